@@ -0,0 +1,5 @@
+action "fortisase_endpoints_access_proxy_disconnect" "example" {
+  config {
+    sn_list = ["FCT8000000000001"]
+  }
+}
@@ -0,0 +1,10 @@
+action "fortisase_endpoints_disable_management" "example" {
+  config {
+    endpoints = [
+      {
+        device_id = "0123456789ABCDEF0123456789ABCDEF"
+        hostname  = "laptop-01"
+      },
+    ]
+  }
+}
@@ -0,0 +1,5 @@
+action "fortisase_endpoints_enable_management" "example" {
+  config {
+    device_ids = ["0123456789ABCDEF0123456789ABCDEF"]
+  }
+}
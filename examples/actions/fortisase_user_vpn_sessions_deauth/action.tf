@@ -0,0 +1,17 @@
+action "fortisase_user_vpn_sessions_deauth" "example" {
+  config {
+    usernames = ["employee@company.com"]
+  }
+}
+
+# Run the action whenever the resource is replaced.
+resource "terraform_data" "offboarding" {
+  input = "employee@company.com"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.fortisase_user_vpn_sessions_deauth.example]
+    }
+  }
+}
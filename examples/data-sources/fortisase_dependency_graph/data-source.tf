@@ -0,0 +1,17 @@
+data "fortisase_dependency_graph" "example" {
+  types = ["fortisase_auth_ldap_servers", "fortisase_auth_user_groups", "fortisase_security_outbound_policies"]
+}
+
+# The objects that break if the LDAP server "ldap1" is deleted
+output "ldap1_referenced_by" {
+  value = data.fortisase_dependency_graph.example.referenced_by["fortisase_auth_ldap_servers.ldap1"]
+}
+
+output "orphans" {
+  value = data.fortisase_dependency_graph.example.orphans
+}
+
+resource "local_file" "graph" {
+  content  = data.fortisase_dependency_graph.example.dot
+  filename = "${path.module}/graph.dot"
+}
@@ -0,0 +1,10 @@
+data "fortisase_rest_call" "example" {
+  path = "/resource-api/v2/network/hosts/{primaryKey}"
+  path_params = {
+    primaryKey = "network_host_example"
+  }
+}
+
+output "subnet" {
+  value = nonsensitive(jsondecode(data.fortisase_rest_call.example.response).subnet)
+}
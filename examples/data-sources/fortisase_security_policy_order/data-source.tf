@@ -0,0 +1,17 @@
+# GUI: Security > Policies > Secure internet access
+# The FortiSASE API cannot reorder policies: the check reports the moves to make in the portal as a warning.
+# Policies that are not listed are ignored.
+check "outbound_policy_order" {
+  data "fortisase_security_policy_order" "outbound" {
+    type = "outbound" # "outbound", "internal", "internal-reverse" or "endpoint-to-endpoint"
+    policies = [
+      fortisase_security_outbound_policies.deny_example.primary_key,
+      fortisase_security_outbound_policies.allow_example.primary_key,
+    ]
+  }
+
+  assert {
+    condition     = data.fortisase_security_policy_order.outbound.in_order
+    error_message = "The outbound policies are out of order, make these moves in the FortiSASE portal:\n${join("\n", data.fortisase_security_policy_order.outbound.moves)}"
+  }
+}
@@ -0,0 +1,10 @@
+ephemeral "fortisase_access_token" "token" {
+  client_id = "FortiSASE"
+}
+
+provider "restapi" {
+  uri = "https://portal.prod.fortisase.com"
+  headers = {
+    Authorization = "Bearer ${ephemeral.fortisase_access_token.token.access_token}"
+  }
+}
@@ -0,0 +1,4 @@
+ephemeral "fortisase_endpoint_group_invitation_code" "onboarding" {
+  primary_key = "onboarding-pipeline-code"
+  expire_date = "2026-12-31"
+}
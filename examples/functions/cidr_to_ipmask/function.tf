@@ -0,0 +1,4 @@
+output "subnet" {
+  # "10.0.0.0 255.255.255.0"
+  value = provider::fortisase::cidr_to_ipmask("10.0.0.0/24")
+}
@@ -0,0 +1,4 @@
+output "cidr" {
+  # "10.0.0.0/24"
+  value = provider::fortisase::ipmask_to_cidr(data.fortisase_network_hosts.lan.subnet)
+}
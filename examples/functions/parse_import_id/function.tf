@@ -0,0 +1,9 @@
+locals {
+  # { primary_key = "web-server", datasource = "network/hosts" }
+  host = provider::fortisase::parse_import_id("network/hosts/web-server")
+}
+
+import {
+  to = fortisase_network_hosts.web
+  id = local.host.primary_key
+}
@@ -0,0 +1,8 @@
+resource "fortisase_security_services" "web" {
+  primary_key = "web-alt"
+  protocol    = "TCP/UDP/SCTP"
+  tcp_portrange = [
+    provider::fortisase::port_range("8080"),
+    provider::fortisase::port_range("8000-8090:1024-65535"),
+  ]
+}
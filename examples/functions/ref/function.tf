@@ -0,0 +1,13 @@
+resource "fortisase_security_outbound_policies" "example" {
+  primary_key = "allow-web"
+  action      = "accept"
+  sources = [
+    provider::fortisase::ref("network/hosts", fortisase_network_hosts.lan.primary_key),
+  ]
+  destinations = [
+    provider::fortisase::ref("network/hosts", "all"),
+  ]
+  services = [
+    provider::fortisase::ref("security/services", "HTTPS"),
+  ]
+}
@@ -53,4 +53,10 @@ resource "fortisase_private_access_service_connections" "example" {
 
   # [IKE version for IPSEC]
   ipsec_ike_version = "2" # Only support 2 for now
+
+  # [Timeouts] The provisioning of the connection is polled until it completes, 20 minutes by default
+  timeouts {
+    create = "30m"
+    update = "30m"
+  }
 }
@@ -0,0 +1 @@
+terraform import fortisase_rest_object.{{your_resource_name}} {{path}}/{{primary_key}}
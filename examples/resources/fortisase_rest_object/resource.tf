@@ -0,0 +1,10 @@
+resource "fortisase_rest_object" "network_host" {
+  path = "/resource-api/v2/network/hosts"
+  body = jsonencode({
+    primaryKey = "rest_host_example"
+    type       = "ipmask"
+    location   = "internal"
+    subnet     = "192.168.5.0/24"
+  })
+  drift_paths = ["subnet"]
+}
@@ -0,0 +1 @@
+terraform import fortisase_security_internal_policy_set.{{your_resource_name}} internal
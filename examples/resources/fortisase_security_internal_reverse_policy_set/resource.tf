@@ -0,0 +1,16 @@
+# Every internal reverse policy that is not listed below is deleted, do not manage the same policies
+# with fortisase_security_internal_reverse_policies.
+resource "fortisase_security_internal_reverse_policy_set" "example" {
+  policies = [
+    {
+      primary_key = "deny_example"
+      action      = "deny"
+      # ...
+    },
+    {
+      primary_key = "accept_example"
+      action      = "accept"
+      # ...
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import fortisase_security_outbound_policy_set.{{your_resource_name}} outbound
@@ -1,4 +1,4 @@
-# Note: Destroying this resource won't delete the cloned profile group.
+# Note: Destroying this resource deletes the cloned profile group.
 resource "fortisase_security_profile_group_clone" "template" {
   based_on    = "existing_profile_name" # The profile to clone from
   primary_key = "new_profile_name"      # The name of the new profile group
@@ -0,0 +1,106 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &actionEndpointsAccessProxyAuthorize{}
+var _ action.ActionWithConfigure = &actionEndpointsAccessProxyAuthorize{}
+
+func newActionEndpointsAccessProxyAuthorize() action.Action {
+	return &actionEndpointsAccessProxyAuthorize{}
+}
+
+type actionEndpointsAccessProxyAuthorize struct {
+	fortiClient *FortiClient
+	actionName  string
+}
+
+// actionEndpointsAccessProxyAuthorizeModel describes the action data model.
+type actionEndpointsAccessProxyAuthorizeModel struct {
+	SnList types.Set `tfsdk:"sn_list"`
+}
+
+func (r *actionEndpointsAccessProxyAuthorize) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoints_access_proxy_authorize"
+}
+
+func (r *actionEndpointsAccessProxyAuthorize) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authorizes endpoints to use the access proxy.",
+		Attributes: map[string]schema.Attribute{
+			"sn_list": schema.SetAttribute{
+				MarkdownDescription: "The serial numbers of the endpoints.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *actionEndpointsAccessProxyAuthorize) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.actionName = "fortisase_endpoints_access_proxy_authorize"
+}
+
+func (r *actionEndpointsAccessProxyAuthorize) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionEndpointsAccessProxyAuthorizeModel
+	diags := &resp.Diagnostics
+
+	// Read Terraform config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// The action shares the request body with resource fortisase_endpoints_access_proxy_authorize.
+	body := resourceEndpointsAccessProxyAuthorize2EdlModel{
+		SnList: data.SnList,
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.BodyParams = *(body.getCreateObjectEndpointsAccessProxyAuthorize(ctx, diags))
+
+	if diags.HasError() {
+		return
+	}
+	output, err := c.CreateEndpointsAccessProxyAuthorize(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to invoke action %s: %v", r.actionName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+}
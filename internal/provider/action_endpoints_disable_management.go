@@ -0,0 +1,114 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &actionEndpointsDisableManagement{}
+var _ action.ActionWithConfigure = &actionEndpointsDisableManagement{}
+
+func newActionEndpointsDisableManagement() action.Action {
+	return &actionEndpointsDisableManagement{}
+}
+
+type actionEndpointsDisableManagement struct {
+	fortiClient *FortiClient
+	actionName  string
+}
+
+// actionEndpointsDisableManagementModel describes the action data model.
+type actionEndpointsDisableManagementModel struct {
+	Endpoints []resourceEndpointsDisableManagementEndpointsModel `tfsdk:"endpoints"`
+}
+
+func (r *actionEndpointsDisableManagement) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoints_disable_management"
+}
+
+func (r *actionEndpointsDisableManagement) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Disables the management of endpoints.",
+		Attributes: map[string]schema.Attribute{
+			"endpoints": schema.ListNestedAttribute{
+				MarkdownDescription: "The endpoints to disable the management for.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.StringAttribute{
+							Required: true,
+						},
+						"hostname": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *actionEndpointsDisableManagement) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.actionName = "fortisase_endpoints_disable_management"
+}
+
+func (r *actionEndpointsDisableManagement) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionEndpointsDisableManagementModel
+	diags := &resp.Diagnostics
+
+	// Read Terraform config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// The action shares the request body with resource fortisase_endpoints_disable_management.
+	body := resourceEndpointsDisableManagementModel{
+		Endpoints: data.Endpoints,
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.BodyParams = *(body.getCreateObjectEndpointsDisableManagement(ctx, diags))
+
+	if diags.HasError() {
+		return
+	}
+	output, err := c.CreateEndpointsDisableManagement(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to invoke action %s: %v", r.actionName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &actionEndpointsEnableManagement{}
+var _ action.ActionWithConfigure = &actionEndpointsEnableManagement{}
+
+func newActionEndpointsEnableManagement() action.Action {
+	return &actionEndpointsEnableManagement{}
+}
+
+type actionEndpointsEnableManagement struct {
+	fortiClient *FortiClient
+	actionName  string
+}
+
+// actionEndpointsEnableManagementModel describes the action data model.
+type actionEndpointsEnableManagementModel struct {
+	DeviceIds types.Set `tfsdk:"device_ids"`
+}
+
+func (r *actionEndpointsEnableManagement) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoints_enable_management"
+}
+
+func (r *actionEndpointsEnableManagement) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables the management of endpoints.",
+		Attributes: map[string]schema.Attribute{
+			"device_ids": schema.SetAttribute{
+				MarkdownDescription: "The device IDs of the endpoints.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *actionEndpointsEnableManagement) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.actionName = "fortisase_endpoints_enable_management"
+}
+
+func (r *actionEndpointsEnableManagement) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionEndpointsEnableManagementModel
+	diags := &resp.Diagnostics
+
+	// Read Terraform config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// The action shares the request body with resource fortisase_endpoints_enable_management.
+	body := resourceEndpointsEnableManagementModel{
+		DeviceIds: data.DeviceIds,
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.BodyParams = *(body.getCreateObjectEndpointsEnableManagement(ctx, diags))
+
+	if diags.HasError() {
+		return
+	}
+	output, err := c.CreateEndpointsEnableManagement(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to invoke action %s: %v", r.actionName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &actionUserVpnSessionsDeauth{}
+var _ action.ActionWithConfigure = &actionUserVpnSessionsDeauth{}
+
+func newActionUserVpnSessionsDeauth() action.Action {
+	return &actionUserVpnSessionsDeauth{}
+}
+
+type actionUserVpnSessionsDeauth struct {
+	fortiClient *FortiClient
+	actionName  string
+}
+
+// actionUserVpnSessionsDeauthModel describes the action data model.
+type actionUserVpnSessionsDeauthModel struct {
+	Usernames  types.Set `tfsdk:"usernames"`
+	SessionIds types.Set `tfsdk:"session_ids"`
+}
+
+func (r *actionUserVpnSessionsDeauth) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_vpn_sessions_deauth"
+}
+
+func (r *actionUserVpnSessionsDeauth) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Deauthenticates VPN user sessions by username or session ID.",
+		Attributes: map[string]schema.Attribute{
+			"usernames": schema.SetAttribute{
+				MarkdownDescription: "The usernames whose sessions are deauthenticated.",
+				Validators: []validator.Set{
+					setvalidator.AtLeastOneOf(path.MatchRoot("session_ids")),
+				},
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"session_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the sessions to deauthenticate.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *actionUserVpnSessionsDeauth) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.actionName = "fortisase_user_vpn_sessions_deauth"
+}
+
+func (r *actionUserVpnSessionsDeauth) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionUserVpnSessionsDeauthModel
+	diags := &resp.Diagnostics
+
+	// Read Terraform config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// The action shares the request body with resource fortisase_user_vpn_sessions_deauth.
+	body := resourceUserVpnSessionsDeauth2EdlModel{
+		Usernames:  data.Usernames,
+		SessionIds: data.SessionIds,
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.BodyParams = *(body.getCreateObjectUserVpnSessionsDeauth(ctx, diags))
+
+	if diags.HasError() {
+		return
+	}
+	output, err := c.CreateUserVpnSessionsDeauth(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to invoke action %s: %v", r.actionName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"fmt"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adoptExistingAttribute is the adopt_existing attribute of the collection resources, it is kept in the state only.
+func adoptExistingAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Whether to take over the object with the same `primary_key` when it already exists in FortiSASE, instead of failing to create it. The object is updated to the configuration and a warning is reported. Defaults to the provider argument `adopt_existing_default`.",
+		Optional:            true,
+	}
+}
+
+// isAdoptExisting returns whether a create that failed with output should try to adopt the existing object.
+// FortiSASE reports a duplicate primary key with the code 424, which it also uses for other failures,
+// the existing object is read before adopting it to tell them apart.
+func isAdoptExisting(client *FortiClient, v types.Bool, output map[string]interface{}) bool {
+	if code, ok := output["code"].(float64); !ok || code != 424.0 {
+		return false
+	}
+	if v.IsNull() {
+		return client != nil && client.AdoptExistingDefault
+	}
+	return v.ValueBool()
+}
+
+// adoptExistingObject reads the object input_model.Mkey and, when it exists, updates it with input_model.
+// It returns false when the object cannot be adopted, the create error is reported then.
+// Otherwise it returns the output and error of the update and reports the adoption as a warning.
+func adoptExistingObject(input_model *forticlient.InputModel, read, update func(*forticlient.InputModel) (map[string]interface{}, error), resourceName string, diags *diag.Diagnostics) (map[string]interface{}, bool, error) {
+	mkey := fmt.Sprintf("%v", input_model.Mkey)
+	if input_model.Mkey == nil || mkey == "" {
+		return nil, false, nil
+	}
+
+	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = input_model.Ctx
+	read_input_model.Mkey = input_model.Mkey
+	read_input_model.URLParams = input_model.URLParams
+	if _, err := read(&read_input_model); err != nil {
+		return nil, false, nil
+	}
+
+	output, err := update(input_model)
+	if err != nil {
+		return output, true, err
+	}
+	if output == nil {
+		output = make(map[string]interface{})
+	}
+	if fortiStringValue(output["primaryKey"]) == "" {
+		output["primaryKey"] = mkey
+	}
+
+	diags.AddWarning(
+		"Existing Object Adopted",
+		fmt.Sprintf("The object %q of resource %s already existed in FortiSASE. It has been updated to the configuration and is now managed by Terraform.", mkey, resourceName),
+	)
+	return output, true, nil
+}
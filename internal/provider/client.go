@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net/http"
@@ -18,6 +19,10 @@ type Config struct {
 	Password     string
 	AccessToken  string
 	RefreshToken string
+
+	ValidateReferences        bool
+	DeletionProtectionDefault bool
+	AdoptExistingDefault      bool
 }
 
 // FortiClient contains the basic FortiSASE SDK connection information to FortiSASE
@@ -31,6 +36,15 @@ type FortiClient struct {
 	ResourceLocks map[string]*sync.Mutex
 	// mutex to protect ResourceLocks map from concurrent access
 	resourceLocksMutex sync.RWMutex
+	// to check the {primary_key, datasource} references at plan time
+	ValidateReferences bool
+	// cache of the checked references, keyed by "<datasource>/<primary_key>"
+	references      map[string]*referenceCheck
+	referencesMutex sync.Mutex
+	// default of the deletion_protection attribute
+	DeletionProtectionDefault bool
+	// default of the adopt_existing attribute
+	AdoptExistingDefault bool
 }
 
 func (f *FortiClient) GetResourceLock(name string) *sync.Mutex {
@@ -56,6 +70,47 @@ func (f *FortiClient) GetResourceLock(name string) *sync.Mutex {
 	return f.ResourceLocks[name]
 }
 
+// referenceCheck is the read of a referenced object, done is closed once exists and err are set.
+type referenceCheck struct {
+	done   chan struct{}
+	exists bool
+	err    error
+}
+
+// ReferenceExists checks whether the object referenced by datasource and primaryKey exists, the read stops when ctx is done.
+// The result is cached, so each object is only read once per Terraform run. The lock only guards the cache:
+// the checks of the same object wait for the first read, the checks of other objects run concurrently.
+// A failed read is not cached.
+func (f *FortiClient) ReferenceExists(ctx context.Context, datasource, primaryKey string) (bool, error) {
+	key := datasource + "/" + primaryKey
+
+	f.referencesMutex.Lock()
+	if check, ok := f.references[key]; ok {
+		f.referencesMutex.Unlock()
+		select {
+		case <-check.done:
+			return check.exists, check.err
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+	if f.references == nil {
+		f.references = make(map[string]*referenceCheck)
+	}
+	check := &referenceCheck{done: make(chan struct{})}
+	f.references[key] = check
+	f.referencesMutex.Unlock()
+
+	check.exists, check.err = f.Client.ReadReference(ctx, datasource, primaryKey)
+	if check.err != nil {
+		f.referencesMutex.Lock()
+		delete(f.references, key)
+		f.referencesMutex.Unlock()
+	}
+	close(check.done)
+	return check.exists, check.err
+}
+
 type RateLimitedTransport struct {
 	Transport http.RoundTripper
 	Limiter   *rate.Limiter
@@ -143,5 +198,8 @@ func createFortiSASEClient(fClient *FortiClient, c *Config) error {
 	fClient.Client = fc
 	// initialize the resource locks
 	fClient.ResourceLocks = make(map[string]*sync.Mutex)
+	fClient.ValidateReferences = c.ValidateReferences
+	fClient.DeletionProtectionDefault = c.DeletionProtectionDefault
+	fClient.AdoptExistingDefault = c.AdoptExistingDefault
 	return nil
 }
@@ -0,0 +1,127 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/auth"
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/config"
+	forticlient "github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+)
+
+// testTransport sends the requests of the SDK, which are built for the FortiSASE portal, to a test server.
+type testTransport struct {
+	server *url.URL
+}
+
+func (t testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.server.Scheme
+	req.URL.Host = t.server.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestFortiClient returns a client whose requests are served by handler.
+func newTestFortiClient(t *testing.T, handler http.Handler) *FortiClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &FortiClient{
+		Client: &forticlient.FortiSDKClient{
+			Config: config.Config{
+				Auth:    auth.NewAuth("", "", "token", ""),
+				HTTPCon: &http.Client{Transport: testTransport{server: u}},
+			},
+		},
+		ResourceLocks: make(map[string]*sync.Mutex),
+	}
+}
+
+// writeTestResponse writes a FortiSASE API response with the status code and the data.
+func writeTestResponse(w http.ResponseWriter, code int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"code": code, "data": data})
+}
+
+func TestReferenceExists(t *testing.T) {
+	var reads atomic.Int32
+	hung := make(chan struct{})
+	client := newTestFortiClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reads.Add(1)
+		switch r.URL.Path {
+		case "/resource-api/v2/network/hosts/web":
+			writeTestResponse(w, 200, map[string]interface{}{"primaryKey": "web"})
+		case "/resource-api/v2/network/hosts/hung":
+			select {
+			case <-hung:
+			case <-r.Context().Done():
+			}
+		default:
+			writeTestResponse(w, 404, nil)
+		}
+	}))
+	defer close(hung)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		exists, err := client.ReferenceExists(ctx, "network/hosts", "web")
+		if err != nil || !exists {
+			t.Fatalf("ReferenceExists(web) = %v, %v, want true", exists, err)
+		}
+	}
+	if n := reads.Load(); n != 1 {
+		t.Errorf("ReferenceExists(web) twice read the object %d times, want 1", n)
+	}
+
+	if exists, err := client.ReferenceExists(ctx, "network/hosts", "typo"); err != nil || exists {
+		t.Errorf("ReferenceExists(typo) = %v, %v, want false", exists, err)
+	}
+
+	// A hung read stops at the deadline of its context and does not block the checks of other objects.
+	hungCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.ReferenceExists(hungCtx, "network/hosts", "hung")
+		errs <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	checked := make(chan struct{})
+	go func() {
+		client.ReferenceExists(ctx, "network/hosts", "other")
+		close(checked)
+	}()
+	select {
+	case <-checked:
+	case <-time.After(300 * time.Millisecond):
+		t.Errorf("ReferenceExists(other) is blocked by the hung read")
+	}
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Errorf("ReferenceExists(hung) error = nil, want the deadline error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("ReferenceExists(hung) did not stop at the deadline")
+	}
+
+	// The failed read is not cached.
+	client.referencesMutex.Lock()
+	_, cached := client.references["network/hosts/hung"]
+	client.referencesMutex.Unlock()
+	if cached {
+		t.Errorf("ReferenceExists(hung) cached the failed read")
+	}
+}
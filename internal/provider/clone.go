@@ -0,0 +1,233 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// cloneExcludedAttributes are the attributes of the cloned resource types that control the provider,
+// the clone resources do not apply them so they are not in their schema and are null in their model.
+var cloneExcludedAttributes = map[string]attr.Value{
+	"deletion_protection": types.BoolNull(),
+	"adopt_existing":      types.BoolNull(),
+	"force_detach":        types.BoolNull(),
+	"raw_json":            types.StringNull(),
+}
+
+// cloneSchemaAttributes returns the attributes of a clone resource: the attributes of the cloned resource type,
+// without cloneExcludedAttributes, all turned into computed ones so that the cloned object can be referenced,
+// overlaid with the attributes of the clone itself.
+func cloneSchemaAttributes(ctx context.Context, target resource.Resource, own map[string]schema.Attribute) map[string]schema.Attribute {
+	var resp resource.SchemaResponse
+	target.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	result := computedAttributes(resp.Schema.Attributes)
+	for name := range cloneExcludedAttributes {
+		delete(result, name)
+	}
+	for name, a := range own {
+		result[name] = a
+	}
+	return result
+}
+
+// getCloneData reads data, the configuration, plan or state of a clone resource, into target, a pointer to the model
+// of the clone, which embeds the model of the cloned resource type: its cloneExcludedAttributes are null.
+func getCloneData(ctx context.Context, data interface {
+	Get(context.Context, interface{}) diag.Diagnostics
+}, target interface{}) diag.Diagnostics {
+	var o types.Object
+	diags := data.Get(ctx, &o)
+	if diags.HasError() {
+		return diags
+	}
+
+	attrTypes, values := o.AttributeTypes(ctx), o.Attributes()
+	for name, v := range cloneModelExcludedAttributes(target) {
+		attrTypes[name] = v.Type(ctx)
+		values[name] = v
+	}
+	o, d := types.ObjectValue(attrTypes, values)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(o.As(ctx, target, basetypes.ObjectAsOptions{})...)
+	return diags
+}
+
+// setCloneState sets the state of a clone resource from model, the model of the clone, without its cloneExcludedAttributes.
+func setCloneState(ctx context.Context, state *tfsdk.State, model interface{}) diag.Diagnostics {
+	stateType := state.Schema.Type().(types.ObjectType)
+	attrTypes := make(map[string]attr.Type, len(stateType.AttrTypes))
+	for name, t := range stateType.AttrTypes {
+		attrTypes[name] = t
+	}
+	for name, v := range cloneModelExcludedAttributes(model) {
+		attrTypes[name] = v.Type(ctx)
+	}
+	o, diags := types.ObjectValueFrom(ctx, attrTypes, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	values := make(map[string]attr.Value, len(stateType.AttrTypes))
+	for name := range stateType.AttrTypes {
+		values[name] = o.Attributes()[name]
+	}
+	o, d := types.ObjectValue(stateType.AttrTypes, values)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(state.Set(ctx, o)...)
+	return diags
+}
+
+// cloneModelExcludedAttributes returns the null values of the cloneExcludedAttributes that the model of a clone has,
+// not every cloned resource type has all of them.
+func cloneModelExcludedAttributes(model interface{}) map[string]attr.Value {
+	names := modelAttributeNames(reflect.TypeOf(model))
+	result := make(map[string]attr.Value, len(cloneExcludedAttributes))
+	for name, v := range cloneExcludedAttributes {
+		if names[name] {
+			result[name] = v
+		}
+	}
+	return result
+}
+
+// computedAttributes converts attributes into computed only attributes,
+// validators, plan modifiers and defaults are dropped as they only apply to configured values.
+func computedAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute, len(attributes))
+	for name, a := range attributes {
+		result[name] = computedAttribute(a)
+	}
+	return result
+}
+
+func computedAttribute(a schema.Attribute) schema.Attribute {
+	switch a := a.(type) {
+	case schema.StringAttribute:
+		return schema.StringAttribute{
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.BoolAttribute:
+		return schema.BoolAttribute{
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.Float64Attribute:
+		return schema.Float64Attribute{
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.Int64Attribute:
+		return schema.Int64Attribute{
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.ListAttribute:
+		return schema.ListAttribute{
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.SetAttribute:
+		return schema.SetAttribute{
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.MapAttribute:
+		return schema.MapAttribute{
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{
+			Attributes:          computedAttributes(a.Attributes),
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.ListNestedAttribute:
+		return schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: computedAttributes(a.NestedObject.Attributes),
+				CustomType: a.NestedObject.CustomType,
+			},
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case schema.SetNestedAttribute:
+		return schema.SetNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: computedAttributes(a.NestedObject.Attributes),
+				CustomType: a.NestedObject.CustomType,
+			},
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	}
+	return a
+}
+
+// cloneMkey returns the primary key of the object created by a clone resource.
+// Clone resources created by earlier versions of the provider have a fixed ID, their primary_key is used instead.
+func cloneMkey(id types.String, primaryKey types.String, legacyID string) string {
+	if id.ValueString() == legacyID {
+		return primaryKey.ValueString()
+	}
+	return id.ValueString()
+}
+
+// cloneCreatedMkey returns the primary key of the object created by a clone request,
+// falling back to the configured primary_key when the response does not contain it.
+func cloneCreatedMkey(output map[string]interface{}, primaryKey types.String) string {
+	if mkey := fortiStringValue(output["primaryKey"]); mkey != "" {
+		return mkey
+	}
+	return primaryKey.ValueString()
+}
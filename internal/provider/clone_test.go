@@ -0,0 +1,47 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCloneState(t *testing.T) {
+	ctx := context.Background()
+	var resp resource.SchemaResponse
+	newResourceSecurityOutboundPoliciesClone().Schema(ctx, resource.SchemaRequest{}, &resp)
+	for name := range cloneExcludedAttributes {
+		if _, ok := resp.Schema.Attributes[name]; ok {
+			t.Errorf("clone attribute %s is not excluded", name)
+		}
+	}
+
+	state := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil)}
+	var data resourceSecurityOutboundPoliciesClone2EdlModel
+	data.ID = types.StringValue("copy")
+	data.PrimaryKey = types.StringValue("copy")
+	data.BasedOn = types.StringValue("deny")
+	data.Action = types.StringValue("deny")
+	data.DeletionProtection = types.BoolValue(true)
+	data.RawJson = types.StringValue(`{"action":"deny"}`)
+	data.Timeouts = timeoutsNull(ctx)
+	if diags := setCloneState(ctx, &state, &data); diags.HasError() {
+		t.Fatalf("setCloneState() diagnostics: %v", diags)
+	}
+
+	var got resourceSecurityOutboundPoliciesClone2EdlModel
+	if diags := getCloneData(ctx, state, &got); diags.HasError() {
+		t.Fatalf("getCloneData() diagnostics: %v", diags)
+	}
+	if got.PrimaryKey.ValueString() != "copy" || got.BasedOn.ValueString() != "deny" || got.Action.ValueString() != "deny" {
+		t.Errorf("getCloneData() = %+v, want the set clone", got)
+	}
+	if !got.DeletionProtection.IsNull() || !got.RawJson.IsNull() || !got.ForceDetach.IsNull() || !got.AdoptExisting.IsNull() {
+		t.Errorf("getCloneData() excluded attributes = %v, %v, %v, %v, want null", got.DeletionProtection, got.RawJson, got.ForceDetach, got.AdoptExisting)
+	}
+}
@@ -62,17 +62,96 @@ func AttributeTypes[T any](ctx context.Context) (map[string]attr.Type, diag.Diag
 
 func validateConvIPMask2CIDR(oNewIP, oOldIP string) string {
 	if oNewIP != oOldIP && strings.Contains(oNewIP, "/") && strings.Contains(oOldIP, " ") {
-		line := strings.Split(oOldIP, " ")
-		if len(line) >= 2 {
-			ip := line[0]
-			mask := line[1]
-			prefixSize, _ := net.IPMask(net.ParseIP(mask).To4()).Size()
-			return ip + "/" + strconv.Itoa(prefixSize)
+		if cidr, err := convIPMask2CIDR(oOldIP); err == nil {
+			return cidr
 		}
 	}
 	return oOldIP
 }
 
+// convIPMask2CIDR converts "10.0.0.0 255.255.255.0" into "10.0.0.0/24".
+func convIPMask2CIDR(v string) (string, error) {
+	line := strings.Fields(v)
+	if len(line) != 2 {
+		return "", fmt.Errorf("%q is not in the format of \"<ip> <netmask>\"", v)
+	}
+	ip := net.ParseIP(line[0]).To4()
+	if ip == nil {
+		return "", fmt.Errorf("%q is not a valid IPv4 address", line[0])
+	}
+	mask := net.ParseIP(line[1]).To4()
+	if mask == nil {
+		return "", fmt.Errorf("%q is not a valid netmask", line[1])
+	}
+	prefixSize, bits := net.IPMask(mask).Size()
+	if bits == 0 {
+		return "", fmt.Errorf("%q is not a contiguous netmask", line[1])
+	}
+	return ip.String() + "/" + strconv.Itoa(prefixSize), nil
+}
+
+// convCIDR2IPMask converts "10.0.0.0/24" into "10.0.0.0 255.255.255.0".
+func convCIDR2IPMask(v string) (string, error) {
+	ip, ipnet, err := net.ParseCIDR(strings.TrimSpace(v))
+	if err != nil || ip.To4() == nil {
+		return "", fmt.Errorf("%q is not a valid IPv4 CIDR", v)
+	}
+	return ip.To4().String() + " " + net.IP(ipnet.Mask).String(), nil
+}
+
+// parsePortRange parses a port range such as "80", "8000-8080" or "8000-8080:1024-2048",
+// where the optional part after the colon is the source port range.
+// It returns the destination and source low/high ports, a missing source range is returned as nil.
+func parsePortRange(v string) (dst []int64, src []int64, err error) {
+	parseRange := func(r string) ([]int64, error) {
+		bounds := strings.SplitN(r, "-", 2)
+		result := make([]int64, 0, 2)
+		for _, b := range bounds {
+			port, err := strconv.ParseInt(strings.TrimSpace(b), 10, 64)
+			if err != nil || port < 0 || port > 65535 {
+				return nil, fmt.Errorf("%q is not a valid port, it must be a number between 0 and 65535", b)
+			}
+			result = append(result, port)
+		}
+		if len(result) == 1 {
+			result = append(result, result[0])
+		}
+		if result[0] > result[1] {
+			return nil, fmt.Errorf("the low port %d of %q is greater than the high port %d", result[0], r, result[1])
+		}
+		return result, nil
+	}
+
+	parts := strings.SplitN(strings.TrimSpace(v), ":", 2)
+	dst, err = parseRange(parts[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(parts) == 2 {
+		src, err = parseRange(parts[1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return dst, src, nil
+}
+
+// fortisaseDatasources lists the datasource paths used by {primary_key, datasource} references.
+var fortisaseDatasources = []string{
+	"auth/ad-groups", "auth/ldap-servers", "auth/radius-servers", "auth/swg-saml-server", "auth/user-groups", "auth/users", "auth/vpn-saml-server",
+	"endpoint/on-net-rules", "endpoint/ztna-tags",
+	"infra/extenders", "infra/fortigates", "infra/ssids",
+	"network/basic-internet-services", "network/host-groups", "network/hosts", "network/internet-services",
+	"security/antivirus-filetypes", "security/antivirus-profiles", "security/application-categories", "security/application-control-profiles",
+	"security/applications", "security/dlp-data-types", "security/dlp-dictionaries", "security/dlp-file-patterns", "security/dlp-profiles",
+	"security/dlp-sensors", "security/dns-filter-profiles", "security/domain-threat-feeds", "security/file-filter-profiles",
+	"security/fortiguard-categories", "security/fortiguard-local-categories", "security/ip-threat-feeds", "security/ips-custom-signatures",
+	"security/ips-profiles", "security/ips-rule", "security/onetime-schedules", "security/profile-groups", "security/recurring-schedules",
+	"security/schedule-groups", "security/service-groups", "security/services", "security/ssl-ssh-profiles", "security/url-threat-feeds",
+	"security/video-filter-fortiguard-categories", "security/video-filter-profiles", "security/web-filter-profiles",
+	"system/certificate/ca-certificates", "system/certificate/local-certificates", "system/certificate/remote-certificates",
+}
+
 func fortiStringValue(t interface{}) string {
 	if v, ok := t.(string); ok {
 		return v
@@ -411,6 +490,31 @@ func parseFloat64Value(v interface{}) basetypes.Float64Value {
 	return types.Float64Null()
 }
 
+func parseInt64Value(v interface{}) basetypes.Int64Value {
+	if v == nil {
+		return types.Int64Null()
+	}
+	switch val := v.(type) {
+	case float64:
+		return types.Int64Value(int64(val))
+	case int:
+		return types.Int64Value(int64(val))
+	case int64:
+		return types.Int64Value(val)
+	case string:
+		i, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			f, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return types.Int64Null()
+			}
+			return types.Int64Value(int64(f))
+		}
+		return types.Int64Value(i)
+	}
+	return types.Int64Null()
+}
+
 func parseMapValue(ctx context.Context, v interface{}, element_type attr.Type) basetypes.MapValue {
 	var m basetypes.MapValue
 	if v != nil {
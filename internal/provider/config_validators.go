@@ -0,0 +1,106 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = discriminatorValidator{}
+
+// discriminatorVariant lists the sibling attributes of a discriminator for one of its values.
+// Each entry of required is satisfied when any of the listed attributes is configured.
+type discriminatorVariant struct {
+	required    [][]string
+	conflicting []string
+}
+
+// discriminatorValidator validates the attributes next to a discriminator attribute depending on its value,
+// e.g. `type = "fqdn"` of fortisase_network_hosts requires `fqdn` and conflicts with `subnet`.
+// The discriminator may be nested, the sibling attributes are looked up in the same object.
+type discriminatorValidator struct {
+	discriminator path.Expression
+	variants      map[string]discriminatorVariant
+}
+
+func validateDiscriminator(discriminator path.Expression, variants map[string]discriminatorVariant) resource.ConfigValidator {
+	return discriminatorValidator{
+		discriminator: discriminator,
+		variants:      variants,
+	}
+}
+
+func (v discriminatorValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v discriminatorValidator) MarkdownDescription(ctx context.Context) string {
+	values := make([]string, 0, len(v.variants))
+	for value := range v.variants {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return fmt.Sprintf("Checks the attributes next to %s for the values: %s", v.discriminator, strings.Join(values, ", "))
+}
+
+func (v discriminatorValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	paths, diags := req.Config.PathMatches(ctx, v.discriminator)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range paths {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &value)...)
+		if resp.Diagnostics.HasError() || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		variant, ok := v.variants[value.ValueString()]
+		if !ok {
+			continue
+		}
+
+		isSet := func(name string) (path.Path, attr.Value) {
+			ap := p.ParentPath().AtName(name)
+			var av attr.Value
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, ap, &av)...)
+			return ap, av
+		}
+
+		for _, names := range variant.required {
+			found := false
+			for _, name := range names {
+				if _, av := isSet(name); av != nil && !av.IsNull() {
+					found = true
+					break
+				}
+			}
+			if !found {
+				resp.Diagnostics.AddAttributeError(
+					p.ParentPath().AtName(names[0]),
+					"Missing Attribute Configuration",
+					fmt.Sprintf("%s must be configured when %s is %q.", strings.Join(names, " or "), p, value.ValueString()),
+				)
+			}
+		}
+
+		for _, name := range variant.conflicting {
+			if ap, av := isSet(name); av != nil && !av.IsNull() && !av.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					ap,
+					"Invalid Attribute Combination",
+					fmt.Sprintf("%s cannot be configured when %s is %q.", name, p, value.ValueString()),
+				)
+			}
+		}
+	}
+}
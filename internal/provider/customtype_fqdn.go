@@ -0,0 +1,86 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = fqdnType{}
+var _ basetypes.StringValuableWithSemanticEquals = fqdnValue{}
+
+// fqdnType is a string type for fully qualified domain names.
+// The comparison ignores the case and a trailing dot.
+type fqdnType struct {
+	basetypes.StringType
+}
+
+func (t fqdnType) Equal(o attr.Type) bool {
+	other, ok := o.(fqdnType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t fqdnType) String() string {
+	return "fqdnType"
+}
+
+func (t fqdnType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return fqdnValue{StringValue: in}, nil
+}
+
+func (t fqdnType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return fqdnValue{StringValue: stringValue}, nil
+}
+
+func (t fqdnType) ValueType(ctx context.Context) attr.Value {
+	return fqdnValue{}
+}
+
+// fqdnValue is the value of fqdnType.
+type fqdnValue struct {
+	basetypes.StringValue
+}
+
+func (v fqdnValue) Equal(o attr.Value) bool {
+	other, ok := o.(fqdnValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v fqdnValue) Type(ctx context.Context) attr.Type {
+	return fqdnType{}
+}
+
+func (v fqdnValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(fqdnValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return strings.EqualFold(strings.TrimSuffix(v.ValueString(), "."), strings.TrimSuffix(newValue.ValueString(), ".")), diags
+}
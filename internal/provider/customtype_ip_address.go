@@ -0,0 +1,91 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = ipAddressType{}
+var _ basetypes.StringValuableWithSemanticEquals = ipAddressValue{}
+
+// ipAddressType is a string type for IP addresses, such as the start and end of an IP range.
+// Addresses are compared by value, so "2001:db8:0::1" equals "2001:db8::1".
+type ipAddressType struct {
+	basetypes.StringType
+}
+
+func (t ipAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(ipAddressType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t ipAddressType) String() string {
+	return "ipAddressType"
+}
+
+func (t ipAddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ipAddressValue{StringValue: in}, nil
+}
+
+func (t ipAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return ipAddressValue{StringValue: stringValue}, nil
+}
+
+func (t ipAddressType) ValueType(ctx context.Context) attr.Value {
+	return ipAddressValue{}
+}
+
+// ipAddressValue is the value of ipAddressType.
+type ipAddressValue struct {
+	basetypes.StringValue
+}
+
+func (v ipAddressValue) Equal(o attr.Value) bool {
+	other, ok := o.(ipAddressValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v ipAddressValue) Type(ctx context.Context) attr.Type {
+	return ipAddressType{}
+}
+
+func (v ipAddressValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(ipAddressValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if v.ValueString() == newValue.ValueString() {
+		return true, diags
+	}
+	oldIP := net.ParseIP(v.ValueString())
+	newIP := net.ParseIP(newValue.ValueString())
+	return oldIP != nil && oldIP.Equal(newIP), diags
+}
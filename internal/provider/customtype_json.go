@@ -0,0 +1,91 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = jsonType{}
+var _ basetypes.StringValuableWithSemanticEquals = jsonValue{}
+
+// jsonType is a string type for JSON documents.
+// The comparison ignores the formatting and the order of the object keys.
+type jsonType struct {
+	basetypes.StringType
+}
+
+func (t jsonType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonType) String() string {
+	return "jsonType"
+}
+
+func (t jsonType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonValue{StringValue: in}, nil
+}
+
+func (t jsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return jsonValue{StringValue: stringValue}, nil
+}
+
+func (t jsonType) ValueType(ctx context.Context) attr.Value {
+	return jsonValue{}
+}
+
+// jsonValue is the value of jsonType.
+type jsonValue struct {
+	basetypes.StringValue
+}
+
+func (v jsonValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v jsonValue) Type(ctx context.Context) attr.Type {
+	return jsonType{}
+}
+
+func (v jsonValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(jsonValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	var a, b interface{}
+	if json.Unmarshal([]byte(v.ValueString()), &a) != nil || json.Unmarshal([]byte(newValue.ValueString()), &b) != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	return reflect.DeepEqual(a, b), diags
+}
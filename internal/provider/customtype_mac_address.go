@@ -0,0 +1,99 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = macAddressType{}
+var _ basetypes.StringValuableWithSemanticEquals = macAddressValue{}
+
+// macAddressType is a string type for MAC addresses.
+// The comparison ignores the case and the separator, so "AA-BB-CC-DD-EE-FF" equals "aa:bb:cc:dd:ee:ff".
+type macAddressType struct {
+	basetypes.StringType
+}
+
+func (t macAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(macAddressType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t macAddressType) String() string {
+	return "macAddressType"
+}
+
+func (t macAddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return macAddressValue{StringValue: in}, nil
+}
+
+func (t macAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return macAddressValue{StringValue: stringValue}, nil
+}
+
+func (t macAddressType) ValueType(ctx context.Context) attr.Value {
+	return macAddressValue{}
+}
+
+// macAddressValue is the value of macAddressType.
+type macAddressValue struct {
+	basetypes.StringValue
+}
+
+func (v macAddressValue) Equal(o attr.Value) bool {
+	other, ok := o.(macAddressValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v macAddressValue) Type(ctx context.Context) attr.Type {
+	return macAddressType{}
+}
+
+func (v macAddressValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(macAddressValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if strings.EqualFold(v.ValueString(), newValue.ValueString()) {
+		return true, diags
+	}
+	oldMac, err := net.ParseMAC(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newMac, err := net.ParseMAC(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return bytes.Equal(oldMac, newMac), diags
+}
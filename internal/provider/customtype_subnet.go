@@ -0,0 +1,100 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = subnetType{}
+var _ basetypes.StringValuableWithSemanticEquals = subnetValue{}
+
+// subnetType is a string type for subnets. "10.0.0.0/24" and "10.0.0.0 255.255.255.0" are treated as the same value,
+// so the canonical form echoed by the API does not cause a diff.
+type subnetType struct {
+	basetypes.StringType
+}
+
+func (t subnetType) Equal(o attr.Type) bool {
+	other, ok := o.(subnetType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t subnetType) String() string {
+	return "subnetType"
+}
+
+func (t subnetType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return subnetValue{StringValue: in}, nil
+}
+
+func (t subnetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return subnetValue{StringValue: stringValue}, nil
+}
+
+func (t subnetType) ValueType(ctx context.Context) attr.Value {
+	return subnetValue{}
+}
+
+// subnetValue is the value of subnetType.
+type subnetValue struct {
+	basetypes.StringValue
+}
+
+func (v subnetValue) Equal(o attr.Value) bool {
+	other, ok := o.(subnetValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v subnetValue) Type(ctx context.Context) attr.Type {
+	return subnetType{}
+}
+
+func (v subnetValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(subnetValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldSubnet := v.ValueString()
+	newSubnet := newValue.ValueString()
+	if oldSubnet == newSubnet {
+		return true, diags
+	}
+	return normalizeSubnet(oldSubnet) == normalizeSubnet(newSubnet), diags
+}
+
+// normalizeSubnet converts a subnet into the CIDR format, a single address is treated as a /32 subnet.
+func normalizeSubnet(v string) string {
+	v = strings.TrimSpace(v)
+	if !strings.Contains(v, "/") && !strings.Contains(v, " ") {
+		v = v + "/32"
+	}
+	return validateConvIPMask2CIDR("/", v)
+}
@@ -37,6 +37,7 @@ type datasourceAuthFssoAgentsModel struct {
 	Server4        types.String `tfsdk:"server4"`
 	Server5        types.String `tfsdk:"server5"`
 	SslTrustedCert types.String `tfsdk:"ssl_trusted_cert"`
+	RawJson        types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceAuthFssoAgents) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,7 @@ func (r *datasourceAuthFssoAgents) Metadata(ctx context.Context, req datasource.
 func (r *datasourceAuthFssoAgents) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -179,6 +181,8 @@ func (m *datasourceAuthFssoAgentsModel) refreshAuthFssoAgents(ctx context.Contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["activeServer"]; ok {
 		m.ActiveServer = parseStringValue(v)
 	}
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -30,7 +30,7 @@ type datasourceAuthLdapServers struct {
 type datasourceAuthLdapServersModel struct {
 	PrimaryKey                   types.String                               `tfsdk:"primary_key"`
 	Server                       types.String                               `tfsdk:"server"`
-	Port                         types.Float64                              `tfsdk:"port"`
+	Port                         types.Int64                                `tfsdk:"port"`
 	Cnid                         types.String                               `tfsdk:"cnid"`
 	Dn                           types.String                               `tfsdk:"dn"`
 	BindType                     types.String                               `tfsdk:"bind_type"`
@@ -48,6 +48,7 @@ type datasourceAuthLdapServersModel struct {
 	ClientCert                   *datasourceAuthLdapServersClientCertModel  `tfsdk:"client_cert"`
 	Username                     types.String                               `tfsdk:"username"`
 	Password                     types.String                               `tfsdk:"password"`
+	RawJson                      types.String                               `tfsdk:"raw_json"`
 }
 
 func (r *datasourceAuthLdapServers) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -57,6 +58,7 @@ func (r *datasourceAuthLdapServers) Metadata(ctx context.Context, req datasource
 func (r *datasourceAuthLdapServers) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -70,9 +72,9 @@ func (r *datasourceAuthLdapServers) Schema(ctx context.Context, req datasource.S
 				Computed: true,
 				Optional: true,
 			},
-			"port": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 65535),
+			"port": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
 				},
 				Computed: true,
 				Optional: true,
@@ -164,8 +166,9 @@ func (r *datasourceAuthLdapServers) Schema(ctx context.Context, req datasource.S
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
 				},
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
 			"certificate": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -268,12 +271,14 @@ func (m *datasourceAuthLdapServersModel) refreshAuthLdapServers(ctx context.Cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["server"]; ok {
 		m.Server = parseStringValue(v)
 	}
 
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	if v, ok := o["cnid"]; ok {
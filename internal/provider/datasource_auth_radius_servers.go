@@ -32,6 +32,7 @@ type datasourceAuthRadiusServersModel struct {
 	PrimaryServer              types.String `tfsdk:"primary_server"`
 	IncludedInDefaultUserGroup types.Bool   `tfsdk:"included_in_default_user_group"`
 	SecondaryServer            types.String `tfsdk:"secondary_server"`
+	RawJson                    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceAuthRadiusServers) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (r *datasourceAuthRadiusServers) Metadata(ctx context.Context, req datasour
 func (r *datasourceAuthRadiusServers) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -139,6 +141,8 @@ func (m *datasourceAuthRadiusServersModel) refreshAuthRadiusServers(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["authType"]; ok {
 		m.AuthType = parseStringValue(v)
 	}
@@ -39,6 +39,7 @@ type datasourceAuthSwgSamlServerModel struct {
 	DigestMethod   types.String                                    `tfsdk:"digest_method"`
 	ScimEnabled    types.Bool                                      `tfsdk:"scim_enabled"`
 	Scim           *datasourceAuthSwgSamlServerScimModel           `tfsdk:"scim"`
+	RawJson        types.String                                    `tfsdk:"raw_json"`
 }
 
 func (r *datasourceAuthSwgSamlServer) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,6 +49,7 @@ func (r *datasourceAuthSwgSamlServer) Metadata(ctx context.Context, req datasour
 func (r *datasourceAuthSwgSamlServer) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -155,8 +157,9 @@ func (r *datasourceAuthSwgSamlServer) Schema(ctx context.Context, req datasource
 						Validators: []validator.String{
 							stringvalidator.LengthBetween(1, 128),
 						},
-						Computed: true,
-						Optional: true,
+						Sensitive: true,
+						Computed:  true,
+						Optional:  true,
 					},
 				},
 				Computed: true,
@@ -228,6 +231,8 @@ func (m *datasourceAuthSwgSamlServerModel) refreshAuthSwgSamlServer(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["idpEntityId"]; ok {
 		m.IdpEntityId = parseStringValue(v)
 	}
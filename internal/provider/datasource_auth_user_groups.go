@@ -31,6 +31,7 @@ type datasourceAuthUserGroupsModel struct {
 	GroupType        types.String                                    `tfsdk:"group_type"`
 	LocalUsers       []datasourceAuthUserGroupsLocalUsersModel       `tfsdk:"local_users"`
 	RemoteUserGroups []datasourceAuthUserGroupsRemoteUserGroupsModel `tfsdk:"remote_user_groups"`
+	RawJson          types.String                                    `tfsdk:"raw_json"`
 }
 
 func (r *datasourceAuthUserGroups) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,6 +41,7 @@ func (r *datasourceAuthUserGroups) Metadata(ctx context.Context, req datasource.
 func (r *datasourceAuthUserGroups) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(35),
@@ -169,6 +171,8 @@ func (m *datasourceAuthUserGroupsModel) refreshAuthUserGroups(ctx context.Contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["groupType"]; ok {
 		m.GroupType = parseStringValue(v)
 	}
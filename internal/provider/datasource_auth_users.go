@@ -32,6 +32,7 @@ type datasourceAuthUsersModel struct {
 	Status     types.String                        `tfsdk:"status"`
 	Email      types.String                        `tfsdk:"email"`
 	LdapServer *datasourceAuthUsersLdapServerModel `tfsdk:"ldap_server"`
+	RawJson    types.String                        `tfsdk:"raw_json"`
 }
 
 func (r *datasourceAuthUsers) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (r *datasourceAuthUsers) Metadata(ctx context.Context, req datasource.Metad
 func (r *datasourceAuthUsers) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 64),
@@ -152,6 +154,8 @@ func (m *datasourceAuthUsersModel) refreshAuthUsers(ctx context.Context, o map[s
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["authType"]; ok {
 		m.AuthType = parseStringValue(v)
 	}
@@ -41,6 +41,7 @@ type datasourceAuthVpnSamlServerModel struct {
 	ScimEnabled    types.Bool                                      `tfsdk:"scim_enabled"`
 	DomainName     types.String                                    `tfsdk:"domain_name"`
 	ApplicationId  types.String                                    `tfsdk:"application_id"`
+	RawJson        types.String                                    `tfsdk:"raw_json"`
 }
 
 func (r *datasourceAuthVpnSamlServer) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,6 +51,7 @@ func (r *datasourceAuthVpnSamlServer) Metadata(ctx context.Context, req datasour
 func (r *datasourceAuthVpnSamlServer) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -224,6 +226,8 @@ func (m *datasourceAuthVpnSamlServerModel) refreshAuthVpnSamlServer(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["idpEntityId"]; ok {
 		m.IdpEntityId = parseStringValue(v)
 	}
@@ -30,6 +30,7 @@ type datasourceDemCustomSaasAppsModel struct {
 	PrimaryKey types.String `tfsdk:"primary_key"`
 	Alias      types.String `tfsdk:"alias"`
 	Fqdn       types.String `tfsdk:"fqdn"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceDemCustomSaasApps) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,6 +40,7 @@ func (r *datasourceDemCustomSaasApps) Metadata(ctx context.Context, req datasour
 func (r *datasourceDemCustomSaasApps) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 253),
@@ -125,6 +127,8 @@ func (m *datasourceDemCustomSaasAppsModel) refreshDemCustomSaasApps(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["alias"]; ok {
 		m.Alias = parseStringValue(v)
 	}
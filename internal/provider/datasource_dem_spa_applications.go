@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -28,14 +28,15 @@ type datasourceDemSpaApplications struct {
 
 // datasourceDemSpaApplicationsModel describes the datasource data model.
 type datasourceDemSpaApplicationsModel struct {
-	PrimaryKey          types.String  `tfsdk:"primary_key"`
-	Server              types.String  `tfsdk:"server"`
-	LatencyThreshold    types.Float64 `tfsdk:"latency_threshold"`
-	JitterThreshold     types.Float64 `tfsdk:"jitter_threshold"`
-	PacketlossThreshold types.Float64 `tfsdk:"packetloss_threshold"`
-	Interval            types.Float64 `tfsdk:"interval"`
-	FailTime            types.Float64 `tfsdk:"fail_time"`
-	RecoveryTime        types.Float64 `tfsdk:"recovery_time"`
+	PrimaryKey          types.String `tfsdk:"primary_key"`
+	Server              types.String `tfsdk:"server"`
+	LatencyThreshold    types.Int64  `tfsdk:"latency_threshold"`
+	JitterThreshold     types.Int64  `tfsdk:"jitter_threshold"`
+	PacketlossThreshold types.Int64  `tfsdk:"packetloss_threshold"`
+	Interval            types.Int64  `tfsdk:"interval"`
+	FailTime            types.Int64  `tfsdk:"fail_time"`
+	RecoveryTime        types.Int64  `tfsdk:"recovery_time"`
+	RawJson             types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceDemSpaApplications) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +46,7 @@ func (r *datasourceDemSpaApplications) Metadata(ctx context.Context, req datasou
 func (r *datasourceDemSpaApplications) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -58,44 +60,44 @@ func (r *datasourceDemSpaApplications) Schema(ctx context.Context, req datasourc
 				Computed: true,
 				Optional: true,
 			},
-			"latency_threshold": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(10000000),
+			"latency_threshold": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10000000),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"jitter_threshold": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(10000000),
+			"jitter_threshold": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10000000),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"packetloss_threshold": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(100),
+			"packetloss_threshold": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"interval": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(20, 3600000),
+			"interval": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(20, 3600000),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"fail_time": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 3600),
+			"fail_time": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3600),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"recovery_time": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 3600),
+			"recovery_time": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3600),
 				},
 				Computed: true,
 				Optional: true,
@@ -167,32 +169,34 @@ func (m *datasourceDemSpaApplicationsModel) refreshDemSpaApplications(ctx contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["server"]; ok {
 		m.Server = parseStringValue(v)
 	}
 
 	if v, ok := o["latencyThreshold"]; ok {
-		m.LatencyThreshold = parseFloat64Value(v)
+		m.LatencyThreshold = parseInt64Value(v)
 	}
 
 	if v, ok := o["jitterThreshold"]; ok {
-		m.JitterThreshold = parseFloat64Value(v)
+		m.JitterThreshold = parseInt64Value(v)
 	}
 
 	if v, ok := o["packetlossThreshold"]; ok {
-		m.PacketlossThreshold = parseFloat64Value(v)
+		m.PacketlossThreshold = parseInt64Value(v)
 	}
 
 	if v, ok := o["interval"]; ok {
-		m.Interval = parseFloat64Value(v)
+		m.Interval = parseInt64Value(v)
 	}
 
 	if v, ok := o["failTime"]; ok {
-		m.FailTime = parseFloat64Value(v)
+		m.FailTime = parseInt64Value(v)
 	}
 
 	if v, ok := o["recoveryTime"]; ok {
-		m.RecoveryTime = parseFloat64Value(v)
+		m.RecoveryTime = parseInt64Value(v)
 	}
 
 	return diags
@@ -0,0 +1,406 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &datasourceDependencyGraph{}
+
+func newDatasourceDependencyGraph() datasource.DataSource {
+	return &datasourceDependencyGraph{}
+}
+
+type datasourceDependencyGraph struct {
+	fortiClient  *FortiClient
+	resourceName string
+}
+
+// datasourceDependencyGraphModel describes the datasource data model.
+type datasourceDependencyGraphModel struct {
+	ID           types.String                         `tfsdk:"id"`
+	Types        types.List                           `tfsdk:"types"`
+	Nodes        []datasourceDependencyGraphNodeModel `tfsdk:"nodes"`
+	Edges        []datasourceDependencyGraphEdgeModel `tfsdk:"edges"`
+	Adjacency    types.Map                            `tfsdk:"adjacency"`
+	ReferencedBy types.Map                            `tfsdk:"referenced_by"`
+	Orphans      types.List                           `tfsdk:"orphans"`
+	Dot          types.String                         `tfsdk:"dot"`
+	Json         types.String                         `tfsdk:"json"`
+}
+
+type datasourceDependencyGraphNodeModel struct {
+	ID         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	PrimaryKey types.String `tfsdk:"primary_key"`
+	UsageCount types.Int64  `tfsdk:"usage_count"`
+}
+
+type datasourceDependencyGraphEdgeModel struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+func (r *datasourceDependencyGraph) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dependency_graph"
+}
+
+func (r *datasourceDependencyGraph) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var typeNames []string
+	for _, col := range exportCollections {
+		typeNames = append(typeNames, col.typeName)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Walks the objects of the tenant and the `{primary_key, datasource}` references between them. An edge goes from the referencing object to the referenced one. The usage endpoints are read for the objects that have one to also count the references from objects that are not walked, such as security profiles.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
+				Computed:            true,
+			},
+			"types": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The resource types whose objects are walked, e.g. `fortisase_network_hosts`. Defaults to all the resource types whose objects can be listed.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(typeNames...)),
+				},
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "The walked objects and the objects they reference.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the node, `<type>.<primary_key>`.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The resource type of the object, or the datasource of the references for the objects that are not managed by a resource.",
+							Computed:            true,
+						},
+						"primary_key": schema.StringAttribute{
+							MarkdownDescription: "The primary key of the object.",
+							Computed:            true,
+						},
+						"usage_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of objects referencing the object as reported by its usage endpoint, null when the object has no usage endpoint or its usage cannot be read, a warning lists the latter.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				MarkdownDescription: "The references between the nodes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the referencing node.",
+							Computed:            true,
+						},
+						"to": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the referenced node.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"adjacency": schema.MapAttribute{
+				ElementType:         types.ListType{ElemType: types.StringType},
+				MarkdownDescription: "The identifiers of the nodes referenced by each node.",
+				Computed:            true,
+			},
+			"referenced_by": schema.MapAttribute{
+				ElementType:         types.ListType{ElemType: types.StringType},
+				MarkdownDescription: "The identifiers of the nodes referencing each node, i.e. what breaks when the node is deleted.",
+				Computed:            true,
+			},
+			"orphans": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The identifiers of the walked nodes that have a usage endpoint and that nothing references.",
+				Computed:            true,
+			},
+			"dot": schema.StringAttribute{
+				MarkdownDescription: "The graph in the DOT language of Graphviz.",
+				Computed:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The nodes and edges of the graph in JSON.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *datasourceDependencyGraph) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.resourceName = "fortisase_dependency_graph"
+}
+
+func (r *datasourceDependencyGraph) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	diags := &resp.Diagnostics
+	var data datasourceDependencyGraphModel
+
+	// Read Terraform prior config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	var typeNames []string
+	diags.Append(data.Types.ElementsAs(ctx, &typeNames, false)...)
+	if diags.HasError() {
+		return
+	}
+	selected := make(map[string]bool)
+	for _, v := range typeNames {
+		selected[v] = true
+	}
+
+	c := r.fortiClient.Client
+	graph := newDependencyGraph()
+	for i := range exportCollections {
+		col := &exportCollections[i]
+		if len(selected) > 0 && !selected[col.typeName] {
+			continue
+		}
+
+		output, err := col.list(ctx, c)
+		if err != nil {
+			diags.AddWarning(
+				fmt.Sprintf("Error to read the objects of %s: %v", col.typeName, err),
+				fmt.Sprintf("The objects of %s are missing from data source %s.", col.typeName, r.resourceName),
+			)
+			continue
+		}
+		for _, o := range output {
+			from := graph.addNode(col.typeName, col.datasource(), col.objectKey(o), true)
+			graph.addReferences(from, o)
+		}
+	}
+
+	var failed []string
+	for _, n := range graph.nodes {
+		if !n.walked || !usageDatasources[n.datasource] {
+			continue
+		}
+		usage, err := readUsage(ctx, c, n.datasource, n.primaryKey)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("- %s %q: %v", n.typeName, n.primaryKey, err))
+			continue
+		}
+		count := 0
+		for _, v := range usage {
+			count += v
+		}
+		n.usageCount = &count
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		diags.AddWarning(
+			fmt.Sprintf("Error to read the usage of %d objects", len(failed)),
+			fmt.Sprintf("The usage_count of these objects is null in data source %s:\n%s", r.resourceName, strings.Join(failed, "\n")),
+		)
+	}
+
+	data.ID = types.StringValue(r.resourceName)
+	diags.Append(graph.refresh(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dependencyNode is an object of the tenant in a dependency graph.
+type dependencyNode struct {
+	id         string
+	typeName   string
+	datasource string
+	primaryKey string
+	walked     bool
+	usageCount *int
+}
+
+// dependencyGraph is the graph of the {primaryKey, datasource} references between the objects of the tenant.
+type dependencyGraph struct {
+	nodes        map[string]*dependencyNode
+	adjacency    map[string][]string
+	referencedBy map[string][]string
+	typeNames    map[string]string
+}
+
+func newDependencyGraph() *dependencyGraph {
+	g := &dependencyGraph{
+		nodes:        make(map[string]*dependencyNode),
+		adjacency:    make(map[string][]string),
+		referencedBy: make(map[string][]string),
+		typeNames:    make(map[string]string),
+	}
+	for _, col := range exportCollections {
+		g.typeNames[col.datasource()] = col.typeName
+	}
+	return g
+}
+
+// addNode adds the node of an object if it is missing and returns its identifier.
+func (g *dependencyGraph) addNode(typeName string, datasource string, primaryKey string, walked bool) string {
+	id := typeName + "." + primaryKey
+	n, ok := g.nodes[id]
+	if !ok {
+		n = &dependencyNode{id: id, typeName: typeName, datasource: datasource, primaryKey: primaryKey}
+		g.nodes[id] = n
+		g.adjacency[id] = []string{}
+		g.referencedBy[id] = []string{}
+	}
+	n.walked = n.walked || walked
+	return id
+}
+
+// addReferences adds an edge from the node from to each object referenced in v at any depth.
+func (g *dependencyGraph) addReferences(from string, v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		datasource := fortiStringValue(v["datasource"])
+		primaryKey := fortiStringValue(v["primaryKey"])
+		if datasource != "" && primaryKey != "" {
+			typeName, ok := g.typeNames[datasource]
+			if !ok {
+				typeName = datasource
+			}
+			to := g.addNode(typeName, datasource, primaryKey, false)
+			if to != from && !policyOrderContains(g.adjacency[from], to) {
+				g.adjacency[from] = append(g.adjacency[from], to)
+				g.referencedBy[to] = append(g.referencedBy[to], from)
+			}
+		}
+		for _, e := range v {
+			g.addReferences(from, e)
+		}
+	case []interface{}:
+		for _, e := range v {
+			g.addReferences(from, e)
+		}
+	}
+}
+
+// refresh sets the computed attributes of the data source from the graph.
+func (g *dependencyGraph) refresh(ctx context.Context, data *datasourceDependencyGraphModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := make([]string, 0, len(g.nodes))
+	for id := range g.nodes {
+		ids = append(ids, id)
+		sort.Strings(g.adjacency[id])
+		sort.Strings(g.referencedBy[id])
+	}
+	sort.Strings(ids)
+
+	data.Nodes = make([]datasourceDependencyGraphNodeModel, 0, len(ids))
+	data.Edges = make([]datasourceDependencyGraphEdgeModel, 0)
+	orphans := make([]string, 0)
+	for _, id := range ids {
+		n := g.nodes[id]
+		node := datasourceDependencyGraphNodeModel{
+			ID:         types.StringValue(id),
+			Type:       types.StringValue(n.typeName),
+			PrimaryKey: types.StringValue(n.primaryKey),
+			UsageCount: types.Int64Null(),
+		}
+		if n.usageCount != nil {
+			node.UsageCount = types.Int64Value(int64(*n.usageCount))
+			if *n.usageCount == 0 && len(g.referencedBy[id]) == 0 {
+				orphans = append(orphans, id)
+			}
+		}
+		data.Nodes = append(data.Nodes, node)
+		for _, to := range g.adjacency[id] {
+			data.Edges = append(data.Edges, datasourceDependencyGraphEdgeModel{
+				From: types.StringValue(id),
+				To:   types.StringValue(to),
+			})
+		}
+	}
+
+	var d diag.Diagnostics
+	data.Adjacency, d = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, g.adjacency)
+	diags.Append(d...)
+	data.ReferencedBy, d = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, g.referencedBy)
+	diags.Append(d...)
+	data.Orphans, d = types.ListValueFrom(ctx, types.StringType, orphans)
+	diags.Append(d...)
+
+	var dot strings.Builder
+	dot.WriteString("digraph fortisase {\n")
+	for _, node := range data.Nodes {
+		fmt.Fprintf(&dot, "  %s;\n", strconv.Quote(node.ID.ValueString()))
+	}
+	for _, edge := range data.Edges {
+		fmt.Fprintf(&dot, "  %s -> %s;\n", strconv.Quote(edge.From.ValueString()), strconv.Quote(edge.To.ValueString()))
+	}
+	dot.WriteString("}\n")
+	data.Dot = types.StringValue(dot.String())
+
+	type jsonNode struct {
+		ID         string `json:"id"`
+		Type       string `json:"type"`
+		PrimaryKey string `json:"primary_key"`
+		UsageCount *int   `json:"usage_count"`
+	}
+	type jsonEdge struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+	graph := struct {
+		Nodes []jsonNode `json:"nodes"`
+		Edges []jsonEdge `json:"edges"`
+	}{Nodes: []jsonNode{}, Edges: []jsonEdge{}}
+	for _, id := range ids {
+		n := g.nodes[id]
+		graph.Nodes = append(graph.Nodes, jsonNode{id, n.typeName, n.primaryKey, n.usageCount})
+	}
+	for _, edge := range data.Edges {
+		graph.Edges = append(graph.Edges, jsonEdge{edge.From.ValueString(), edge.To.ValueString()})
+	}
+	value, err := json.Marshal(graph)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to encode the graph: %v", err), "")
+		return diags
+	}
+	data.Json = types.StringValue(string(value))
+
+	return diags
+}
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -40,12 +40,13 @@ type datasourceEndpointConnectionProfilesModel struct {
 	PreferredDtlsTunnel            types.String                                                   `tfsdk:"preferred_dtls_tunnel"`
 	UseGuiSamlAuth                 types.String                                                   `tfsdk:"use_gui_saml_auth"`
 	AllowPersonalVpns              types.Bool                                                     `tfsdk:"allow_personal_vpns"`
-	MtuSize                        types.Float64                                                  `tfsdk:"mtu_size"`
+	MtuSize                        types.Int64                                                    `tfsdk:"mtu_size"`
 	AvailableVpNs                  []datasourceEndpointConnectionProfilesAvailableVpNsModel       `tfsdk:"available_vp_ns"`
 	ShowDisconnectBtn              types.String                                                   `tfsdk:"show_disconnect_btn"`
 	EnableInvalidServerCertWarning types.String                                                   `tfsdk:"enable_invalid_server_cert_warning"`
 	PreLogon                       *datasourceEndpointConnectionProfilesPreLogonModel             `tfsdk:"pre_logon"`
 	PrimaryKey                     types.String                                                   `tfsdk:"primary_key"`
+	RawJson                        types.String                                                   `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointConnectionProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -55,6 +56,7 @@ func (r *datasourceEndpointConnectionProfiles) Metadata(ctx context.Context, req
 func (r *datasourceEndpointConnectionProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"connect_to_forti_sase": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("automatically", "manually"),
@@ -95,9 +97,9 @@ func (r *datasourceEndpointConnectionProfiles) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"mtu_size": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(576, 1500),
+			"mtu_size": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(576, 1500),
 				},
 				Computed: true,
 				Optional: true,
@@ -129,13 +131,16 @@ func (r *datasourceEndpointConnectionProfiles) Schema(ctx context.Context, req d
 						Computed: true,
 						Optional: true,
 					},
-					"grace_period": schema.Float64Attribute{
+					"grace_period": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
 						Computed: true,
 						Optional: true,
 					},
-					"max_attempts": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.AtLeast(1),
+					"max_attempts": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
 						},
 						Computed: true,
 						Optional: true,
@@ -474,16 +479,16 @@ func (r *datasourceEndpointConnectionProfiles) Schema(ctx context.Context, req d
 							Computed: true,
 							Optional: true,
 						},
-						"udp_port": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(500, 65535),
+						"udp_port": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(500, 65535),
 							},
 							Computed: true,
 							Optional: true,
 						},
-						"tcp_port": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 65535),
+						"tcp_port": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
 							},
 							Computed: true,
 							Optional: true,
@@ -495,9 +500,9 @@ func (r *datasourceEndpointConnectionProfiles) Schema(ctx context.Context, req d
 							Computed: true,
 							Optional: true,
 						},
-						"port": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.AtMost(65535),
+						"port": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
 							},
 							Computed: true,
 							Optional: true,
@@ -524,8 +529,9 @@ func (r *datasourceEndpointConnectionProfiles) Schema(ctx context.Context, req d
 							Optional: true,
 						},
 						"pre_shared_key": schema.StringAttribute{
-							Computed: true,
-							Optional: true,
+							Sensitive: true,
+							Computed:  true,
+							Optional:  true,
 						},
 						"posture_check": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
@@ -566,9 +572,9 @@ func (r *datasourceEndpointConnectionProfiles) Schema(ctx context.Context, req d
 						Computed: true,
 						Optional: true,
 					},
-					"port": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.AtMost(65535),
+					"port": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(0, 65535),
 						},
 						Computed: true,
 						Optional: true,
@@ -684,6 +690,8 @@ func (m *datasourceEndpointConnectionProfilesModel) refreshEndpointConnectionPro
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["connectToFortiSASE"]; ok {
 		m.ConnectToFortiSase = parseStringValue(v)
 	}
@@ -733,7 +741,7 @@ func (m *datasourceEndpointConnectionProfilesModel) refreshEndpointConnectionPro
 	}
 
 	if v, ok := o["mtuSize"]; ok {
-		m.MtuSize = parseFloat64Value(v)
+		m.MtuSize = parseInt64Value(v)
 	}
 
 	if v, ok := o["availableVPNs"]; ok {
@@ -766,8 +774,8 @@ func (data *datasourceEndpointConnectionProfilesModel) getURLObjectEndpointConne
 
 type datasourceEndpointConnectionProfilesLockdownModel struct {
 	Status              types.String                                                          `tfsdk:"status"`
-	GracePeriod         types.Float64                                                         `tfsdk:"grace_period"`
-	MaxAttempts         types.Float64                                                         `tfsdk:"max_attempts"`
+	GracePeriod         types.Int64                                                           `tfsdk:"grace_period"`
+	MaxAttempts         types.Int64                                                           `tfsdk:"max_attempts"`
 	Ips                 []datasourceEndpointConnectionProfilesLockdownIpsModel                `tfsdk:"ips"`
 	Domains             []datasourceEndpointConnectionProfilesLockdownDomainsModel            `tfsdk:"domains"`
 	DetectCaptivePortal *datasourceEndpointConnectionProfilesLockdownDetectCaptivePortalModel `tfsdk:"detect_captive_portal"`
@@ -856,10 +864,10 @@ type datasourceEndpointConnectionProfilesAvailableVpNsModel struct {
 	AllowFidoAuth            types.String                                                        `tfsdk:"allow_fido_auth"`
 	EnableLocalLan           types.String                                                        `tfsdk:"enable_local_lan"`
 	EncapsulationMode        types.String                                                        `tfsdk:"encapsulation_mode"`
-	UdpPort                  types.Float64                                                       `tfsdk:"udp_port"`
-	TcpPort                  types.Float64                                                       `tfsdk:"tcp_port"`
+	UdpPort                  types.Int64                                                         `tfsdk:"udp_port"`
+	TcpPort                  types.Int64                                                         `tfsdk:"tcp_port"`
 	ExternalBrowserSamlLogin types.String                                                        `tfsdk:"external_browser_saml_login"`
-	Port                     types.Float64                                                       `tfsdk:"port"`
+	Port                     types.Int64                                                         `tfsdk:"port"`
 	RequireCertificate       types.String                                                        `tfsdk:"require_certificate"`
 	AuthMethod               types.String                                                        `tfsdk:"auth_method"`
 	ShowPasscode             types.String                                                        `tfsdk:"show_passcode"`
@@ -878,7 +886,7 @@ type datasourceEndpointConnectionProfilesPreLogonModel struct {
 	RemoteGateway types.String                                                 `tfsdk:"remote_gateway"`
 	CommonName    *datasourceEndpointConnectionProfilesPreLogonCommonNameModel `tfsdk:"common_name"`
 	Issuer        *datasourceEndpointConnectionProfilesPreLogonIssuerModel     `tfsdk:"issuer"`
-	Port          types.Float64                                                `tfsdk:"port"`
+	Port          types.Int64                                                  `tfsdk:"port"`
 }
 
 type datasourceEndpointConnectionProfilesPreLogonCommonNameModel struct {
@@ -904,11 +912,11 @@ func (m *datasourceEndpointConnectionProfilesLockdownModel) flattenEndpointConne
 	}
 
 	if v, ok := o["gracePeriod"]; ok {
-		m.GracePeriod = parseFloat64Value(v)
+		m.GracePeriod = parseInt64Value(v)
 	}
 
 	if v, ok := o["maxAttempts"]; ok {
-		m.MaxAttempts = parseFloat64Value(v)
+		m.MaxAttempts = parseInt64Value(v)
 	}
 
 	if v, ok := o["ips"]; ok {
@@ -1395,11 +1403,11 @@ func (m *datasourceEndpointConnectionProfilesAvailableVpNsModel) flattenEndpoint
 	}
 
 	if v, ok := o["udpPort"]; ok {
-		m.UdpPort = parseFloat64Value(v)
+		m.UdpPort = parseInt64Value(v)
 	}
 
 	if v, ok := o["tcpPort"]; ok {
-		m.TcpPort = parseFloat64Value(v)
+		m.TcpPort = parseInt64Value(v)
 	}
 
 	if v, ok := o["externalBrowserSamlLogin"]; ok {
@@ -1407,7 +1415,7 @@ func (m *datasourceEndpointConnectionProfilesAvailableVpNsModel) flattenEndpoint
 	}
 
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	if v, ok := o["requireCertificate"]; ok {
@@ -1505,7 +1513,7 @@ func (m *datasourceEndpointConnectionProfilesPreLogonModel) flattenEndpointConne
 	}
 
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	return m
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -28,12 +28,13 @@ type datasourceEndpointFssoProfiles struct {
 
 // datasourceEndpointFssoProfilesModel describes the datasource data model.
 type datasourceEndpointFssoProfilesModel struct {
-	Enabled       types.Bool    `tfsdk:"enabled"`
-	PreferEntraId types.String  `tfsdk:"prefer_entra_id"`
-	Host          types.String  `tfsdk:"host"`
-	Port          types.Float64 `tfsdk:"port"`
-	PreSharedKey  types.String  `tfsdk:"pre_shared_key"`
-	PrimaryKey    types.String  `tfsdk:"primary_key"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	PreferEntraId types.String `tfsdk:"prefer_entra_id"`
+	Host          types.String `tfsdk:"host"`
+	Port          types.Int64  `tfsdk:"port"`
+	PreSharedKey  types.String `tfsdk:"pre_shared_key"`
+	PrimaryKey    types.String `tfsdk:"primary_key"`
+	RawJson       types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointFssoProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,6 +44,7 @@ func (r *datasourceEndpointFssoProfiles) Metadata(ctx context.Context, req datas
 func (r *datasourceEndpointFssoProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"enabled": schema.BoolAttribute{
 				Computed: true,
 				Optional: true,
@@ -58,16 +60,17 @@ func (r *datasourceEndpointFssoProfiles) Schema(ctx context.Context, req datasou
 				Computed: true,
 				Optional: true,
 			},
-			"port": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(65535),
+			"port": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
 				},
 				Computed: true,
 				Optional: true,
 			},
 			"pre_shared_key": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
@@ -140,6 +143,8 @@ func (m *datasourceEndpointFssoProfilesModel) refreshEndpointFssoProfiles(ctx co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
@@ -153,7 +158,7 @@ func (m *datasourceEndpointFssoProfilesModel) refreshEndpointFssoProfiles(ctx co
 	}
 
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	if v, ok := o["preSharedKey"]; ok {
@@ -28,6 +28,7 @@ type datasourceEndpointGroupAdUserProfilesModel struct {
 	AdUserIds  types.Set    `tfsdk:"ad_user_ids"`
 	GroupIds   types.Set    `tfsdk:"group_ids"`
 	PrimaryKey types.String `tfsdk:"primary_key"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointGroupAdUserProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +38,7 @@ func (r *datasourceEndpointGroupAdUserProfiles) Metadata(ctx context.Context, re
 func (r *datasourceEndpointGroupAdUserProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"ad_user_ids": schema.SetAttribute{
 				Computed:    true,
 				Optional:    true,
@@ -118,6 +120,8 @@ func (m *datasourceEndpointGroupAdUserProfilesModel) refreshEndpointGroupAdUserP
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["adUserIds"]; ok {
 		m.AdUserIds = parseSetValue(ctx, v, types.Int64Type)
 	}
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,6 +31,7 @@ type datasourceEndpointGroupInvitationCodesModel struct {
 	PrimaryKey      types.String                                                `tfsdk:"primary_key"`
 	ExpireDate      types.String                                                `tfsdk:"expire_date"`
 	GroupAssignment *datasourceEndpointGroupInvitationCodesGroupAssignmentModel `tfsdk:"group_assignment"`
+	RawJson         types.String                                                `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointGroupInvitationCodes) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,6 +41,7 @@ func (r *datasourceEndpointGroupInvitationCodes) Metadata(ctx context.Context, r
 func (r *datasourceEndpointGroupInvitationCodes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -58,9 +60,9 @@ func (r *datasourceEndpointGroupInvitationCodes) Schema(ctx context.Context, req
 					},
 					"group": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
-							"id": schema.Float64Attribute{
-								Validators: []validator.Float64{
-									float64validator.AtLeast(1),
+							"id": schema.Int64Attribute{
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
 								},
 								Computed: true,
 								Optional: true,
@@ -147,6 +149,8 @@ func (m *datasourceEndpointGroupInvitationCodesModel) refreshEndpointGroupInvita
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["expireDate"]; ok {
 		m.ExpireDate = parseStringValue(v)
 	}
@@ -173,8 +177,8 @@ type datasourceEndpointGroupInvitationCodesGroupAssignmentModel struct {
 }
 
 type datasourceEndpointGroupInvitationCodesGroupAssignmentGroupModel struct {
-	Id   types.Float64 `tfsdk:"id"`
-	Path types.String  `tfsdk:"path"`
+	Id   types.Int64  `tfsdk:"id"`
+	Path types.String `tfsdk:"path"`
 }
 
 func (m *datasourceEndpointGroupInvitationCodesGroupAssignmentModel) flattenEndpointGroupInvitationCodesGroupAssignment(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceEndpointGroupInvitationCodesGroupAssignmentModel {
@@ -205,7 +209,7 @@ func (m *datasourceEndpointGroupInvitationCodesGroupAssignmentGroupModel) flatte
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["path"]; ok {
@@ -39,6 +39,7 @@ type datasourceEndpointOnNetRulesModel struct {
 	WebRequestHttp  types.String                                       `tfsdk:"web_request_http"`
 	WebRequestHttps []datasourceEndpointOnNetRulesWebRequestHttpsModel `tfsdk:"web_request_https"`
 	DnsRequest      []datasourceEndpointOnNetRulesDnsRequestModel      `tfsdk:"dns_request"`
+	RawJson         types.String                                       `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointOnNetRules) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,6 +49,7 @@ func (r *datasourceEndpointOnNetRules) Metadata(ctx context.Context, req datasou
 func (r *datasourceEndpointOnNetRules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -189,6 +191,8 @@ func (m *datasourceEndpointOnNetRulesModel) refreshEndpointOnNetRules(ctx contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["publicIp"]; ok {
 		m.PublicIp = parseStringValue(v)
 	}
@@ -30,6 +30,7 @@ type datasourceEndpointPoliciesModel struct {
 	PrimaryKey                      types.String `tfsdk:"primary_key"`
 	Enabled                         types.Bool   `tfsdk:"enabled"`
 	SkipOffNetProfileCreationOnEdit types.Bool   `tfsdk:"skip_off_net_profile_creation_on_edit"`
+	RawJson                         types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointPolicies) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,6 +40,7 @@ func (r *datasourceEndpointPolicies) Metadata(ctx context.Context, req datasourc
 func (r *datasourceEndpointPolicies) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -120,6 +122,8 @@ func (m *datasourceEndpointPoliciesModel) refreshEndpointPolicies(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -43,6 +43,7 @@ type datasourceEndpointProtectionProfilesModel struct {
 	ScheduledScan                     *datasourceEndpointProtectionProfilesScheduledScanModel          `tfsdk:"scheduled_scan"`
 	ScheduledAntivirusScan            *datasourceEndpointProtectionProfilesScheduledAntivirusScanModel `tfsdk:"scheduled_antivirus_scan"`
 	PrimaryKey                        types.String                                                     `tfsdk:"primary_key"`
+	RawJson                           types.String                                                     `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointProtectionProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -52,6 +53,7 @@ func (r *datasourceEndpointProtectionProfiles) Metadata(ctx context.Context, req
 func (r *datasourceEndpointProtectionProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"antivirus": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("enable", "disable"),
@@ -202,9 +204,9 @@ func (r *datasourceEndpointProtectionProfiles) Schema(ctx context.Context, req d
 						Computed: true,
 						Optional: true,
 					},
-					"day": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(1, 31),
+					"day": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(1, 31),
 						},
 						Computed: true,
 						Optional: true,
@@ -233,9 +235,9 @@ func (r *datasourceEndpointProtectionProfiles) Schema(ctx context.Context, req d
 						Computed: true,
 						Optional: true,
 					},
-					"day": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(1, 31),
+					"day": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(1, 31),
 						},
 						Computed: true,
 						Optional: true,
@@ -311,6 +313,8 @@ func (m *datasourceEndpointProtectionProfilesModel) refreshEndpointProtectionPro
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["antivirus"]; ok {
 		m.Antivirus = parseStringValue(v)
 	}
@@ -396,16 +400,16 @@ type datasourceEndpointProtectionProfilesExclusionsModel struct {
 }
 
 type datasourceEndpointProtectionProfilesScheduledScanModel struct {
-	Time   types.String  `tfsdk:"time"`
-	Repeat types.String  `tfsdk:"repeat"`
-	Day    types.Float64 `tfsdk:"day"`
+	Time   types.String `tfsdk:"time"`
+	Repeat types.String `tfsdk:"repeat"`
+	Day    types.Int64  `tfsdk:"day"`
 }
 
 type datasourceEndpointProtectionProfilesScheduledAntivirusScanModel struct {
-	ScanType types.String  `tfsdk:"scan_type"`
-	Time     types.String  `tfsdk:"time"`
-	Repeat   types.String  `tfsdk:"repeat"`
-	Day      types.Float64 `tfsdk:"day"`
+	ScanType types.String `tfsdk:"scan_type"`
+	Time     types.String `tfsdk:"time"`
+	Repeat   types.String `tfsdk:"repeat"`
+	Day      types.Int64  `tfsdk:"day"`
 }
 
 func (m *datasourceEndpointProtectionProfilesRulesModel) flattenEndpointProtectionProfilesRules(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceEndpointProtectionProfilesRulesModel {
@@ -511,7 +515,7 @@ func (m *datasourceEndpointProtectionProfilesScheduledScanModel) flattenEndpoint
 	}
 
 	if v, ok := o["day"]; ok {
-		m.Day = parseFloat64Value(v)
+		m.Day = parseInt64Value(v)
 	}
 
 	return m
@@ -538,7 +542,7 @@ func (m *datasourceEndpointProtectionProfilesScheduledAntivirusScanModel) flatte
 	}
 
 	if v, ok := o["day"]; ok {
-		m.Day = parseFloat64Value(v)
+		m.Day = parseInt64Value(v)
 	}
 
 	return m
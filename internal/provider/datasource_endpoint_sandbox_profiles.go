@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -29,8 +29,8 @@ type datasourceEndpointSandboxProfiles struct {
 // datasourceEndpointSandboxProfilesModel describes the datasource data model.
 type datasourceEndpointSandboxProfilesModel struct {
 	SandboxMode                   types.String                                                 `tfsdk:"sandbox_mode"`
-	NotificationType              types.Float64                                                `tfsdk:"notification_type"`
-	TimeoutAwaitingSandboxResults types.Float64                                                `tfsdk:"timeout_awaiting_sandbox_results"`
+	NotificationType              types.Int64                                                  `tfsdk:"notification_type"`
+	TimeoutAwaitingSandboxResults types.Int64                                                  `tfsdk:"timeout_awaiting_sandbox_results"`
 	FileSubmissionOptions         *datasourceEndpointSandboxProfilesFileSubmissionOptionsModel `tfsdk:"file_submission_options"`
 	DetectionVerdictLevel         types.String                                                 `tfsdk:"detection_verdict_level"`
 	Exceptions                    *datasourceEndpointSandboxProfilesExceptionsModel            `tfsdk:"exceptions"`
@@ -40,6 +40,7 @@ type datasourceEndpointSandboxProfilesModel struct {
 	Username                      types.String                                                 `tfsdk:"username"`
 	Password                      types.String                                                 `tfsdk:"password"`
 	PrimaryKey                    types.String                                                 `tfsdk:"primary_key"`
+	RawJson                       types.String                                                 `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointSandboxProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,6 +50,7 @@ func (r *datasourceEndpointSandboxProfiles) Metadata(ctx context.Context, req da
 func (r *datasourceEndpointSandboxProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"sandbox_mode": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("Disabled", "FortiSASE", "StandaloneFortiSandbox"),
@@ -56,14 +58,17 @@ func (r *datasourceEndpointSandboxProfiles) Schema(ctx context.Context, req data
 				Computed: true,
 				Optional: true,
 			},
-			"notification_type": schema.Float64Attribute{
+			"notification_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				MarkdownDescription: "Integer representing how notifications should be handled on FortiSandbox file submission. 0 - display notification balloon when malware is detected in a submission. 1 - display a popup for all file submissions.",
 				Computed:            true,
 				Optional:            true,
 			},
-			"timeout_awaiting_sandbox_results": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(2147483647),
+			"timeout_awaiting_sandbox_results": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2147483647),
 				},
 				Computed: true,
 				Optional: true,
@@ -104,8 +109,9 @@ func (r *datasourceEndpointSandboxProfiles) Schema(ctx context.Context, req data
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
@@ -235,16 +241,18 @@ func (m *datasourceEndpointSandboxProfilesModel) refreshEndpointSandboxProfiles(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["sandboxMode"]; ok {
 		m.SandboxMode = parseStringValue(v)
 	}
 
 	if v, ok := o["notificationType"]; ok {
-		m.NotificationType = parseFloat64Value(v)
+		m.NotificationType = parseInt64Value(v)
 	}
 
 	if v, ok := o["timeoutAwaitingSandboxResults"]; ok {
-		m.TimeoutAwaitingSandboxResults = parseFloat64Value(v)
+		m.TimeoutAwaitingSandboxResults = parseInt64Value(v)
 	}
 
 	if v, ok := o["fileSubmissionOptions"]; ok {
@@ -34,6 +34,7 @@ type datasourceEndpointSettingProfilesModel struct {
 	UsersCanDisconnect    types.String `tfsdk:"users_can_disconnect"`
 	EmsDisconnectPassword types.String `tfsdk:"ems_disconnect_password"`
 	PrimaryKey            types.String `tfsdk:"primary_key"`
+	RawJson               types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointSettingProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,6 +44,7 @@ func (r *datasourceEndpointSettingProfiles) Metadata(ctx context.Context, req da
 func (r *datasourceEndpointSettingProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"allow_config_backup": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("enable", "disable"),
@@ -79,8 +81,9 @@ func (r *datasourceEndpointSettingProfiles) Schema(ctx context.Context, req data
 				Optional: true,
 			},
 			"ems_disconnect_password": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
@@ -153,6 +156,8 @@ func (m *datasourceEndpointSettingProfilesModel) refreshEndpointSettingProfiles(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["allowConfigBackup"]; ok {
 		m.AllowConfigBackup = parseStringValue(v)
 	}
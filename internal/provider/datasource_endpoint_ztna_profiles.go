@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,6 +32,7 @@ type datasourceEndpointZtnaProfilesModel struct {
 	ConnectionRules      []datasourceEndpointZtnaProfilesConnectionRulesModel `tfsdk:"connection_rules"`
 	EntraId              *datasourceEndpointZtnaProfilesEntraIdModel          `tfsdk:"entra_id"`
 	PrimaryKey           types.String                                         `tfsdk:"primary_key"`
+	RawJson              types.String                                         `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointZtnaProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,6 +42,7 @@ func (r *datasourceEndpointZtnaProfiles) Metadata(ctx context.Context, req datas
 func (r *datasourceEndpointZtnaProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"allow_automatic_sign_on": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("enable", "disable"),
@@ -54,7 +57,10 @@ func (r *datasourceEndpointZtnaProfiles) Schema(ctx context.Context, req datasou
 			"connection_rules": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.Float64Attribute{
+						"id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -92,7 +98,10 @@ func (r *datasourceEndpointZtnaProfiles) Schema(ctx context.Context, req datasou
 										Computed: true,
 										Optional: true,
 									},
-									"private_app_count": schema.Float64Attribute{
+									"private_app_count": schema.Int64Attribute{
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
 										Computed: true,
 										Optional: true,
 									},
@@ -198,6 +207,8 @@ func (m *datasourceEndpointZtnaProfilesModel) refreshEndpointZtnaProfiles(ctx co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["allowAutomaticSignOn"]; ok {
 		m.AllowAutomaticSignOn = parseStringValue(v)
 	}
@@ -223,7 +234,7 @@ func (data *datasourceEndpointZtnaProfilesModel) getURLObjectEndpointZtnaProfile
 }
 
 type datasourceEndpointZtnaProfilesConnectionRulesModel struct {
-	Id         types.Float64                                                `tfsdk:"id"`
+	Id         types.Int64                                                  `tfsdk:"id"`
 	Address    types.String                                                 `tfsdk:"address"`
 	Uid        types.String                                                 `tfsdk:"uid"`
 	Gateways   []datasourceEndpointZtnaProfilesConnectionRulesGatewaysModel `tfsdk:"gateways"`
@@ -234,10 +245,10 @@ type datasourceEndpointZtnaProfilesConnectionRulesModel struct {
 }
 
 type datasourceEndpointZtnaProfilesConnectionRulesGatewaysModel struct {
-	Alias           types.String  `tfsdk:"alias"`
-	PrivateAppCount types.Float64 `tfsdk:"private_app_count"`
-	Vip             types.String  `tfsdk:"vip"`
-	Redirect        types.String  `tfsdk:"redirect"`
+	Alias           types.String `tfsdk:"alias"`
+	PrivateAppCount types.Int64  `tfsdk:"private_app_count"`
+	Vip             types.String `tfsdk:"vip"`
+	Redirect        types.String `tfsdk:"redirect"`
 }
 
 type datasourceEndpointZtnaProfilesEntraIdModel struct {
@@ -254,7 +265,7 @@ func (m *datasourceEndpointZtnaProfilesConnectionRulesModel) flattenEndpointZtna
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["address"]; ok {
@@ -325,7 +336,7 @@ func (m *datasourceEndpointZtnaProfilesConnectionRulesGatewaysModel) flattenEndp
 	}
 
 	if v, ok := o["private_app_count"]; ok {
-		m.PrivateAppCount = parseFloat64Value(v)
+		m.PrivateAppCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["vip"]; ok {
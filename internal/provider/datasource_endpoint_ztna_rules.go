@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -34,6 +34,7 @@ type datasourceEndpointZtnaRulesModel struct {
 	Comments   types.String                            `tfsdk:"comments"`
 	Rules      []datasourceEndpointZtnaRulesRulesModel `tfsdk:"rules"`
 	Logic      *datasourceEndpointZtnaRulesLogicModel  `tfsdk:"logic"`
+	RawJson    types.String                            `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointZtnaRules) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,6 +44,7 @@ func (r *datasourceEndpointZtnaRules) Metadata(ctx context.Context, req datasour
 func (r *datasourceEndpointZtnaRules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -84,9 +86,9 @@ func (r *datasourceEndpointZtnaRules) Schema(ctx context.Context, req datasource
 				MarkdownDescription: "The property 'logic' is required when 'rules' are modified; otherwise, 'logic' will be set to a default value.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.AtLeast(1),
+						"id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
 							},
 							Computed: true,
 							Optional: true,
@@ -155,9 +157,9 @@ func (r *datasourceEndpointZtnaRules) Schema(ctx context.Context, req datasource
 							Computed: true,
 							Optional: true,
 						},
-						"check_updates_within_days": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 3653),
+						"check_updates_within_days": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 3653),
 							},
 							Computed: true,
 							Optional: true,
@@ -303,6 +305,8 @@ func (m *datasourceEndpointZtnaRulesModel) refreshEndpointZtnaRules(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["status"]; ok {
 		m.Status = parseStringValue(v)
 	}
@@ -341,7 +345,7 @@ type datasourceEndpointZtnaRulesTagModel struct {
 }
 
 type datasourceEndpointZtnaRulesRulesModel struct {
-	Id                      types.Float64                                   `tfsdk:"id"`
+	Id                      types.Int64                                     `tfsdk:"id"`
 	Os                      types.String                                    `tfsdk:"os"`
 	Type                    types.String                                    `tfsdk:"type"`
 	Service                 types.String                                    `tfsdk:"service"`
@@ -352,7 +356,7 @@ type datasourceEndpointZtnaRulesRulesModel struct {
 	Path                    types.String                                    `tfsdk:"path"`
 	Negated                 types.Bool                                      `tfsdk:"negated"`
 	EnableLatestUpdateCheck types.Bool                                      `tfsdk:"enable_latest_update_check"`
-	CheckUpdatesWithinDays  types.Float64                                   `tfsdk:"check_updates_within_days"`
+	CheckUpdatesWithinDays  types.Int64                                     `tfsdk:"check_updates_within_days"`
 	Comparator              types.String                                    `tfsdk:"comparator"`
 	Condition               *datasourceEndpointZtnaRulesRulesConditionModel `tfsdk:"condition"`
 	Content                 types.String                                    `tfsdk:"content"`
@@ -401,7 +405,7 @@ func (m *datasourceEndpointZtnaRulesRulesModel) flattenEndpointZtnaRulesRules(ct
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["os"]; ok {
@@ -445,7 +449,7 @@ func (m *datasourceEndpointZtnaRulesRulesModel) flattenEndpointZtnaRulesRules(ct
 	}
 
 	if v, ok := o["checkUpdatesWithinDays"]; ok {
-		m.CheckUpdatesWithinDays = parseFloat64Value(v)
+		m.CheckUpdatesWithinDays = parseInt64Value(v)
 	}
 
 	if v, ok := o["comparator"]; ok {
@@ -29,6 +29,7 @@ type datasourceEndpointZtnaTags struct {
 type datasourceEndpointZtnaTagsModel struct {
 	PrimaryKey types.String `tfsdk:"primary_key"`
 	Name       types.String `tfsdk:"name"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointZtnaTags) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,6 +39,7 @@ func (r *datasourceEndpointZtnaTags) Metadata(ctx context.Context, req datasourc
 func (r *datasourceEndpointZtnaTags) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 58),
@@ -118,6 +120,8 @@ func (m *datasourceEndpointZtnaTagsModel) refreshEndpointZtnaTags(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["name"]; ok {
 		m.Name = parseStringValue(v)
 	}
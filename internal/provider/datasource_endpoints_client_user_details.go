@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,7 +27,7 @@ type datasourceEndpointsClientUserDetails struct {
 
 // datasourceEndpointsClientUserDetailsModel describes the datasource data model.
 type datasourceEndpointsClientUserDetailsModel struct {
-	DeviceId                types.Float64                                             `tfsdk:"device_id"`
+	DeviceId                types.Int64                                               `tfsdk:"device_id"`
 	Host                    types.String                                              `tfsdk:"host"`
 	Alias                   types.String                                              `tfsdk:"alias"`
 	Name                    types.String                                              `tfsdk:"name"`
@@ -37,25 +37,25 @@ type datasourceEndpointsClientUserDetailsModel struct {
 	OsServicePack           types.String                                              `tfsdk:"os_service_pack"`
 	DistinguishedName       types.String                                              `tfsdk:"distinguished_name"`
 	GroupTag                types.String                                              `tfsdk:"group_tag"`
-	GroupId                 types.Float64                                             `tfsdk:"group_id"`
+	GroupId                 types.Int64                                               `tfsdk:"group_id"`
 	GroupName               types.String                                              `tfsdk:"group_name"`
 	OrigGroupName           types.String                                              `tfsdk:"orig_group_name"`
-	OrigGroupId             types.Float64                                             `tfsdk:"orig_group_id"`
-	DomainId                types.Float64                                             `tfsdk:"domain_id"`
+	OrigGroupId             types.Int64                                               `tfsdk:"orig_group_id"`
+	DomainId                types.Int64                                               `tfsdk:"domain_id"`
 	InstallerName           types.String                                              `tfsdk:"installer_name"`
-	DeploymentState         types.Float64                                             `tfsdk:"deployment_state"`
+	DeploymentState         types.Int64                                               `tfsdk:"deployment_state"`
 	ScheduledInstallTime    types.String                                              `tfsdk:"scheduled_install_time"`
-	InstallationState       types.Float64                                             `tfsdk:"installation_state"`
+	InstallationState       types.Int64                                               `tfsdk:"installation_state"`
 	DeploymentStateTime     types.String                                              `tfsdk:"deployment_state_time"`
 	DeploymentStateData     types.String                                              `tfsdk:"deployment_state_data"`
-	ForticlientId           types.Float64                                             `tfsdk:"forticlient_id"`
+	ForticlientId           types.Int64                                               `tfsdk:"forticlient_id"`
 	Uid                     types.String                                              `tfsdk:"uid"`
-	Caps                    types.Float64                                             `tfsdk:"caps"`
+	Caps                    types.Int64                                               `tfsdk:"caps"`
 	FctSn                   types.String                                              `tfsdk:"fct_sn"`
 	FgtSn                   types.String                                              `tfsdk:"fgt_sn"`
-	LastSeen                types.Float64                                             `tfsdk:"last_seen"`
-	Deregister              types.Float64                                             `tfsdk:"deregister"`
-	RunCmd                  types.Float64                                             `tfsdk:"run_cmd"`
+	LastSeen                types.Int64                                               `tfsdk:"last_seen"`
+	Deregister              types.Int64                                               `tfsdk:"deregister"`
+	RunCmd                  types.Int64                                               `tfsdk:"run_cmd"`
 	QuarantineMessage       types.String                                              `tfsdk:"quarantine_message"`
 	IsInstalled             types.Bool                                                `tfsdk:"is_installed"`
 	IsManaged               types.Bool                                                `tfsdk:"is_managed"`
@@ -67,7 +67,7 @@ type datasourceEndpointsClientUserDetailsModel struct {
 	IsQuarantined           types.Bool                                                `tfsdk:"is_quarantined"`
 	QuarantineAccessCode    types.String                                              `tfsdk:"quarantine_access_code"`
 	FctVersion              types.String                                              `tfsdk:"fct_version"`
-	ComparableFctVersion    types.Float64                                             `tfsdk:"comparable_fct_version"`
+	ComparableFctVersion    types.Int64                                               `tfsdk:"comparable_fct_version"`
 	UserDomain              types.String                                              `tfsdk:"user_domain"`
 	Service                 types.String                                              `tfsdk:"service"`
 	ProfileName             types.String                                              `tfsdk:"profile_name"`
@@ -98,39 +98,40 @@ type datasourceEndpointsClientUserDetailsModel struct {
 	RsInstalled             types.Bool                                                `tfsdk:"rs_installed"`
 	RsEnabled               types.Bool                                                `tfsdk:"rs_enabled"`
 	RsHidden                types.Bool                                                `tfsdk:"rs_hidden"`
-	AvLastScanType          types.Float64                                             `tfsdk:"av_last_scan_type"`
+	AvLastScanType          types.Int64                                               `tfsdk:"av_last_scan_type"`
 	AvLastScanDate          types.String                                              `tfsdk:"av_last_scan_date"`
 	AvLastFullScanDate      types.String                                              `tfsdk:"av_last_full_scan_date"`
-	AvLastCancelledScanType types.Float64                                             `tfsdk:"av_last_cancelled_scan_type"`
+	AvLastCancelledScanType types.Int64                                               `tfsdk:"av_last_cancelled_scan_type"`
 	AvLastCancelledScanDate types.String                                              `tfsdk:"av_last_cancelled_scan_date"`
 	AvScanScheduled         types.Bool                                                `tfsdk:"av_scan_scheduled"`
-	AvNextSchType           types.Float64                                             `tfsdk:"av_next_sch_type"`
-	AvNextScanOn            types.Float64                                             `tfsdk:"av_next_scan_on"`
-	AvNextScanHour          types.Float64                                             `tfsdk:"av_next_scan_hour"`
-	AvNextScanMin           types.Float64                                             `tfsdk:"av_next_scan_min"`
-	AvNextScanType          types.Float64                                             `tfsdk:"av_next_scan_type"`
+	AvNextSchType           types.Int64                                               `tfsdk:"av_next_sch_type"`
+	AvNextScanOn            types.Int64                                               `tfsdk:"av_next_scan_on"`
+	AvNextScanHour          types.Int64                                               `tfsdk:"av_next_scan_hour"`
+	AvNextScanMin           types.Int64                                               `tfsdk:"av_next_scan_min"`
+	AvNextScanType          types.Int64                                               `tfsdk:"av_next_scan_type"`
 	IsAvScanning            types.Bool                                                `tfsdk:"is_av_scanning"`
-	LastVulnScan            types.Float64                                             `tfsdk:"last_vuln_scan"`
+	LastVulnScan            types.Int64                                               `tfsdk:"last_vuln_scan"`
 	VulnScanStatus          types.String                                              `tfsdk:"vuln_scan_status"`
 	VulnNextScheduled       types.Bool                                                `tfsdk:"vuln_next_scheduled"`
-	VulnNextSchType         types.Float64                                             `tfsdk:"vuln_next_sch_type"`
-	VulnNextScanOn          types.Float64                                             `tfsdk:"vuln_next_scan_on"`
-	VulnNextStartHour       types.Float64                                             `tfsdk:"vuln_next_start_hour"`
-	VulnNextStartMin        types.Float64                                             `tfsdk:"vuln_next_start_min"`
+	VulnNextSchType         types.Int64                                               `tfsdk:"vuln_next_sch_type"`
+	VulnNextScanOn          types.Int64                                               `tfsdk:"vuln_next_scan_on"`
+	VulnNextStartHour       types.Int64                                               `tfsdk:"vuln_next_start_hour"`
+	VulnNextStartMin        types.Int64                                               `tfsdk:"vuln_next_start_min"`
 	IsVulnScanning          types.Bool                                                `tfsdk:"is_vuln_scanning"`
-	AvEventsCount           types.Float64                                             `tfsdk:"av_events_count"`
-	SbEventsCount           types.Float64                                             `tfsdk:"sb_events_count"`
-	FwEventsCount           types.Float64                                             `tfsdk:"fw_events_count"`
-	WfEventsCount           types.Float64                                             `tfsdk:"wf_events_count"`
-	VulnEventsCount         types.Float64                                             `tfsdk:"vuln_events_count"`
-	SysEventsCount          types.Float64                                             `tfsdk:"sys_events_count"`
-	VulnEventsMaxSeverity   types.Float64                                             `tfsdk:"vuln_events_max_severity"`
+	AvEventsCount           types.Int64                                               `tfsdk:"av_events_count"`
+	SbEventsCount           types.Int64                                               `tfsdk:"sb_events_count"`
+	FwEventsCount           types.Int64                                               `tfsdk:"fw_events_count"`
+	WfEventsCount           types.Int64                                               `tfsdk:"wf_events_count"`
+	VulnEventsCount         types.Int64                                               `tfsdk:"vuln_events_count"`
+	SysEventsCount          types.Int64                                               `tfsdk:"sys_events_count"`
+	VulnEventsMaxSeverity   types.Int64                                               `tfsdk:"vuln_events_max_severity"`
 	ConnDetails             []datasourceEndpointsClientUserDetailsConnDetailsModel    `tfsdk:"conn_details"`
 	HardwareDetails         *datasourceEndpointsClientUserDetailsHardwareDetailsModel `tfsdk:"hardware_details"`
 	Forensics               *datasourceEndpointsClientUserDetailsForensicsModel       `tfsdk:"forensics"`
 	ForensicsEnabled        types.Bool                                                `tfsdk:"forensics_enabled"`
 	Tags                    []datasourceEndpointsClientUserDetailsTagsModel           `tfsdk:"tags"`
-	ClientUserId            types.Float64                                             `tfsdk:"client_user_id"`
+	ClientUserId            types.Int64                                               `tfsdk:"client_user_id"`
+	RawJson                 types.String                                              `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointsClientUserDetails) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -140,7 +141,11 @@ func (r *datasourceEndpointsClientUserDetails) Metadata(ctx context.Context, req
 func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"device_id": schema.Float64Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
+			"device_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -180,7 +185,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"group_id": schema.Float64Attribute{
+			"group_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -192,11 +200,17 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"orig_group_id": schema.Float64Attribute{
+			"orig_group_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"domain_id": schema.Float64Attribute{
+			"domain_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -204,7 +218,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"deployment_state": schema.Float64Attribute{
+			"deployment_state": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -212,7 +229,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"installation_state": schema.Float64Attribute{
+			"installation_state": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -224,7 +244,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"forticlient_id": schema.Float64Attribute{
+			"forticlient_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -232,7 +255,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"caps": schema.Float64Attribute{
+			"caps": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -244,15 +270,24 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"last_seen": schema.Float64Attribute{
+			"last_seen": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"deregister": schema.Float64Attribute{
+			"deregister": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"run_cmd": schema.Float64Attribute{
+			"run_cmd": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -300,7 +335,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"comparable_fct_version": schema.Float64Attribute{
+			"comparable_fct_version": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -424,7 +462,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"av_last_scan_type": schema.Float64Attribute{
+			"av_last_scan_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -436,7 +477,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"av_last_cancelled_scan_type": schema.Float64Attribute{
+			"av_last_cancelled_scan_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -448,23 +492,38 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"av_next_sch_type": schema.Float64Attribute{
+			"av_next_sch_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"av_next_scan_on": schema.Float64Attribute{
+			"av_next_scan_on": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"av_next_scan_hour": schema.Float64Attribute{
+			"av_next_scan_hour": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"av_next_scan_min": schema.Float64Attribute{
+			"av_next_scan_min": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"av_next_scan_type": schema.Float64Attribute{
+			"av_next_scan_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -472,7 +531,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"last_vuln_scan": schema.Float64Attribute{
+			"last_vuln_scan": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -484,19 +546,31 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"vuln_next_sch_type": schema.Float64Attribute{
+			"vuln_next_sch_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"vuln_next_scan_on": schema.Float64Attribute{
+			"vuln_next_scan_on": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"vuln_next_start_hour": schema.Float64Attribute{
+			"vuln_next_start_hour": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"vuln_next_start_min": schema.Float64Attribute{
+			"vuln_next_start_min": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -504,31 +578,52 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"av_events_count": schema.Float64Attribute{
+			"av_events_count": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"sb_events_count": schema.Float64Attribute{
+			"sb_events_count": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"fw_events_count": schema.Float64Attribute{
+			"fw_events_count": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"wf_events_count": schema.Float64Attribute{
+			"wf_events_count": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"vuln_events_count": schema.Float64Attribute{
+			"vuln_events_count": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"sys_events_count": schema.Float64Attribute{
+			"sys_events_count": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"vuln_events_max_severity": schema.Float64Attribute{
+			"vuln_events_max_severity": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -536,9 +631,9 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 				Computed: true,
 				Optional: true,
 			},
-			"client_user_id": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtLeast(1),
+			"client_user_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
 				},
 				MarkdownDescription: "The client user ID of the endpoint.\nValue at least 1.",
 				Required:            true,
@@ -657,7 +752,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 						Computed: true,
 						Optional: true,
 					},
-					"fsr_task_id": schema.Float64Attribute{
+					"fsr_task_id": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
 						Computed: true,
 						Optional: true,
 					},
@@ -668,7 +766,10 @@ func (r *datasourceEndpointsClientUserDetails) Schema(ctx context.Context, req d
 			"tags": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.Float64Attribute{
+						"id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -718,7 +819,7 @@ func (r *datasourceEndpointsClientUserDetails) Read(ctx context.Context, req dat
 		return
 	}
 
-	mkey := data.ClientUserId.ValueFloat64()
+	mkey := data.ClientUserId.ValueInt64()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
@@ -748,8 +849,10 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["deviceId"]; ok {
-		m.DeviceId = parseFloat64Value(v)
+		m.DeviceId = parseInt64Value(v)
 	}
 
 	if v, ok := o["host"]; ok {
@@ -789,7 +892,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["groupId"]; ok {
-		m.GroupId = parseFloat64Value(v)
+		m.GroupId = parseInt64Value(v)
 	}
 
 	if v, ok := o["groupName"]; ok {
@@ -801,11 +904,11 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["origGroupId"]; ok {
-		m.OrigGroupId = parseFloat64Value(v)
+		m.OrigGroupId = parseInt64Value(v)
 	}
 
 	if v, ok := o["domainId"]; ok {
-		m.DomainId = parseFloat64Value(v)
+		m.DomainId = parseInt64Value(v)
 	}
 
 	if v, ok := o["installerName"]; ok {
@@ -813,7 +916,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["deploymentState"]; ok {
-		m.DeploymentState = parseFloat64Value(v)
+		m.DeploymentState = parseInt64Value(v)
 	}
 
 	if v, ok := o["scheduledInstallTime"]; ok {
@@ -821,7 +924,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["installationState"]; ok {
-		m.InstallationState = parseFloat64Value(v)
+		m.InstallationState = parseInt64Value(v)
 	}
 
 	if v, ok := o["deploymentStateTime"]; ok {
@@ -833,7 +936,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["forticlientId"]; ok {
-		m.ForticlientId = parseFloat64Value(v)
+		m.ForticlientId = parseInt64Value(v)
 	}
 
 	if v, ok := o["uid"]; ok {
@@ -841,7 +944,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["caps"]; ok {
-		m.Caps = parseFloat64Value(v)
+		m.Caps = parseInt64Value(v)
 	}
 
 	if v, ok := o["fctSn"]; ok {
@@ -853,15 +956,15 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["lastSeen"]; ok {
-		m.LastSeen = parseFloat64Value(v)
+		m.LastSeen = parseInt64Value(v)
 	}
 
 	if v, ok := o["deregister"]; ok {
-		m.Deregister = parseFloat64Value(v)
+		m.Deregister = parseInt64Value(v)
 	}
 
 	if v, ok := o["runCmd"]; ok {
-		m.RunCmd = parseFloat64Value(v)
+		m.RunCmd = parseInt64Value(v)
 	}
 
 	if v, ok := o["quarantineMessage"]; ok {
@@ -909,7 +1012,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["comparableFctVersion"]; ok {
-		m.ComparableFctVersion = parseFloat64Value(v)
+		m.ComparableFctVersion = parseInt64Value(v)
 	}
 
 	if v, ok := o["userDomain"]; ok {
@@ -1033,7 +1136,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["avLastScanType"]; ok {
-		m.AvLastScanType = parseFloat64Value(v)
+		m.AvLastScanType = parseInt64Value(v)
 	}
 
 	if v, ok := o["avLastScanDate"]; ok {
@@ -1045,7 +1148,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["avLastCancelledScanType"]; ok {
-		m.AvLastCancelledScanType = parseFloat64Value(v)
+		m.AvLastCancelledScanType = parseInt64Value(v)
 	}
 
 	if v, ok := o["avLastCancelledScanDate"]; ok {
@@ -1057,23 +1160,23 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["avNextSchType"]; ok {
-		m.AvNextSchType = parseFloat64Value(v)
+		m.AvNextSchType = parseInt64Value(v)
 	}
 
 	if v, ok := o["avNextScanOn"]; ok {
-		m.AvNextScanOn = parseFloat64Value(v)
+		m.AvNextScanOn = parseInt64Value(v)
 	}
 
 	if v, ok := o["avNextScanHour"]; ok {
-		m.AvNextScanHour = parseFloat64Value(v)
+		m.AvNextScanHour = parseInt64Value(v)
 	}
 
 	if v, ok := o["avNextScanMin"]; ok {
-		m.AvNextScanMin = parseFloat64Value(v)
+		m.AvNextScanMin = parseInt64Value(v)
 	}
 
 	if v, ok := o["avNextScanType"]; ok {
-		m.AvNextScanType = parseFloat64Value(v)
+		m.AvNextScanType = parseInt64Value(v)
 	}
 
 	if v, ok := o["isAvScanning"]; ok {
@@ -1081,7 +1184,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["lastVulnScan"]; ok {
-		m.LastVulnScan = parseFloat64Value(v)
+		m.LastVulnScan = parseInt64Value(v)
 	}
 
 	if v, ok := o["vulnScanStatus"]; ok {
@@ -1093,19 +1196,19 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["vulnNextSchType"]; ok {
-		m.VulnNextSchType = parseFloat64Value(v)
+		m.VulnNextSchType = parseInt64Value(v)
 	}
 
 	if v, ok := o["vulnNextScanOn"]; ok {
-		m.VulnNextScanOn = parseFloat64Value(v)
+		m.VulnNextScanOn = parseInt64Value(v)
 	}
 
 	if v, ok := o["vulnNextStartHour"]; ok {
-		m.VulnNextStartHour = parseFloat64Value(v)
+		m.VulnNextStartHour = parseInt64Value(v)
 	}
 
 	if v, ok := o["vulnNextStartMin"]; ok {
-		m.VulnNextStartMin = parseFloat64Value(v)
+		m.VulnNextStartMin = parseInt64Value(v)
 	}
 
 	if v, ok := o["isVulnScanning"]; ok {
@@ -1113,31 +1216,31 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 	}
 
 	if v, ok := o["avEventsCount"]; ok {
-		m.AvEventsCount = parseFloat64Value(v)
+		m.AvEventsCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["sbEventsCount"]; ok {
-		m.SbEventsCount = parseFloat64Value(v)
+		m.SbEventsCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["fwEventsCount"]; ok {
-		m.FwEventsCount = parseFloat64Value(v)
+		m.FwEventsCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["wfEventsCount"]; ok {
-		m.WfEventsCount = parseFloat64Value(v)
+		m.WfEventsCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["vulnEventsCount"]; ok {
-		m.VulnEventsCount = parseFloat64Value(v)
+		m.VulnEventsCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["sysEventsCount"]; ok {
-		m.SysEventsCount = parseFloat64Value(v)
+		m.SysEventsCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["vulnEventsMaxSeverity"]; ok {
-		m.VulnEventsMaxSeverity = parseFloat64Value(v)
+		m.VulnEventsMaxSeverity = parseInt64Value(v)
 	}
 
 	if v, ok := o["connDetails"]; ok {
@@ -1166,7 +1269,7 @@ func (m *datasourceEndpointsClientUserDetailsModel) refreshEndpointsClientUserDe
 func (data *datasourceEndpointsClientUserDetailsModel) getURLObjectEndpointsClientUserDetails(ctx context.Context, ope string, diags *diag.Diagnostics) *map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.ClientUserId.IsNull() {
-		result["clientUserId"] = data.ClientUserId.ValueFloat64()
+		result["clientUserId"] = data.ClientUserId.ValueInt64()
 	}
 
 	return &result
@@ -1200,18 +1303,18 @@ type datasourceEndpointsClientUserDetailsHardwareDetailsModel struct {
 }
 
 type datasourceEndpointsClientUserDetailsForensicsModel struct {
-	Guid           types.String  `tfsdk:"guid"`
-	Status         types.String  `tfsdk:"status"`
-	Verdict        types.String  `tfsdk:"verdict"`
-	ReportUrl      types.String  `tfsdk:"report_url"`
-	CompletionTime types.String  `tfsdk:"completion_time"`
-	UpdateTime     types.String  `tfsdk:"update_time"`
-	FsrTaskId      types.Float64 `tfsdk:"fsr_task_id"`
+	Guid           types.String `tfsdk:"guid"`
+	Status         types.String `tfsdk:"status"`
+	Verdict        types.String `tfsdk:"verdict"`
+	ReportUrl      types.String `tfsdk:"report_url"`
+	CompletionTime types.String `tfsdk:"completion_time"`
+	UpdateTime     types.String `tfsdk:"update_time"`
+	FsrTaskId      types.Int64  `tfsdk:"fsr_task_id"`
 }
 
 type datasourceEndpointsClientUserDetailsTagsModel struct {
-	Id   types.Float64 `tfsdk:"id"`
-	Name types.String  `tfsdk:"name"`
+	Id   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
 }
 
 func (m *datasourceEndpointsClientUserDetailsConnDetailsModel) flattenEndpointsClientUserDetailsConnDetails(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceEndpointsClientUserDetailsConnDetailsModel {
@@ -1376,7 +1479,7 @@ func (m *datasourceEndpointsClientUserDetailsForensicsModel) flattenEndpointsCli
 	}
 
 	if v, ok := o["fsrTaskId"]; ok {
-		m.FsrTaskId = parseFloat64Value(v)
+		m.FsrTaskId = parseInt64Value(v)
 	}
 
 	return m
@@ -1391,7 +1494,7 @@ func (m *datasourceEndpointsClientUserDetailsTagsModel) flattenEndpointsClientUs
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,10 +28,11 @@ type datasourceEndpointsDonut struct {
 
 // datasourceEndpointsDonutModel describes the datasource data model.
 type datasourceEndpointsDonutModel struct {
-	Token     types.String  `tfsdk:"token"`
-	Value     types.Float64 `tfsdk:"value"`
-	Name      types.String  `tfsdk:"name"`
-	DonutType types.String  `tfsdk:"donut_type"`
+	Token     types.String `tfsdk:"token"`
+	Value     types.Int64  `tfsdk:"value"`
+	Name      types.String `tfsdk:"name"`
+	DonutType types.String `tfsdk:"donut_type"`
+	RawJson   types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointsDonut) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,11 +42,15 @@ func (r *datasourceEndpointsDonut) Metadata(ctx context.Context, req datasource.
 func (r *datasourceEndpointsDonut) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"token": schema.StringAttribute{
 				Computed: true,
 				Optional: true,
 			},
-			"value": schema.Float64Attribute{
+			"value": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -125,12 +131,14 @@ func (m *datasourceEndpointsDonutModel) refreshEndpointsDonut(ctx context.Contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["token"]; ok {
 		m.Token = parseStringValue(v)
 	}
 
 	if v, ok := o["value"]; ok {
-		m.Value = parseFloat64Value(v)
+		m.Value = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -28,8 +28,9 @@ type datasourceEndpointsEndpointsWithSoftware struct {
 // datasourceEndpointsEndpointsWithSoftwareModel describes the datasource data model.
 type datasourceEndpointsEndpointsWithSoftwareModel struct {
 	Clients    []datasourceEndpointsEndpointsWithSoftwareClientsModel `tfsdk:"clients"`
-	Total      types.Float64                                          `tfsdk:"total"`
-	SoftwareId types.Float64                                          `tfsdk:"software_id"`
+	Total      types.Int64                                            `tfsdk:"total"`
+	SoftwareId types.Int64                                            `tfsdk:"software_id"`
+	RawJson    types.String                                           `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointsEndpointsWithSoftware) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,13 +40,17 @@ func (r *datasourceEndpointsEndpointsWithSoftware) Metadata(ctx context.Context,
 func (r *datasourceEndpointsEndpointsWithSoftware) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"total": schema.Float64Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
+			"total": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"software_id": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtLeast(1),
+			"software_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
 				},
 				MarkdownDescription: "The ID property of a specific software.\nValue at least 1.",
 				Required:            true,
@@ -53,15 +58,24 @@ func (r *datasourceEndpointsEndpointsWithSoftware) Schema(ctx context.Context, r
 			"clients": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"client_user_id": schema.Float64Attribute{
+						"client_user_id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
-						"client_id": schema.Float64Attribute{
+						"client_id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
-						"app_count": schema.Float64Attribute{
+						"app_count": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -69,7 +83,10 @@ func (r *datasourceEndpointsEndpointsWithSoftware) Schema(ctx context.Context, r
 							Computed: true,
 							Optional: true,
 						},
-						"device_id": schema.Float64Attribute{
+						"device_id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -85,7 +102,10 @@ func (r *datasourceEndpointsEndpointsWithSoftware) Schema(ctx context.Context, r
 							Computed: true,
 							Optional: true,
 						},
-						"user_id": schema.Float64Attribute{
+						"user_id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -139,7 +159,7 @@ func (r *datasourceEndpointsEndpointsWithSoftware) Read(ctx context.Context, req
 		return
 	}
 
-	mkey := data.SoftwareId.ValueFloat64()
+	mkey := data.SoftwareId.ValueInt64()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
@@ -169,12 +189,14 @@ func (m *datasourceEndpointsEndpointsWithSoftwareModel) refreshEndpointsEndpoint
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["clients"]; ok {
 		m.Clients = m.flattenEndpointsEndpointsWithSoftwareClientsList(ctx, v, &diags)
 	}
 
 	if v, ok := o["total"]; ok {
-		m.Total = parseFloat64Value(v)
+		m.Total = parseInt64Value(v)
 	}
 
 	return diags
@@ -183,24 +205,24 @@ func (m *datasourceEndpointsEndpointsWithSoftwareModel) refreshEndpointsEndpoint
 func (data *datasourceEndpointsEndpointsWithSoftwareModel) getURLObjectEndpointsEndpointsWithSoftware(ctx context.Context, ope string, diags *diag.Diagnostics) *map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.SoftwareId.IsNull() {
-		result["softwareId"] = data.SoftwareId.ValueFloat64()
+		result["softwareId"] = data.SoftwareId.ValueInt64()
 	}
 
 	return &result
 }
 
 type datasourceEndpointsEndpointsWithSoftwareClientsModel struct {
-	ClientUserId types.Float64 `tfsdk:"client_user_id"`
-	ClientId     types.Float64 `tfsdk:"client_id"`
-	AppCount     types.Float64 `tfsdk:"app_count"`
-	LastInstall  types.String  `tfsdk:"last_install"`
-	DeviceId     types.Float64 `tfsdk:"device_id"`
-	DeviceIp     types.String  `tfsdk:"device_ip"`
-	DeviceHost   types.String  `tfsdk:"device_host"`
-	DeviceOs     types.String  `tfsdk:"device_os"`
-	UserId       types.Float64 `tfsdk:"user_id"`
-	UserName     types.String  `tfsdk:"user_name"`
-	UserIcon     types.String  `tfsdk:"user_icon"`
+	ClientUserId types.Int64  `tfsdk:"client_user_id"`
+	ClientId     types.Int64  `tfsdk:"client_id"`
+	AppCount     types.Int64  `tfsdk:"app_count"`
+	LastInstall  types.String `tfsdk:"last_install"`
+	DeviceId     types.Int64  `tfsdk:"device_id"`
+	DeviceIp     types.String `tfsdk:"device_ip"`
+	DeviceHost   types.String `tfsdk:"device_host"`
+	DeviceOs     types.String `tfsdk:"device_os"`
+	UserId       types.Int64  `tfsdk:"user_id"`
+	UserName     types.String `tfsdk:"user_name"`
+	UserIcon     types.String `tfsdk:"user_icon"`
 }
 
 func (m *datasourceEndpointsEndpointsWithSoftwareClientsModel) flattenEndpointsEndpointsWithSoftwareClients(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceEndpointsEndpointsWithSoftwareClientsModel {
@@ -212,15 +234,15 @@ func (m *datasourceEndpointsEndpointsWithSoftwareClientsModel) flattenEndpointsE
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["clientUserId"]; ok {
-		m.ClientUserId = parseFloat64Value(v)
+		m.ClientUserId = parseInt64Value(v)
 	}
 
 	if v, ok := o["clientId"]; ok {
-		m.ClientId = parseFloat64Value(v)
+		m.ClientId = parseInt64Value(v)
 	}
 
 	if v, ok := o["appCount"]; ok {
-		m.AppCount = parseFloat64Value(v)
+		m.AppCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["lastInstall"]; ok {
@@ -228,7 +250,7 @@ func (m *datasourceEndpointsEndpointsWithSoftwareClientsModel) flattenEndpointsE
 	}
 
 	if v, ok := o["deviceId"]; ok {
-		m.DeviceId = parseFloat64Value(v)
+		m.DeviceId = parseInt64Value(v)
 	}
 
 	if v, ok := o["deviceIp"]; ok {
@@ -244,7 +266,7 @@ func (m *datasourceEndpointsEndpointsWithSoftwareClientsModel) flattenEndpointsE
 	}
 
 	if v, ok := o["userId"]; ok {
-		m.UserId = parseFloat64Value(v)
+		m.UserId = parseInt64Value(v)
 	}
 
 	if v, ok := o["userName"]; ok {
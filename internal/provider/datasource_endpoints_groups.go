@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -30,8 +31,9 @@ type datasourceEndpointsGroupsModel struct {
 	AdGroups    *datasourceEndpointsGroupsAdGroupsModel    `tfsdk:"ad_groups"`
 	NonAdGroups *datasourceEndpointsGroupsNonAdGroupsModel `tfsdk:"non_ad_groups"`
 	Guid        types.String                               `tfsdk:"guid"`
-	Offset      types.Float64                              `tfsdk:"offset"`
+	Offset      types.Int64                                `tfsdk:"offset"`
 	PrimaryKey  types.String                               `tfsdk:"primary_key"`
+	RawJson     types.String                               `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointsGroups) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,12 +43,16 @@ func (r *datasourceEndpointsGroups) Metadata(ctx context.Context, req datasource
 func (r *datasourceEndpointsGroups) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"guid": schema.StringAttribute{
 				MarkdownDescription: "UID of the group to expand to find child groups.",
 				Computed:            true,
 				Optional:            true,
 			},
-			"offset": schema.Float64Attribute{
+			"offset": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				MarkdownDescription: "Specifies the starting position of AD groups. Based on this the results will be seperated in AD groups and non AD groups, with AD groups containing a \"total\" count.",
 				Computed:            true,
 				Optional:            true,
@@ -57,14 +63,20 @@ func (r *datasourceEndpointsGroups) Schema(ctx context.Context, req datasource.S
 			},
 			"ad_groups": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
-					"total": schema.Float64Attribute{
+					"total": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
 						Computed: true,
 						Optional: true,
 					},
 					"data": schema.ListNestedAttribute{
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"id": schema.Float64Attribute{
+								"id": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
 									MarkdownDescription: "Id of the group.",
 									Computed:            true,
 									Optional:            true,
@@ -74,7 +86,10 @@ func (r *datasourceEndpointsGroups) Schema(ctx context.Context, req datasource.S
 									Computed:            true,
 									Optional:            true,
 								},
-								"parent_id": schema.Float64Attribute{
+								"parent_id": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
 									MarkdownDescription: "Parent id of the group.",
 									Computed:            true,
 									Optional:            true,
@@ -140,7 +155,10 @@ func (r *datasourceEndpointsGroups) Schema(ctx context.Context, req datasource.S
 					"data": schema.ListNestedAttribute{
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"id": schema.Float64Attribute{
+								"id": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
 									MarkdownDescription: "Id of the group.",
 									Computed:            true,
 									Optional:            true,
@@ -150,7 +168,10 @@ func (r *datasourceEndpointsGroups) Schema(ctx context.Context, req datasource.S
 									Computed:            true,
 									Optional:            true,
 								},
-								"parent_id": schema.Float64Attribute{
+								"parent_id": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
 									MarkdownDescription: "Parent id of the group.",
 									Computed:            true,
 									Optional:            true,
@@ -277,6 +298,8 @@ func (m *datasourceEndpointsGroupsModel) refreshEndpointsGroups(ctx context.Cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["adGroups"]; ok {
 		m.AdGroups = m.AdGroups.flattenEndpointsGroupsAdGroups(ctx, v, &diags)
 	}
@@ -295,7 +318,7 @@ func (data *datasourceEndpointsGroupsModel) getURLObjectEndpointsGroups(ctx cont
 	}
 
 	if !data.Offset.IsNull() {
-		result["offset"] = data.Offset.ValueFloat64()
+		result["offset"] = data.Offset.ValueInt64()
 	}
 
 	if !data.PrimaryKey.IsNull() {
@@ -307,13 +330,13 @@ func (data *datasourceEndpointsGroupsModel) getURLObjectEndpointsGroups(ctx cont
 
 type datasourceEndpointsGroupsAdGroupsModel struct {
 	Data  []datasourceEndpointsGroupsAdGroupsDataModel `tfsdk:"data"`
-	Total types.Float64                                `tfsdk:"total"`
+	Total types.Int64                                  `tfsdk:"total"`
 }
 
 type datasourceEndpointsGroupsAdGroupsDataModel struct {
-	Id            types.Float64                                     `tfsdk:"id"`
+	Id            types.Int64                                       `tfsdk:"id"`
 	Name          types.String                                      `tfsdk:"name"`
-	ParentId      types.Float64                                     `tfsdk:"parent_id"`
+	ParentId      types.Int64                                       `tfsdk:"parent_id"`
 	Guid          types.String                                      `tfsdk:"guid"`
 	Path          types.String                                      `tfsdk:"path"`
 	HasChild      types.Bool                                        `tfsdk:"has_child"`
@@ -332,9 +355,9 @@ type datasourceEndpointsGroupsNonAdGroupsModel struct {
 }
 
 type datasourceEndpointsGroupsNonAdGroupsDataModel struct {
-	Id            types.Float64                                        `tfsdk:"id"`
+	Id            types.Int64                                          `tfsdk:"id"`
 	Name          types.String                                         `tfsdk:"name"`
-	ParentId      types.Float64                                        `tfsdk:"parent_id"`
+	ParentId      types.Int64                                          `tfsdk:"parent_id"`
 	Guid          types.String                                         `tfsdk:"guid"`
 	Path          types.String                                         `tfsdk:"path"`
 	HasChild      types.Bool                                           `tfsdk:"has_child"`
@@ -361,7 +384,7 @@ func (m *datasourceEndpointsGroupsAdGroupsModel) flattenEndpointsGroupsAdGroups(
 	}
 
 	if v, ok := o["total"]; ok {
-		m.Total = parseFloat64Value(v)
+		m.Total = parseInt64Value(v)
 	}
 
 	return m
@@ -376,7 +399,7 @@ func (m *datasourceEndpointsGroupsAdGroupsDataModel) flattenEndpointsGroupsAdGro
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -384,7 +407,7 @@ func (m *datasourceEndpointsGroupsAdGroupsDataModel) flattenEndpointsGroupsAdGro
 	}
 
 	if v, ok := o["parentId"]; ok {
-		m.ParentId = parseFloat64Value(v)
+		m.ParentId = parseInt64Value(v)
 	}
 
 	if v, ok := o["guid"]; ok {
@@ -481,7 +504,7 @@ func (m *datasourceEndpointsGroupsNonAdGroupsDataModel) flattenEndpointsGroupsNo
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -489,7 +512,7 @@ func (m *datasourceEndpointsGroupsNonAdGroupsDataModel) flattenEndpointsGroupsNo
 	}
 
 	if v, ok := o["parentId"]; ok {
-		m.ParentId = parseFloat64Value(v)
+		m.ParentId = parseInt64Value(v)
 	}
 
 	if v, ok := o["guid"]; ok {
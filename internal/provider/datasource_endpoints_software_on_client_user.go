@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -28,7 +28,8 @@ type datasourceEndpointsSoftwareOnClientUser struct {
 // datasourceEndpointsSoftwareOnClientUserModel describes the datasource data model.
 type datasourceEndpointsSoftwareOnClientUserModel struct {
 	Software     []datasourceEndpointsSoftwareOnClientUserSoftwareModel `tfsdk:"software"`
-	ClientUserId types.Float64                                          `tfsdk:"client_user_id"`
+	ClientUserId types.Int64                                            `tfsdk:"client_user_id"`
+	RawJson      types.String                                           `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointsSoftwareOnClientUser) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,9 +39,10 @@ func (r *datasourceEndpointsSoftwareOnClientUser) Metadata(ctx context.Context,
 func (r *datasourceEndpointsSoftwareOnClientUser) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"client_user_id": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtLeast(1),
+			"raw_json": rawJSONDatasourceAttribute(),
+			"client_user_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
 				},
 				MarkdownDescription: "The client user ID of the endpoint client.\nValue at least 1.",
 				Required:            true,
@@ -48,7 +50,10 @@ func (r *datasourceEndpointsSoftwareOnClientUser) Schema(ctx context.Context, re
 			"software": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.Float64Attribute{
+						"id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -114,7 +119,7 @@ func (r *datasourceEndpointsSoftwareOnClientUser) Read(ctx context.Context, req
 		return
 	}
 
-	mkey := data.ClientUserId.ValueFloat64()
+	mkey := data.ClientUserId.ValueInt64()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
@@ -144,6 +149,8 @@ func (m *datasourceEndpointsSoftwareOnClientUserModel) refreshEndpointsSoftwareO
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["software"]; ok {
 		m.Software = m.flattenEndpointsSoftwareOnClientUserSoftwareList(ctx, v, &diags)
 	}
@@ -154,19 +161,19 @@ func (m *datasourceEndpointsSoftwareOnClientUserModel) refreshEndpointsSoftwareO
 func (data *datasourceEndpointsSoftwareOnClientUserModel) getURLObjectEndpointsSoftwareOnClientUser(ctx context.Context, ope string, diags *diag.Diagnostics) *map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.ClientUserId.IsNull() {
-		result["clientUserId"] = data.ClientUserId.ValueFloat64()
+		result["clientUserId"] = data.ClientUserId.ValueInt64()
 	}
 
 	return &result
 }
 
 type datasourceEndpointsSoftwareOnClientUserSoftwareModel struct {
-	Id          types.Float64 `tfsdk:"id"`
-	Name        types.String  `tfsdk:"name"`
-	Vendor      types.String  `tfsdk:"vendor"`
-	Version     types.String  `tfsdk:"version"`
-	Icon        types.String  `tfsdk:"icon"`
-	InstallDate types.String  `tfsdk:"install_date"`
+	Id          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Vendor      types.String `tfsdk:"vendor"`
+	Version     types.String `tfsdk:"version"`
+	Icon        types.String `tfsdk:"icon"`
+	InstallDate types.String `tfsdk:"install_date"`
 }
 
 func (m *datasourceEndpointsSoftwareOnClientUserSoftwareModel) flattenEndpointsSoftwareOnClientUserSoftware(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceEndpointsSoftwareOnClientUserSoftwareModel {
@@ -178,7 +185,7 @@ func (m *datasourceEndpointsSoftwareOnClientUserSoftwareModel) flattenEndpointsS
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -28,7 +28,8 @@ type datasourceEndpointsSoftwareOnEndpoint struct {
 // datasourceEndpointsSoftwareOnEndpointModel describes the datasource data model.
 type datasourceEndpointsSoftwareOnEndpointModel struct {
 	Software []datasourceEndpointsSoftwareOnEndpointSoftwareModel `tfsdk:"software"`
-	DeviceId types.Float64                                        `tfsdk:"device_id"`
+	DeviceId types.Int64                                          `tfsdk:"device_id"`
+	RawJson  types.String                                         `tfsdk:"raw_json"`
 }
 
 func (r *datasourceEndpointsSoftwareOnEndpoint) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,9 +39,10 @@ func (r *datasourceEndpointsSoftwareOnEndpoint) Metadata(ctx context.Context, re
 func (r *datasourceEndpointsSoftwareOnEndpoint) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"device_id": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtLeast(1),
+			"raw_json": rawJSONDatasourceAttribute(),
+			"device_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
 				},
 				MarkdownDescription: "The device ID of the endpoint.\nValue at least 1.",
 				Required:            true,
@@ -48,7 +50,10 @@ func (r *datasourceEndpointsSoftwareOnEndpoint) Schema(ctx context.Context, req
 			"software": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.Float64Attribute{
+						"id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -114,7 +119,7 @@ func (r *datasourceEndpointsSoftwareOnEndpoint) Read(ctx context.Context, req da
 		return
 	}
 
-	mkey := data.DeviceId.ValueFloat64()
+	mkey := data.DeviceId.ValueInt64()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
@@ -144,6 +149,8 @@ func (m *datasourceEndpointsSoftwareOnEndpointModel) refreshEndpointsSoftwareOnE
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["software"]; ok {
 		m.Software = m.flattenEndpointsSoftwareOnEndpointSoftwareList(ctx, v, &diags)
 	}
@@ -154,19 +161,19 @@ func (m *datasourceEndpointsSoftwareOnEndpointModel) refreshEndpointsSoftwareOnE
 func (data *datasourceEndpointsSoftwareOnEndpointModel) getURLObjectEndpointsSoftwareOnEndpoint(ctx context.Context, ope string, diags *diag.Diagnostics) *map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.DeviceId.IsNull() {
-		result["deviceId"] = data.DeviceId.ValueFloat64()
+		result["deviceId"] = data.DeviceId.ValueInt64()
 	}
 
 	return &result
 }
 
 type datasourceEndpointsSoftwareOnEndpointSoftwareModel struct {
-	Id          types.Float64 `tfsdk:"id"`
-	Name        types.String  `tfsdk:"name"`
-	Vendor      types.String  `tfsdk:"vendor"`
-	Version     types.String  `tfsdk:"version"`
-	Icon        types.String  `tfsdk:"icon"`
-	InstallDate types.String  `tfsdk:"install_date"`
+	Id          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Vendor      types.String `tfsdk:"vendor"`
+	Version     types.String `tfsdk:"version"`
+	Icon        types.String `tfsdk:"icon"`
+	InstallDate types.String `tfsdk:"install_date"`
 }
 
 func (m *datasourceEndpointsSoftwareOnEndpointSoftwareModel) flattenEndpointsSoftwareOnEndpointSoftware(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceEndpointsSoftwareOnEndpointSoftwareModel {
@@ -178,7 +185,7 @@ func (m *datasourceEndpointsSoftwareOnEndpointSoftwareModel) flattenEndpointsSof
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
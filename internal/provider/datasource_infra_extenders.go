@@ -29,6 +29,7 @@ type datasourceInfraExtenders struct {
 type datasourceInfraExtendersModel struct {
 	PrimaryKey types.String `tfsdk:"primary_key"`
 	Status     types.String `tfsdk:"status"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceInfraExtenders) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,6 +39,7 @@ func (r *datasourceInfraExtenders) Metadata(ctx context.Context, req datasource.
 func (r *datasourceInfraExtenders) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -115,6 +117,8 @@ func (m *datasourceInfraExtendersModel) refreshInfraExtenders(ctx context.Contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["status"]; ok {
 		m.Status = parseStringValue(v)
 	}
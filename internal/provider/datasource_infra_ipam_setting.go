@@ -29,6 +29,7 @@ type datasourceInfraIpamSetting struct {
 type datasourceInfraIpamSettingModel struct {
 	PrimaryKey types.String                           `tfsdk:"primary_key"`
 	Pools      []datasourceInfraIpamSettingPoolsModel `tfsdk:"pools"`
+	RawJson    types.String                           `tfsdk:"raw_json"`
 }
 
 func (r *datasourceInfraIpamSetting) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,6 +39,7 @@ func (r *datasourceInfraIpamSetting) Metadata(ctx context.Context, req datasourc
 func (r *datasourceInfraIpamSetting) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -138,6 +140,8 @@ func (m *datasourceInfraIpamSettingModel) refreshInfraIpamSetting(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["pools"]; ok {
 		m.Pools = m.flattenInfraIpamSettingPoolsList(ctx, v, &diags)
 	}
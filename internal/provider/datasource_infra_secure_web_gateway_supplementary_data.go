@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,9 +28,10 @@ type datasourceInfraSecureWebGatewaySupplementaryData struct {
 
 // datasourceInfraSecureWebGatewaySupplementaryDataModel describes the datasource data model.
 type datasourceInfraSecureWebGatewaySupplementaryDataModel struct {
-	PrimaryKey           types.String  `tfsdk:"primary_key"`
-	SessionDurationHours types.Float64 `tfsdk:"session_duration_hours"`
-	EndSessionAfterMins  types.Float64 `tfsdk:"end_session_after_mins"`
+	PrimaryKey           types.String `tfsdk:"primary_key"`
+	SessionDurationHours types.Int64  `tfsdk:"session_duration_hours"`
+	EndSessionAfterMins  types.Int64  `tfsdk:"end_session_after_mins"`
+	RawJson              types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceInfraSecureWebGatewaySupplementaryData) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,17 +41,24 @@ func (r *datasourceInfraSecureWebGatewaySupplementaryData) Metadata(ctx context.
 func (r *datasourceInfraSecureWebGatewaySupplementaryData) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
 				},
 				Required: true,
 			},
-			"session_duration_hours": schema.Float64Attribute{
+			"session_duration_hours": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"end_session_after_mins": schema.Float64Attribute{
+			"end_session_after_mins": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -119,12 +128,14 @@ func (m *datasourceInfraSecureWebGatewaySupplementaryDataModel) refreshInfraSecu
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["sessionDurationHours"]; ok {
-		m.SessionDurationHours = parseFloat64Value(v)
+		m.SessionDurationHours = parseInt64Value(v)
 	}
 
 	if v, ok := o["endSessionAfterMins"]; ok {
-		m.EndSessionAfterMins = parseFloat64Value(v)
+		m.EndSessionAfterMins = parseInt64Value(v)
 	}
 
 	return diags
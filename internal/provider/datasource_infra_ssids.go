@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -30,13 +31,14 @@ type datasourceInfraSsidsModel struct {
 	PrimaryKey     types.String                              `tfsdk:"primary_key"`
 	WifiSsid       types.String                              `tfsdk:"wifi_ssid"`
 	BroadcastSsid  types.String                              `tfsdk:"broadcast_ssid"`
-	ClientLimit    types.Float64                             `tfsdk:"client_limit"`
+	ClientLimit    types.Int64                               `tfsdk:"client_limit"`
 	SecurityMode   types.String                              `tfsdk:"security_mode"`
 	CaptivePortal  types.Bool                                `tfsdk:"captive_portal"`
 	SecurityGroups []datasourceInfraSsidsSecurityGroupsModel `tfsdk:"security_groups"`
 	PreSharedKey   types.String                              `tfsdk:"pre_shared_key"`
 	RadiusServer   *datasourceInfraSsidsRadiusServerModel    `tfsdk:"radius_server"`
 	UserGroups     []datasourceInfraSsidsUserGroupsModel     `tfsdk:"user_groups"`
+	RawJson        types.String                              `tfsdk:"raw_json"`
 }
 
 func (r *datasourceInfraSsids) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +48,7 @@ func (r *datasourceInfraSsids) Metadata(ctx context.Context, req datasource.Meta
 func (r *datasourceInfraSsids) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 10),
@@ -63,7 +66,10 @@ func (r *datasourceInfraSsids) Schema(ctx context.Context, req datasource.Schema
 				Computed: true,
 				Optional: true,
 			},
-			"client_limit": schema.Float64Attribute{
+			"client_limit": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -79,8 +85,9 @@ func (r *datasourceInfraSsids) Schema(ctx context.Context, req datasource.Schema
 				Optional: true,
 			},
 			"pre_shared_key": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
 			"security_groups": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
@@ -200,6 +207,8 @@ func (m *datasourceInfraSsidsModel) refreshInfraSsids(ctx context.Context, o map
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["wifiSsid"]; ok {
 		m.WifiSsid = parseStringValue(v)
 	}
@@ -209,7 +218,7 @@ func (m *datasourceInfraSsidsModel) refreshInfraSsids(ctx context.Context, o map
 	}
 
 	if v, ok := o["clientLimit"]; ok {
-		m.ClientLimit = parseFloat64Value(v)
+		m.ClientLimit = parseInt64Value(v)
 	}
 
 	if v, ok := o["securityMode"]; ok {
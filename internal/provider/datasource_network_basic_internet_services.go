@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,12 +27,13 @@ type datasourceNetworkBasicInternetServices struct {
 
 // datasourceNetworkBasicInternetServicesModel describes the datasource data model.
 type datasourceNetworkBasicInternetServicesModel struct {
-	PrimaryKey    types.String  `tfsdk:"primary_key"`
-	Ftntid        types.Float64 `tfsdk:"ftntid"`
-	Direction     types.String  `tfsdk:"direction"`
-	IpRangeNumber types.Float64 `tfsdk:"ip_range_number"`
-	IpNumber      types.Float64 `tfsdk:"ip_number"`
-	IconId        types.Float64 `tfsdk:"icon_id"`
+	PrimaryKey    types.String `tfsdk:"primary_key"`
+	Ftntid        types.Int64  `tfsdk:"ftntid"`
+	Direction     types.String `tfsdk:"direction"`
+	IpRangeNumber types.Int64  `tfsdk:"ip_range_number"`
+	IpNumber      types.Int64  `tfsdk:"ip_number"`
+	IconId        types.Int64  `tfsdk:"icon_id"`
+	RawJson       types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceNetworkBasicInternetServices) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,10 +43,14 @@ func (r *datasourceNetworkBasicInternetServices) Metadata(ctx context.Context, r
 func (r *datasourceNetworkBasicInternetServices) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
-			"ftntid": schema.Float64Attribute{
+			"ftntid": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -51,15 +58,24 @@ func (r *datasourceNetworkBasicInternetServices) Schema(ctx context.Context, req
 				Computed: true,
 				Optional: true,
 			},
-			"ip_range_number": schema.Float64Attribute{
+			"ip_range_number": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"ip_number": schema.Float64Attribute{
+			"ip_number": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"icon_id": schema.Float64Attribute{
+			"icon_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -130,8 +146,10 @@ func (m *datasourceNetworkBasicInternetServicesModel) refreshNetworkBasicInterne
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["direction"]; ok {
@@ -139,15 +157,15 @@ func (m *datasourceNetworkBasicInternetServicesModel) refreshNetworkBasicInterne
 	}
 
 	if v, ok := o["ipRangeNumber"]; ok {
-		m.IpRangeNumber = parseFloat64Value(v)
+		m.IpRangeNumber = parseInt64Value(v)
 	}
 
 	if v, ok := o["ipNumber"]; ok {
-		m.IpNumber = parseFloat64Value(v)
+		m.IpNumber = parseInt64Value(v)
 	}
 
 	if v, ok := o["iconId"]; ok {
-		m.IconId = parseFloat64Value(v)
+		m.IconId = parseInt64Value(v)
 	}
 
 	return diags
@@ -34,6 +34,7 @@ type datasourceNetworkDnsRulesModel struct {
 	Domains        types.Set                                               `tfsdk:"domains"`
 	PopDnsOverride map[string]datasourceNetworkDnsRulesPopDnsOverrideModel `tfsdk:"pop_dns_override"`
 	ForPrivate     types.Bool                                              `tfsdk:"for_private"`
+	RawJson        types.String                                            `tfsdk:"raw_json"`
 }
 
 func (r *datasourceNetworkDnsRules) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,6 +44,7 @@ func (r *datasourceNetworkDnsRules) Metadata(ctx context.Context, req datasource
 func (r *datasourceNetworkDnsRules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(30),
@@ -156,6 +158,8 @@ func (m *datasourceNetworkDnsRulesModel) refreshNetworkDnsRules(ctx context.Cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["primaryDns"]; ok {
 		m.PrimaryDns = parseStringValue(v)
 	}
@@ -29,6 +29,7 @@ type datasourceNetworkHostGroups struct {
 type datasourceNetworkHostGroupsModel struct {
 	PrimaryKey types.String                              `tfsdk:"primary_key"`
 	Members    []datasourceNetworkHostGroupsMembersModel `tfsdk:"members"`
+	RawJson    types.String                              `tfsdk:"raw_json"`
 }
 
 func (r *datasourceNetworkHostGroups) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,6 +39,7 @@ func (r *datasourceNetworkHostGroups) Metadata(ctx context.Context, req datasour
 func (r *datasourceNetworkHostGroups) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -130,6 +132,8 @@ func (m *datasourceNetworkHostGroupsModel) refreshNetworkHostGroups(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["members"]; ok {
 		m.Members = m.flattenNetworkHostGroupsMembersList(ctx, v, &diags)
 	}
@@ -35,6 +35,7 @@ type datasourceNetworkHostsModel struct {
 	EndIp      types.String `tfsdk:"end_ip"`
 	Fqdn       types.String `tfsdk:"fqdn"`
 	CountryId  types.String `tfsdk:"country_id"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceNetworkHosts) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,6 +45,7 @@ func (r *datasourceNetworkHosts) Metadata(ctx context.Context, req datasource.Me
 func (r *datasourceNetworkHosts) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -157,6 +159,8 @@ func (m *datasourceNetworkHostsModel) refreshNetworkHosts(ctx context.Context, o
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["type"]; ok {
 		m.Type = parseStringValue(v)
 	}
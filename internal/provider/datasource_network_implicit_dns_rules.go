@@ -34,6 +34,7 @@ type datasourceNetworkImplicitDnsRulesModel struct {
 	DnsServer2 types.String `tfsdk:"dns_server2"`
 	Protocols  types.Set    `tfsdk:"protocols"`
 	ForPrivate types.Bool   `tfsdk:"for_private"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceNetworkImplicitDnsRules) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,6 +44,7 @@ func (r *datasourceNetworkImplicitDnsRules) Metadata(ctx context.Context, req da
 func (r *datasourceNetworkImplicitDnsRules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("vpn", "other", "implicit_all"),
@@ -143,6 +145,8 @@ func (m *datasourceNetworkImplicitDnsRulesModel) refreshNetworkImplicitDnsRules(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["dnsServer"]; ok {
 		m.DnsServer = parseStringValue(v)
 	}
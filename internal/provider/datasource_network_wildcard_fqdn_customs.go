@@ -26,6 +26,7 @@ type datasourceNetworkWildcardFqdnCustoms struct {
 // datasourceNetworkWildcardFqdnCustomsModel describes the datasource data model.
 type datasourceNetworkWildcardFqdnCustomsModel struct {
 	PrimaryKey types.String `tfsdk:"primary_key"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceNetworkWildcardFqdnCustoms) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -35,6 +36,7 @@ func (r *datasourceNetworkWildcardFqdnCustoms) Metadata(ctx context.Context, req
 func (r *datasourceNetworkWildcardFqdnCustoms) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -105,6 +107,8 @@ func (m *datasourceNetworkWildcardFqdnCustomsModel) refreshNetworkWildcardFqdnCu
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	return diags
 }
 
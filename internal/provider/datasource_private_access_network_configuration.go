@@ -34,6 +34,7 @@ type datasourcePrivateAccessNetworkConfigurationModel struct {
 	SdwanHealthCheckVm types.String `tfsdk:"sdwan_health_check_vm"`
 	ConfigState        types.String `tfsdk:"config_state"`
 	BgpDesign          types.String `tfsdk:"bgp_design"`
+	RawJson            types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourcePrivateAccessNetworkConfiguration) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,6 +44,7 @@ func (r *datasourcePrivateAccessNetworkConfiguration) Metadata(ctx context.Conte
 func (r *datasourcePrivateAccessNetworkConfiguration) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"bgp_router_ids_subnet": schema.StringAttribute{
 				MarkdownDescription: "Available/unused subnet that can be used to assign loopback interface IP addresses used for BGP router IDs parameter on the FortiSASE security PoPs. /28 is the minimum subnet size.",
 				Computed:            true,
@@ -149,6 +151,8 @@ func (m *datasourcePrivateAccessNetworkConfigurationModel) refreshPrivateAccessN
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["bgp_router_ids_subnet"]; ok {
 		m.BgpRouterIdsSubnet = parseStringValue(v)
 	}
@@ -41,13 +41,14 @@ type datasourcePrivateAccessServiceConnectionsModel struct {
 	Ftntid              types.String                                                `tfsdk:"ftntid"`
 	Type                types.String                                                `tfsdk:"type"`
 	ConfigState         types.String                                                `tfsdk:"config_state"`
-	SeqNum              types.Float64                                               `tfsdk:"seq_num"`
+	SeqNum              types.Int64                                                 `tfsdk:"seq_num"`
 	FailedMessage       types.String                                                `tfsdk:"failed_message"`
 	Config              *datasourcePrivateAccessServiceConnectionsConfigModel       `tfsdk:"config"`
 	CommonConfig        *datasourcePrivateAccessServiceConnectionsCommonConfigModel `tfsdk:"common_config"`
 	IpAssigned          []datasourcePrivateAccessServiceConnectionsIpAssignedModel  `tfsdk:"ip_assigned"`
 	RegionCost          types.Map                                                   `tfsdk:"region_cost"`
 	ServiceConnectionId types.String                                                `tfsdk:"service_connection_id"`
+	RawJson             types.String                                                `tfsdk:"raw_json"`
 }
 
 func (r *datasourcePrivateAccessServiceConnections) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -57,6 +58,7 @@ func (r *datasourcePrivateAccessServiceConnections) Metadata(ctx context.Context
 func (r *datasourcePrivateAccessServiceConnections) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"alias": schema.StringAttribute{
 				MarkdownDescription: "alias for serivce connection",
 				Optional:            true,
@@ -86,6 +88,7 @@ func (r *datasourcePrivateAccessServiceConnections) Schema(ctx context.Context,
 			},
 			"ipsec_pre_shared_key": schema.StringAttribute{
 				MarkdownDescription: "IPSEC auth by pre shared key.",
+				Sensitive:           true,
 				Optional:            true,
 			},
 			"ipsec_cert_name": schema.StringAttribute{
@@ -122,7 +125,7 @@ func (r *datasourcePrivateAccessServiceConnections) Schema(ctx context.Context,
 				MarkdownDescription: "Configuration state of service connection.\nSupported values: success, failed, creating, updating, deleting.",
 				Computed:            true,
 			},
-			"seq_num": schema.Float64Attribute{
+			"seq_num": schema.Int64Attribute{
 				MarkdownDescription: "sequential unique number for service connection",
 				Computed:            true,
 			},
@@ -185,6 +188,7 @@ func (r *datasourcePrivateAccessServiceConnections) Schema(ctx context.Context,
 						},
 						"ipsec_pre_shared_key": schema.StringAttribute{
 							MarkdownDescription: "IPSEC auth by pre shared key.",
+							Sensitive:           true,
 							Computed:            true,
 							Optional:            true,
 						},
@@ -453,6 +457,8 @@ func (m *datasourcePrivateAccessServiceConnectionsModel) refreshPrivateAccessSer
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
 		m.Ftntid = parseStringValue(v)
 	}
@@ -466,7 +472,7 @@ func (m *datasourcePrivateAccessServiceConnectionsModel) refreshPrivateAccessSer
 	}
 
 	if v, ok := o["seq_num"]; ok {
-		m.SeqNum = parseFloat64Value(v)
+		m.SeqNum = parseInt64Value(v)
 	}
 
 	if v, ok := o["failed_message"]; ok {
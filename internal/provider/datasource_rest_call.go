@@ -0,0 +1,157 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &datasourceRestCall{}
+
+func newDatasourceRestCall() datasource.DataSource {
+	return &datasourceRestCall{}
+}
+
+type datasourceRestCall struct {
+	fortiClient  *FortiClient
+	resourceName string
+}
+
+// datasourceRestCallModel describes the datasource data model.
+type datasourceRestCallModel struct {
+	ID         types.String `tfsdk:"id"`
+	Path       types.String `tfsdk:"path"`
+	PathParams types.Map    `tfsdk:"path_params"`
+	Method     types.String `tfsdk:"method"`
+	Body       jsonValue    `tfsdk:"body"`
+	Response   types.String `tfsdk:"response"`
+}
+
+func (r *datasourceRestCall) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rest_call"
+}
+
+func (r *datasourceRestCall) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Calls an endpoint of the FortiSASE API that has no dedicated data source yet, e.g. a monitor endpoint. The request goes through the same authentication, retries and rate limiting as the other data sources.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the endpoint, e.g. `/resource-api/v2/network/hosts/{primaryKey}`. Placeholders are replaced with `path_params`.",
+				Required:            true,
+			},
+			"path_params": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The values of the placeholders of `path`.",
+				Optional:            true,
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method, `GET` or `POST` for the endpoints that take the query in the body. Defaults to `GET`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("GET", "POST"),
+				},
+			},
+			"body": schema.StringAttribute{
+				CustomType:          jsonType{},
+				MarkdownDescription: "The JSON object sent as the body of the request.",
+				Optional:            true,
+			},
+			"response": schema.StringAttribute{
+				MarkdownDescription: "The `data` of the response in JSON, or the whole response when it has no `data`. It may hold secrets, such as pre-shared keys.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *datasourceRestCall) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.resourceName = "fortisase_rest_call"
+}
+
+func (r *datasourceRestCall) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	diags := &resp.Diagnostics
+	var data datasourceRestCallModel
+
+	// Read Terraform prior config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.HTTPMethod = "GET"
+	if !data.Method.IsNull() {
+		input_model.HTTPMethod = data.Method.ValueString()
+	}
+	input_model.URL = data.Path.ValueString()
+	input_model.URLParams = make(map[string]interface{})
+	params := make(map[string]string)
+	diags.Append(data.PathParams.ElementsAs(ctx, &params, false)...)
+	for k, v := range params {
+		input_model.URLParams[k] = v
+	}
+	if !data.Body.IsNull() {
+		input_model.BodyParams = decodeRestBody(data.Body, diags)
+	}
+
+	if diags.HasError() {
+		return
+	}
+	read_output, err := c.Request(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read data source %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, read_output),
+		)
+		return
+	}
+
+	var result interface{} = read_output
+	if v, ok := read_output["data"].([]interface{}); ok {
+		result = v
+	}
+	response, err := json.Marshal(result)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to read data source %s: %v", r.resourceName, err), "")
+		return
+	}
+
+	data.ID = types.StringValue(input_model.URL)
+	data.Response = types.StringValue(string(response))
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
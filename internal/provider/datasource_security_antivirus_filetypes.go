@@ -27,6 +27,7 @@ type datasourceSecurityAntivirusFiletypes struct {
 type datasourceSecurityAntivirusFiletypesModel struct {
 	PrimaryKey          types.String `tfsdk:"primary_key"`
 	IsPasswordProtected types.Bool   `tfsdk:"is_password_protected"`
+	RawJson             types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityAntivirusFiletypes) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,6 +37,7 @@ func (r *datasourceSecurityAntivirusFiletypes) Metadata(ctx context.Context, req
 func (r *datasourceSecurityAntivirusFiletypes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -110,6 +112,8 @@ func (m *datasourceSecurityAntivirusFiletypesModel) refreshSecurityAntivirusFile
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["isPasswordProtected"]; ok {
 		m.IsPasswordProtected = parseBoolValue(v)
 	}
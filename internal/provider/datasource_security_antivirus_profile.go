@@ -37,6 +37,7 @@ type datasourceSecurityAntivirusProfileModel struct {
 	Cifs       types.String                                `tfsdk:"cifs"`
 	Cdr        *datasourceSecurityAntivirusProfileCdrModel `tfsdk:"cdr"`
 	Direction  types.String                                `tfsdk:"direction"`
+	RawJson    types.String                                `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityAntivirusProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,7 @@ func (r *datasourceSecurityAntivirusProfile) Metadata(ctx context.Context, req d
 func (r *datasourceSecurityAntivirusProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -190,6 +192,8 @@ func (m *datasourceSecurityAntivirusProfileModel) refreshSecurityAntivirusProfil
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["http"]; ok {
 		m.Http = parseStringValue(v)
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,18 +28,19 @@ type datasourceSecurityAppCustomSignatures struct {
 
 // datasourceSecurityAppCustomSignaturesModel describes the datasource data model.
 type datasourceSecurityAppCustomSignaturesModel struct {
-	PrimaryKey types.String  `tfsdk:"primary_key"`
-	Signature  types.String  `tfsdk:"signature"`
-	Comment    types.String  `tfsdk:"comment"`
-	Ftntid     types.Float64 `tfsdk:"ftntid"`
-	Tag        types.String  `tfsdk:"tag"`
-	Name       types.String  `tfsdk:"name"`
-	Category   types.Float64 `tfsdk:"category"`
-	Protocol   types.String  `tfsdk:"protocol"`
-	Technology types.String  `tfsdk:"technology"`
-	Behavior   types.String  `tfsdk:"behavior"`
-	Vendor     types.String  `tfsdk:"vendor"`
-	IconClass  types.String  `tfsdk:"icon_class"`
+	PrimaryKey types.String `tfsdk:"primary_key"`
+	Signature  types.String `tfsdk:"signature"`
+	Comment    types.String `tfsdk:"comment"`
+	Ftntid     types.Int64  `tfsdk:"ftntid"`
+	Tag        types.String `tfsdk:"tag"`
+	Name       types.String `tfsdk:"name"`
+	Category   types.Int64  `tfsdk:"category"`
+	Protocol   types.String `tfsdk:"protocol"`
+	Technology types.String `tfsdk:"technology"`
+	Behavior   types.String `tfsdk:"behavior"`
+	Vendor     types.String `tfsdk:"vendor"`
+	IconClass  types.String `tfsdk:"icon_class"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityAppCustomSignatures) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,6 +50,7 @@ func (r *datasourceSecurityAppCustomSignatures) Metadata(ctx context.Context, re
 func (r *datasourceSecurityAppCustomSignatures) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 63),
@@ -68,7 +71,10 @@ func (r *datasourceSecurityAppCustomSignatures) Schema(ctx context.Context, req
 				Computed: true,
 				Optional: true,
 			},
-			"ftntid": schema.Float64Attribute{
+			"ftntid": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -83,7 +89,10 @@ func (r *datasourceSecurityAppCustomSignatures) Schema(ctx context.Context, req
 				Computed: true,
 				Optional: true,
 			},
-			"category": schema.Float64Attribute{
+			"category": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -174,6 +183,8 @@ func (m *datasourceSecurityAppCustomSignaturesModel) refreshSecurityAppCustomSig
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["signature"]; ok {
 		m.Signature = parseStringValue(v)
 	}
@@ -183,7 +194,7 @@ func (m *datasourceSecurityAppCustomSignaturesModel) refreshSecurityAppCustomSig
 	}
 
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["tag"]; ok {
@@ -195,7 +206,7 @@ func (m *datasourceSecurityAppCustomSignaturesModel) refreshSecurityAppCustomSig
 	}
 
 	if v, ok := o["category"]; ok {
-		m.Category = parseFloat64Value(v)
+		m.Category = parseInt64Value(v)
 	}
 
 	if v, ok := o["protocol"]; ok {
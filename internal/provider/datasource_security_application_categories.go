@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,8 +27,9 @@ type datasourceSecurityApplicationCategories struct {
 
 // datasourceSecurityApplicationCategoriesModel describes the datasource data model.
 type datasourceSecurityApplicationCategoriesModel struct {
-	PrimaryKey types.String  `tfsdk:"primary_key"`
-	Ftntid     types.Float64 `tfsdk:"ftntid"`
+	PrimaryKey types.String `tfsdk:"primary_key"`
+	Ftntid     types.Int64  `tfsdk:"ftntid"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityApplicationCategories) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,10 +39,14 @@ func (r *datasourceSecurityApplicationCategories) Metadata(ctx context.Context,
 func (r *datasourceSecurityApplicationCategories) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
-			"ftntid": schema.Float64Attribute{
+			"ftntid": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -110,8 +117,10 @@ func (m *datasourceSecurityApplicationCategoriesModel) refreshSecurityApplicatio
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	return diags
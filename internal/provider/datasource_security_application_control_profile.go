@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -38,6 +38,7 @@ type datasourceSecurityApplicationControlProfileModel struct {
 	NetworkProtocols                []datasourceSecurityApplicationControlProfileNetworkProtocolsModel            `tfsdk:"network_protocols"`
 	BlockNonDefaultPortApplications types.String                                                                  `tfsdk:"block_non_default_port_applications"`
 	Direction                       types.String                                                                  `tfsdk:"direction"`
+	RawJson                         types.String                                                                  `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityApplicationControlProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +48,7 @@ func (r *datasourceSecurityApplicationControlProfile) Metadata(ctx context.Conte
 func (r *datasourceSecurityApplicationControlProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -216,9 +218,9 @@ func (r *datasourceSecurityApplicationControlProfile) Schema(ctx context.Context
 						"risk": schema.ListNestedAttribute{
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
-									"id": schema.Float64Attribute{
-										Validators: []validator.Float64{
-											float64validator.AtMost(4),
+									"id": schema.Int64Attribute{
+										Validators: []validator.Int64{
+											int64validator.Between(0, 4),
 										},
 										MarkdownDescription: "Risk level with 0 being lowest and 4 being highest.\nValue at most 4.",
 										Computed:            true,
@@ -237,9 +239,9 @@ func (r *datasourceSecurityApplicationControlProfile) Schema(ctx context.Context
 			"network_protocols": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"port": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 65535),
+						"port": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
 							},
 							Computed: true,
 							Optional: true,
@@ -334,6 +336,8 @@ func (m *datasourceSecurityApplicationControlProfileModel) refreshSecurityApplic
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["applicationCategoryControls"]; ok {
 		m.ApplicationCategoryControls = m.flattenSecurityApplicationControlProfileApplicationCategoryControlsList(ctx, v, &diags)
 	}
@@ -424,13 +428,13 @@ type datasourceSecurityApplicationControlProfileControlsCategoriesModel struct {
 }
 
 type datasourceSecurityApplicationControlProfileControlsRiskModel struct {
-	Id types.Float64 `tfsdk:"id"`
+	Id types.Int64 `tfsdk:"id"`
 }
 
 type datasourceSecurityApplicationControlProfileNetworkProtocolsModel struct {
-	Port     types.Float64 `tfsdk:"port"`
-	Action   types.String  `tfsdk:"action"`
-	Services types.Set     `tfsdk:"services"`
+	Port     types.Int64  `tfsdk:"port"`
+	Action   types.String `tfsdk:"action"`
+	Services types.Set    `tfsdk:"services"`
 }
 
 func (m *datasourceSecurityApplicationControlProfileApplicationCategoryControlsModel) flattenSecurityApplicationControlProfileApplicationCategoryControls(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceSecurityApplicationControlProfileApplicationCategoryControlsModel {
@@ -747,7 +751,7 @@ func (m *datasourceSecurityApplicationControlProfileControlsRiskModel) flattenSe
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	return m
@@ -786,7 +790,7 @@ func (m *datasourceSecurityApplicationControlProfileNetworkProtocolsModel) flatt
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	if v, ok := o["action"]; ok {
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -29,19 +29,20 @@ type datasourceSecurityApplications struct {
 
 // datasourceSecurityApplicationsModel describes the datasource data model.
 type datasourceSecurityApplicationsModel struct {
-	PrimaryKey                types.String  `tfsdk:"primary_key"`
-	Ftntid                    types.Float64 `tfsdk:"ftntid"`
-	Category                  types.Float64 `tfsdk:"category"`
-	Protocol                  types.String  `tfsdk:"protocol"`
-	Popularity                types.Float64 `tfsdk:"popularity"`
-	Risk                      types.Float64 `tfsdk:"risk"`
-	Behavior                  types.Set     `tfsdk:"behavior"`
-	Technology                types.String  `tfsdk:"technology"`
-	Vendor                    types.String  `tfsdk:"vendor"`
-	IconClass                 types.String  `tfsdk:"icon_class"`
-	IsCloudApplication        types.Bool    `tfsdk:"is_cloud_application"`
-	RequiresSslDeepInspection types.Bool    `tfsdk:"requires_ssl_deep_inspection"`
-	IsDeepInspectionApp       types.Bool    `tfsdk:"is_deep_inspection_app"`
+	PrimaryKey                types.String `tfsdk:"primary_key"`
+	Ftntid                    types.Int64  `tfsdk:"ftntid"`
+	Category                  types.Int64  `tfsdk:"category"`
+	Protocol                  types.String `tfsdk:"protocol"`
+	Popularity                types.Int64  `tfsdk:"popularity"`
+	Risk                      types.Int64  `tfsdk:"risk"`
+	Behavior                  types.Set    `tfsdk:"behavior"`
+	Technology                types.String `tfsdk:"technology"`
+	Vendor                    types.String `tfsdk:"vendor"`
+	IconClass                 types.String `tfsdk:"icon_class"`
+	IsCloudApplication        types.Bool   `tfsdk:"is_cloud_application"`
+	RequiresSslDeepInspection types.Bool   `tfsdk:"requires_ssl_deep_inspection"`
+	IsDeepInspectionApp       types.Bool   `tfsdk:"is_deep_inspection_app"`
+	RawJson                   types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityApplications) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -51,14 +52,21 @@ func (r *datasourceSecurityApplications) Metadata(ctx context.Context, req datas
 func (r *datasourceSecurityApplications) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
-			"ftntid": schema.Float64Attribute{
+			"ftntid": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"category": schema.Float64Attribute{
+			"category": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -66,16 +74,16 @@ func (r *datasourceSecurityApplications) Schema(ctx context.Context, req datasou
 				Computed: true,
 				Optional: true,
 			},
-			"popularity": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 5),
+			"popularity": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"risk": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 5),
+			"risk": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
 				},
 				Computed: true,
 				Optional: true,
@@ -181,12 +189,14 @@ func (m *datasourceSecurityApplicationsModel) refreshSecurityApplications(ctx co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["category"]; ok {
-		m.Category = parseFloat64Value(v)
+		m.Category = parseInt64Value(v)
 	}
 
 	if v, ok := o["protocol"]; ok {
@@ -194,11 +204,11 @@ func (m *datasourceSecurityApplicationsModel) refreshSecurityApplications(ctx co
 	}
 
 	if v, ok := o["popularity"]; ok {
-		m.Popularity = parseFloat64Value(v)
+		m.Popularity = parseInt64Value(v)
 	}
 
 	if v, ok := o["risk"]; ok {
-		m.Risk = parseFloat64Value(v)
+		m.Risk = parseInt64Value(v)
 	}
 
 	if v, ok := o["behavior"]; ok {
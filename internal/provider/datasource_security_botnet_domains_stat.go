@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,7 +27,8 @@ type datasourceSecurityBotnetDomainsStat2Edl struct {
 
 // datasourceSecurityBotnetDomainsStat2EdlModel describes the datasource data model.
 type datasourceSecurityBotnetDomainsStat2EdlModel struct {
-	TotalEntries types.Float64 `tfsdk:"total_entries"`
+	TotalEntries types.Int64  `tfsdk:"total_entries"`
+	RawJson      types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityBotnetDomainsStat2Edl) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -35,7 +38,11 @@ func (r *datasourceSecurityBotnetDomainsStat2Edl) Metadata(ctx context.Context,
 func (r *datasourceSecurityBotnetDomainsStat2Edl) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"total_entries": schema.Float64Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
+			"total_entries": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -105,8 +112,10 @@ func (m *datasourceSecurityBotnetDomainsStat2EdlModel) refreshSecurityBotnetDoma
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["totalEntries"]; ok {
-		m.TotalEntries = parseFloat64Value(v)
+		m.TotalEntries = parseInt64Value(v)
 	}
 
 	return diags
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,7 +28,7 @@ type datasourceSecurityCertLocalCaCerts struct {
 
 // datasourceSecurityCertLocalCaCertsModel describes the datasource data model.
 type datasourceSecurityCertLocalCaCertsModel struct {
-	Ftntid         types.Float64                                   `tfsdk:"ftntid"`
+	Ftntid         types.Int64                                     `tfsdk:"ftntid"`
 	Name           types.String                                    `tfsdk:"name"`
 	PrimaryKey     types.String                                    `tfsdk:"primary_key"`
 	Type           types.String                                    `tfsdk:"type"`
@@ -42,6 +43,7 @@ type datasourceSecurityCertLocalCaCertsModel struct {
 	Password       types.String                                    `tfsdk:"password"`
 	FileContent    types.String                                    `tfsdk:"file_content"`
 	KeyFileContent types.String                                    `tfsdk:"key_file_content"`
+	RawJson        types.String                                    `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityCertLocalCaCerts) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -51,7 +53,8 @@ func (r *datasourceSecurityCertLocalCaCerts) Metadata(ctx context.Context, req d
 func (r *datasourceSecurityCertLocalCaCerts) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"ftntid": schema.Float64Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
+			"ftntid": schema.Int64Attribute{
 				Computed: true,
 			},
 			"name": schema.StringAttribute{
@@ -85,13 +88,15 @@ func (r *datasourceSecurityCertLocalCaCerts) Schema(ctx context.Context, req dat
 				Optional: true,
 			},
 			"password": schema.StringAttribute{
-				Optional: true,
+				Sensitive: true,
+				Optional:  true,
 			},
 			"file_content": schema.StringAttribute{
 				Optional: true,
 			},
 			"key_file_content": schema.StringAttribute{
-				Optional: true,
+				Sensitive: true,
+				Optional:  true,
 			},
 			"issuer": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -133,7 +138,10 @@ func (r *datasourceSecurityCertLocalCaCerts) Schema(ctx context.Context, req dat
 							Computed: true,
 							Optional: true,
 						},
-						"count": schema.Float64Attribute{
+						"count": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -208,8 +216,10 @@ func (m *datasourceSecurityCertLocalCaCertsModel) refreshSecurityCertLocalCaCert
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -267,8 +277,8 @@ type datasourceSecurityCertLocalCaCertsIssuerModel struct {
 }
 
 type datasourceSecurityCertLocalCaCertsUsagesModel struct {
-	Type  types.String  `tfsdk:"type"`
-	Count types.Float64 `tfsdk:"count"`
+	Type  types.String `tfsdk:"type"`
+	Count types.Int64  `tfsdk:"count"`
 }
 
 func (m *datasourceSecurityCertLocalCaCertsIssuerModel) flattenSecurityCertLocalCaCertsIssuer(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceSecurityCertLocalCaCertsIssuerModel {
@@ -323,7 +333,7 @@ func (m *datasourceSecurityCertLocalCaCertsUsagesModel) flattenSecurityCertLocal
 	}
 
 	if v, ok := o["count"]; ok {
-		m.Count = parseFloat64Value(v)
+		m.Count = parseInt64Value(v)
 	}
 
 	return m
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,7 +28,7 @@ type datasourceSecurityCertRemoteCerts struct {
 
 // datasourceSecurityCertRemoteCertsModel describes the datasource data model.
 type datasourceSecurityCertRemoteCertsModel struct {
-	Ftntid       types.Float64                                  `tfsdk:"ftntid"`
+	Ftntid       types.Int64                                    `tfsdk:"ftntid"`
 	Name         types.String                                   `tfsdk:"name"`
 	PrimaryKey   types.String                                   `tfsdk:"primary_key"`
 	Type         types.String                                   `tfsdk:"type"`
@@ -39,6 +40,7 @@ type datasourceSecurityCertRemoteCertsModel struct {
 	Usages       []datasourceSecurityCertRemoteCertsUsagesModel `tfsdk:"usages"`
 	CertName     types.String                                   `tfsdk:"cert_name"`
 	FileContent  types.String                                   `tfsdk:"file_content"`
+	RawJson      types.String                                   `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityCertRemoteCerts) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,7 +50,8 @@ func (r *datasourceSecurityCertRemoteCerts) Metadata(ctx context.Context, req da
 func (r *datasourceSecurityCertRemoteCerts) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"ftntid": schema.Float64Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
+			"ftntid": schema.Int64Attribute{
 				Computed: true,
 			},
 			"name": schema.StringAttribute{
@@ -121,7 +124,10 @@ func (r *datasourceSecurityCertRemoteCerts) Schema(ctx context.Context, req data
 							Computed: true,
 							Optional: true,
 						},
-						"count": schema.Float64Attribute{
+						"count": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -196,8 +202,10 @@ func (m *datasourceSecurityCertRemoteCertsModel) refreshSecurityCertRemoteCerts(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -255,8 +263,8 @@ type datasourceSecurityCertRemoteCertsIssuerModel struct {
 }
 
 type datasourceSecurityCertRemoteCertsUsagesModel struct {
-	Type  types.String  `tfsdk:"type"`
-	Count types.Float64 `tfsdk:"count"`
+	Type  types.String `tfsdk:"type"`
+	Count types.Int64  `tfsdk:"count"`
 }
 
 func (m *datasourceSecurityCertRemoteCertsIssuerModel) flattenSecurityCertRemoteCertsIssuer(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceSecurityCertRemoteCertsIssuerModel {
@@ -311,7 +319,7 @@ func (m *datasourceSecurityCertRemoteCertsUsagesModel) flattenSecurityCertRemote
 	}
 
 	if v, ok := o["count"]; ok {
-		m.Count = parseFloat64Value(v)
+		m.Count = parseInt64Value(v)
 	}
 
 	return m
@@ -27,6 +27,7 @@ type datasourceSecurityDlpDataTypes struct {
 type datasourceSecurityDlpDataTypesModel struct {
 	PrimaryKey types.String `tfsdk:"primary_key"`
 	Transform  types.String `tfsdk:"transform"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityDlpDataTypes) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,6 +37,7 @@ func (r *datasourceSecurityDlpDataTypes) Metadata(ctx context.Context, req datas
 func (r *datasourceSecurityDlpDataTypes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -110,6 +112,8 @@ func (m *datasourceSecurityDlpDataTypesModel) refreshSecurityDlpDataTypes(ctx co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["transform"]; ok {
 		m.Transform = parseStringValue(v)
 	}
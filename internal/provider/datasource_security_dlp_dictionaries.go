@@ -32,6 +32,7 @@ type datasourceSecurityDlpDictionariesModel struct {
 	SensitivityLabelGuid types.String                                    `tfsdk:"sensitivity_label_guid"`
 	EntriesToEvaluate    types.String                                    `tfsdk:"entries_to_evaluate"`
 	Entries              []datasourceSecurityDlpDictionariesEntriesModel `tfsdk:"entries"`
+	RawJson              types.String                                    `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityDlpDictionaries) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (r *datasourceSecurityDlpDictionaries) Metadata(ctx context.Context, req da
 func (r *datasourceSecurityDlpDictionaries) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -179,6 +181,8 @@ func (m *datasourceSecurityDlpDictionariesModel) refreshSecurityDlpDictionaries(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["dictionaryType"]; ok {
 		m.DictionaryType = parseStringValue(v)
 	}
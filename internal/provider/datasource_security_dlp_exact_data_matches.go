@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,7 +31,8 @@ type datasourceSecurityDlpExactDataMatchesModel struct {
 	PrimaryKey           types.String                                                    `tfsdk:"primary_key"`
 	ExternalResourceData *datasourceSecurityDlpExactDataMatchesExternalResourceDataModel `tfsdk:"external_resource_data"`
 	Columns              []datasourceSecurityDlpExactDataMatchesColumnsModel             `tfsdk:"columns"`
-	OptionalCount        types.Float64                                                   `tfsdk:"optional_count"`
+	OptionalCount        types.Int64                                                     `tfsdk:"optional_count"`
+	RawJson              types.String                                                    `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityDlpExactDataMatches) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,12 +42,13 @@ func (r *datasourceSecurityDlpExactDataMatches) Metadata(ctx context.Context, re
 func (r *datasourceSecurityDlpExactDataMatches) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
-			"optional_count": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(32),
+			"optional_count": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 32),
 				},
 				Computed: true,
 				Optional: true,
@@ -57,9 +59,9 @@ func (r *datasourceSecurityDlpExactDataMatches) Schema(ctx context.Context, req
 						Computed: true,
 						Optional: true,
 					},
-					"refresh_rate": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(1, 43200),
+					"refresh_rate": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(1, 43200),
 						},
 						Computed: true,
 						Optional: true,
@@ -90,9 +92,9 @@ func (r *datasourceSecurityDlpExactDataMatches) Schema(ctx context.Context, req
 			"columns": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"index": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 32),
+						"index": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 32),
 							},
 							Computed: true,
 							Optional: true,
@@ -190,6 +192,8 @@ func (m *datasourceSecurityDlpExactDataMatchesModel) refreshSecurityDlpExactData
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["externalResourceData"]; ok {
 		m.ExternalResourceData = m.ExternalResourceData.flattenSecurityDlpExactDataMatchesExternalResourceData(ctx, v, &diags)
 	}
@@ -199,7 +203,7 @@ func (m *datasourceSecurityDlpExactDataMatchesModel) refreshSecurityDlpExactData
 	}
 
 	if v, ok := o["optionalCount"]; ok {
-		m.OptionalCount = parseFloat64Value(v)
+		m.OptionalCount = parseInt64Value(v)
 	}
 
 	return diags
@@ -215,15 +219,15 @@ func (data *datasourceSecurityDlpExactDataMatchesModel) getURLObjectSecurityDlpE
 }
 
 type datasourceSecurityDlpExactDataMatchesExternalResourceDataModel struct {
-	Resource     types.String  `tfsdk:"resource"`
-	RefreshRate  types.Float64 `tfsdk:"refresh_rate"`
-	Username     types.String  `tfsdk:"username"`
-	Password     types.String  `tfsdk:"password"`
-	UpdateMethod types.String  `tfsdk:"update_method"`
+	Resource     types.String `tfsdk:"resource"`
+	RefreshRate  types.Int64  `tfsdk:"refresh_rate"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	UpdateMethod types.String `tfsdk:"update_method"`
 }
 
 type datasourceSecurityDlpExactDataMatchesColumnsModel struct {
-	Index    types.Float64                                          `tfsdk:"index"`
+	Index    types.Int64                                            `tfsdk:"index"`
 	Type     *datasourceSecurityDlpExactDataMatchesColumnsTypeModel `tfsdk:"type"`
 	Optional types.Bool                                             `tfsdk:"optional"`
 }
@@ -246,7 +250,7 @@ func (m *datasourceSecurityDlpExactDataMatchesExternalResourceDataModel) flatten
 	}
 
 	if v, ok := o["refreshRate"]; ok {
-		m.RefreshRate = parseFloat64Value(v)
+		m.RefreshRate = parseInt64Value(v)
 	}
 
 	if v, ok := o["username"]; ok {
@@ -269,7 +273,7 @@ func (m *datasourceSecurityDlpExactDataMatchesColumnsModel) flattenSecurityDlpEx
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["index"]; ok {
-		m.Index = parseFloat64Value(v)
+		m.Index = parseInt64Value(v)
 	}
 
 	if v, ok := o["type"]; ok {
@@ -30,6 +30,7 @@ type datasourceSecurityDlpFilePatternsModel struct {
 	PrimaryKey types.String                                    `tfsdk:"primary_key"`
 	Tag        types.String                                    `tfsdk:"tag"`
 	Entries    []datasourceSecurityDlpFilePatternsEntriesModel `tfsdk:"entries"`
+	RawJson    types.String                                    `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityDlpFilePatterns) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,6 +40,7 @@ func (r *datasourceSecurityDlpFilePatterns) Metadata(ctx context.Context, req da
 func (r *datasourceSecurityDlpFilePatterns) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -145,6 +147,8 @@ func (m *datasourceSecurityDlpFilePatternsModel) refreshSecurityDlpFilePatterns(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["tag"]; ok {
 		m.Tag = parseStringValue(v)
 	}
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -39,6 +39,7 @@ type datasourceSecurityDlpFingerprintDatabasesModel struct {
 	KeepModified                  types.String                                                  `tfsdk:"keep_modified"`
 	ScanOnCreation                types.String                                                  `tfsdk:"scan_on_creation"`
 	Authentication                *datasourceSecurityDlpFingerprintDatabasesAuthenticationModel `tfsdk:"authentication"`
+	RawJson                       types.String                                                  `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityDlpFingerprintDatabases) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,6 +49,7 @@ func (r *datasourceSecurityDlpFingerprintDatabases) Metadata(ctx context.Context
 func (r *datasourceSecurityDlpFingerprintDatabases) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -116,16 +118,16 @@ func (r *datasourceSecurityDlpFingerprintDatabases) Schema(ctx context.Context,
 						Computed: true,
 						Optional: true,
 					},
-					"sync_hour": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.AtMost(23),
+					"sync_hour": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(0, 23),
 						},
 						Computed: true,
 						Optional: true,
 					},
-					"sync_minute": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.AtMost(59),
+					"sync_minute": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(0, 59),
 						},
 						Computed: true,
 						Optional: true,
@@ -137,9 +139,9 @@ func (r *datasourceSecurityDlpFingerprintDatabases) Schema(ctx context.Context,
 						Computed: true,
 						Optional: true,
 					},
-					"sync_day_of_the_month": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(1, 31),
+					"sync_day_of_the_month": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(1, 31),
 						},
 						Computed: true,
 						Optional: true,
@@ -233,6 +235,8 @@ func (m *datasourceSecurityDlpFingerprintDatabasesModel) refreshSecurityDlpFinge
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["server"]; ok {
 		m.Server = parseStringValue(v)
 	}
@@ -286,11 +290,11 @@ func (data *datasourceSecurityDlpFingerprintDatabasesModel) getURLObjectSecurity
 }
 
 type datasourceSecurityDlpFingerprintDatabasesScheduleModel struct {
-	Period            types.String  `tfsdk:"period"`
-	SyncHour          types.Float64 `tfsdk:"sync_hour"`
-	SyncMinute        types.Float64 `tfsdk:"sync_minute"`
-	Weekday           types.String  `tfsdk:"weekday"`
-	SyncDayOfTheMonth types.Float64 `tfsdk:"sync_day_of_the_month"`
+	Period            types.String `tfsdk:"period"`
+	SyncHour          types.Int64  `tfsdk:"sync_hour"`
+	SyncMinute        types.Int64  `tfsdk:"sync_minute"`
+	Weekday           types.String `tfsdk:"weekday"`
+	SyncDayOfTheMonth types.Int64  `tfsdk:"sync_day_of_the_month"`
 }
 
 type datasourceSecurityDlpFingerprintDatabasesAuthenticationModel struct {
@@ -311,11 +315,11 @@ func (m *datasourceSecurityDlpFingerprintDatabasesScheduleModel) flattenSecurity
 	}
 
 	if v, ok := o["syncHour"]; ok {
-		m.SyncHour = parseFloat64Value(v)
+		m.SyncHour = parseInt64Value(v)
 	}
 
 	if v, ok := o["syncMinute"]; ok {
-		m.SyncMinute = parseFloat64Value(v)
+		m.SyncMinute = parseInt64Value(v)
 	}
 
 	if v, ok := o["weekday"]; ok {
@@ -323,7 +327,7 @@ func (m *datasourceSecurityDlpFingerprintDatabasesScheduleModel) flattenSecurity
 	}
 
 	if v, ok := o["syncDayOfTheMonth"]; ok {
-		m.SyncDayOfTheMonth = parseFloat64Value(v)
+		m.SyncDayOfTheMonth = parseInt64Value(v)
 	}
 
 	return m
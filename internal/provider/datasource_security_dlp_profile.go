@@ -31,6 +31,7 @@ type datasourceSecurityDlpProfileModel struct {
 	PrimaryKey types.String                                `tfsdk:"primary_key"`
 	DlpRules   []datasourceSecurityDlpProfileDlpRulesModel `tfsdk:"dlp_rules"`
 	Direction  types.String                                `tfsdk:"direction"`
+	RawJson    types.String                                `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityDlpProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,6 +41,7 @@ func (r *datasourceSecurityDlpProfile) Metadata(ctx context.Context, req datasou
 func (r *datasourceSecurityDlpProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -239,6 +241,8 @@ func (m *datasourceSecurityDlpProfileModel) refreshSecurityDlpProfile(ctx contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["dlpRules"]; ok {
 		m.DlpRules = m.flattenSecurityDlpProfileDlpRulesList(ctx, v, &diags)
 	}
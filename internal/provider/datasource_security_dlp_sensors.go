@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -32,6 +32,7 @@ type datasourceSecurityDlpSensorsModel struct {
 	EntryMatchesToTriggerSensor types.String                                          `tfsdk:"entry_matches_to_trigger_sensor"`
 	SensorDictionaries          []datasourceSecurityDlpSensorsSensorDictionariesModel `tfsdk:"sensor_dictionaries"`
 	Description                 types.String                                          `tfsdk:"description"`
+	RawJson                     types.String                                          `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityDlpSensors) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (r *datasourceSecurityDlpSensors) Metadata(ctx context.Context, req datasou
 func (r *datasourceSecurityDlpSensors) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 64),
@@ -64,16 +66,16 @@ func (r *datasourceSecurityDlpSensors) Schema(ctx context.Context, req datasourc
 			"sensor_dictionaries": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"dictionary_id": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 32),
+						"dictionary_id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 32),
 							},
 							Computed: true,
 							Optional: true,
 						},
-						"dictionary_matches_to_consider_risk": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.AtMost(255),
+						"dictionary_matches_to_consider_risk": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
 							},
 							Computed: true,
 							Optional: true,
@@ -171,6 +173,8 @@ func (m *datasourceSecurityDlpSensorsModel) refreshSecurityDlpSensors(ctx contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["entryMatchesToTriggerSensor"]; ok {
 		m.EntryMatchesToTriggerSensor = parseStringValue(v)
 	}
@@ -196,9 +200,9 @@ func (data *datasourceSecurityDlpSensorsModel) getURLObjectSecurityDlpSensors(ct
 }
 
 type datasourceSecurityDlpSensorsSensorDictionariesModel struct {
-	DictionaryId                    types.Float64                                                  `tfsdk:"dictionary_id"`
+	DictionaryId                    types.Int64                                                    `tfsdk:"dictionary_id"`
 	Dictionary                      *datasourceSecurityDlpSensorsSensorDictionariesDictionaryModel `tfsdk:"dictionary"`
-	DictionaryMatchesToConsiderRisk types.Float64                                                  `tfsdk:"dictionary_matches_to_consider_risk"`
+	DictionaryMatchesToConsiderRisk types.Int64                                                    `tfsdk:"dictionary_matches_to_consider_risk"`
 	Status                          types.String                                                   `tfsdk:"status"`
 }
 
@@ -216,7 +220,7 @@ func (m *datasourceSecurityDlpSensorsSensorDictionariesModel) flattenSecurityDlp
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["dictionaryId"]; ok {
-		m.DictionaryId = parseFloat64Value(v)
+		m.DictionaryId = parseInt64Value(v)
 	}
 
 	if v, ok := o["dictionary"]; ok {
@@ -224,7 +228,7 @@ func (m *datasourceSecurityDlpSensorsSensorDictionariesModel) flattenSecurityDlp
 	}
 
 	if v, ok := o["dictionaryMatchesToConsiderRisk"]; ok {
-		m.DictionaryMatchesToConsiderRisk = parseFloat64Value(v)
+		m.DictionaryMatchesToConsiderRisk = parseInt64Value(v)
 	}
 
 	if v, ok := o["status"]; ok {
@@ -39,6 +39,7 @@ type datasourceSecurityDnsFilterProfileModel struct {
 	FortiguardFilters             []datasourceSecurityDnsFilterProfileFortiguardFiltersModel       `tfsdk:"fortiguard_filters"`
 	DomainThreatFeedFilters       []datasourceSecurityDnsFilterProfileDomainThreatFeedFiltersModel `tfsdk:"domain_threat_feed_filters"`
 	Direction                     types.String                                                     `tfsdk:"direction"`
+	RawJson                       types.String                                                     `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityDnsFilterProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,6 +49,7 @@ func (r *datasourceSecurityDnsFilterProfile) Metadata(ctx context.Context, req d
 func (r *datasourceSecurityDnsFilterProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -286,6 +288,8 @@ func (m *datasourceSecurityDnsFilterProfileModel) refreshSecurityDnsFilterProfil
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["useForEdgeDevices"]; ok {
 		m.UseForEdgeDevices = parseBoolValue(v)
 	}
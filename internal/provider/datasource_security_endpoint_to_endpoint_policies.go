@@ -37,6 +37,7 @@ type datasourceSecurityEndpointToEndpointPoliciesModel struct {
 	Comments     types.String                                                   `tfsdk:"comments"`
 	ProfileGroup *datasourceSecurityEndpointToEndpointPoliciesProfileGroupModel `tfsdk:"profile_group"`
 	LogTraffic   types.String                                                   `tfsdk:"log_traffic"`
+	RawJson      types.String                                                   `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityEndpointToEndpointPolicies) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,7 @@ func (r *datasourceSecurityEndpointToEndpointPolicies) Metadata(ctx context.Cont
 func (r *datasourceSecurityEndpointToEndpointPolicies) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -245,6 +247,8 @@ func (m *datasourceSecurityEndpointToEndpointPoliciesModel) refreshSecurityEndpo
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
@@ -32,6 +32,7 @@ type datasourceSecurityFileFilterProfileModel struct {
 	Monitor                     []datasourceSecurityFileFilterProfileMonitorModel `tfsdk:"monitor"`
 	BlockPasswordProtectedFiles types.Bool                                        `tfsdk:"block_password_protected_files"`
 	Direction                   types.String                                      `tfsdk:"direction"`
+	RawJson                     types.String                                      `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityFileFilterProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (r *datasourceSecurityFileFilterProfile) Metadata(ctx context.Context, req
 func (r *datasourceSecurityFileFilterProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -161,6 +163,8 @@ func (m *datasourceSecurityFileFilterProfileModel) refreshSecurityFileFilterProf
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["block"]; ok {
 		m.Block = m.flattenSecurityFileFilterProfileBlockList(ctx, v, &diags)
 	}
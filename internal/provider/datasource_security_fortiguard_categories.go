@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -28,11 +29,12 @@ type datasourceSecurityFortiguardCategories struct {
 
 // datasourceSecurityFortiguardCategoriesModel describes the datasource data model.
 type datasourceSecurityFortiguardCategoriesModel struct {
-	PrimaryKey       types.String  `tfsdk:"primary_key"`
-	Ftntid           types.Float64 `tfsdk:"ftntid"`
-	Group            types.String  `tfsdk:"group"`
-	Rating           types.String  `tfsdk:"rating"`
-	BlockedInRatings types.Set     `tfsdk:"blocked_in_ratings"`
+	PrimaryKey       types.String `tfsdk:"primary_key"`
+	Ftntid           types.Int64  `tfsdk:"ftntid"`
+	Group            types.String `tfsdk:"group"`
+	Rating           types.String `tfsdk:"rating"`
+	BlockedInRatings types.Set    `tfsdk:"blocked_in_ratings"`
+	RawJson          types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityFortiguardCategories) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -42,10 +44,14 @@ func (r *datasourceSecurityFortiguardCategories) Metadata(ctx context.Context, r
 func (r *datasourceSecurityFortiguardCategories) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
-			"ftntid": schema.Float64Attribute{
+			"ftntid": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -137,8 +143,10 @@ func (m *datasourceSecurityFortiguardCategoriesModel) refreshSecurityFortiguardC
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["group"]; ok {
@@ -30,6 +30,7 @@ type datasourceSecurityFortiguardLocalCategoriesModel struct {
 	PrimaryKey   types.String `tfsdk:"primary_key"`
 	ThreatWeight types.String `tfsdk:"threat_weight"`
 	Urls         types.Set    `tfsdk:"urls"`
+	RawJson      types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityFortiguardLocalCategories) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,6 +40,7 @@ func (r *datasourceSecurityFortiguardLocalCategories) Metadata(ctx context.Conte
 func (r *datasourceSecurityFortiguardLocalCategories) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -124,6 +126,8 @@ func (m *datasourceSecurityFortiguardLocalCategoriesModel) refreshSecurityFortig
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["threatWeight"]; ok {
 		m.ThreatWeight = parseStringValue(v)
 	}
@@ -27,6 +27,7 @@ type datasourceSecurityGeoipCountries struct {
 type datasourceSecurityGeoipCountriesModel struct {
 	PrimaryKey  types.String `tfsdk:"primary_key"`
 	CountryName types.String `tfsdk:"country_name"`
+	RawJson     types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityGeoipCountries) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,6 +37,7 @@ func (r *datasourceSecurityGeoipCountries) Metadata(ctx context.Context, req dat
 func (r *datasourceSecurityGeoipCountries) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -110,6 +112,8 @@ func (m *datasourceSecurityGeoipCountriesModel) refreshSecurityGeoipCountries(ct
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["countryName"]; ok {
 		m.CountryName = parseStringValue(v)
 	}
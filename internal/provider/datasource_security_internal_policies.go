@@ -40,6 +40,7 @@ type datasourceSecurityInternalPoliciesModel struct {
 	LogTraffic          types.String                                          `tfsdk:"log_traffic"`
 	Sources             []datasourceSecurityInternalPoliciesSourcesModel      `tfsdk:"sources"`
 	CaptivePortalExempt types.Bool                                            `tfsdk:"captive_portal_exempt"`
+	RawJson             types.String                                          `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityInternalPolicies) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,6 +50,7 @@ func (r *datasourceSecurityInternalPolicies) Metadata(ctx context.Context, req d
 func (r *datasourceSecurityInternalPolicies) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -278,6 +280,8 @@ func (m *datasourceSecurityInternalPoliciesModel) refreshSecurityInternalPolicie
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
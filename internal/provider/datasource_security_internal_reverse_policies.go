@@ -38,6 +38,7 @@ type datasourceSecurityInternalReversePoliciesModel struct {
 	ProfileGroup *datasourceSecurityInternalReversePoliciesProfileGroupModel  `tfsdk:"profile_group"`
 	LogTraffic   types.String                                                 `tfsdk:"log_traffic"`
 	Destinations []datasourceSecurityInternalReversePoliciesDestinationsModel `tfsdk:"destinations"`
+	RawJson      types.String                                                 `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityInternalReversePolicies) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +48,7 @@ func (r *datasourceSecurityInternalReversePolicies) Metadata(ctx context.Context
 func (r *datasourceSecurityInternalReversePolicies) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -253,6 +255,8 @@ func (m *datasourceSecurityInternalReversePoliciesModel) refreshSecurityInternal
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -28,13 +28,14 @@ type datasourceSecurityIpThreatFeeds struct {
 
 // datasourceSecurityIpThreatFeedsModel describes the datasource data model.
 type datasourceSecurityIpThreatFeedsModel struct {
-	PrimaryKey          types.String  `tfsdk:"primary_key"`
-	Comments            types.String  `tfsdk:"comments"`
-	Status              types.String  `tfsdk:"status"`
-	RefreshRate         types.Float64 `tfsdk:"refresh_rate"`
-	Uri                 types.String  `tfsdk:"uri"`
-	BasicAuthentication types.String  `tfsdk:"basic_authentication"`
-	Username            types.String  `tfsdk:"username"`
+	PrimaryKey          types.String `tfsdk:"primary_key"`
+	Comments            types.String `tfsdk:"comments"`
+	Status              types.String `tfsdk:"status"`
+	RefreshRate         types.Int64  `tfsdk:"refresh_rate"`
+	Uri                 types.String `tfsdk:"uri"`
+	BasicAuthentication types.String `tfsdk:"basic_authentication"`
+	Username            types.String `tfsdk:"username"`
+	RawJson             types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityIpThreatFeeds) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,6 +45,7 @@ func (r *datasourceSecurityIpThreatFeeds) Metadata(ctx context.Context, req data
 func (r *datasourceSecurityIpThreatFeeds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -64,9 +66,9 @@ func (r *datasourceSecurityIpThreatFeeds) Schema(ctx context.Context, req dataso
 				Computed: true,
 				Optional: true,
 			},
-			"refresh_rate": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 43200),
+			"refresh_rate": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 43200),
 				},
 				Computed: true,
 				Optional: true,
@@ -159,6 +161,8 @@ func (m *datasourceSecurityIpThreatFeedsModel) refreshSecurityIpThreatFeeds(ctx
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["comments"]; ok {
 		m.Comments = parseStringValue(v)
 	}
@@ -168,7 +172,7 @@ func (m *datasourceSecurityIpThreatFeedsModel) refreshSecurityIpThreatFeeds(ctx
 	}
 
 	if v, ok := o["refreshRate"]; ok {
-		m.RefreshRate = parseFloat64Value(v)
+		m.RefreshRate = parseInt64Value(v)
 	}
 
 	if v, ok := o["uri"]; ok {
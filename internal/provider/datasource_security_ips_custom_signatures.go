@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,20 +28,21 @@ type datasourceSecurityIpsCustomSignatures struct {
 
 // datasourceSecurityIpsCustomSignaturesModel describes the datasource data model.
 type datasourceSecurityIpsCustomSignaturesModel struct {
-	PrimaryKey  types.String  `tfsdk:"primary_key"`
-	Tag         types.String  `tfsdk:"tag"`
-	Signature   types.String  `tfsdk:"signature"`
-	RuleId      types.Float64 `tfsdk:"rule_id"`
-	Status      types.String  `tfsdk:"status"`
-	Log         types.String  `tfsdk:"log"`
-	LogPacket   types.String  `tfsdk:"log_packet"`
-	Action      types.String  `tfsdk:"action"`
-	Severity    types.String  `tfsdk:"severity"`
-	Location    types.String  `tfsdk:"location"`
-	Os          types.String  `tfsdk:"os"`
-	Application types.String  `tfsdk:"application"`
-	Protocol    types.String  `tfsdk:"protocol"`
-	Comment     types.String  `tfsdk:"comment"`
+	PrimaryKey  types.String `tfsdk:"primary_key"`
+	Tag         types.String `tfsdk:"tag"`
+	Signature   types.String `tfsdk:"signature"`
+	RuleId      types.Int64  `tfsdk:"rule_id"`
+	Status      types.String `tfsdk:"status"`
+	Log         types.String `tfsdk:"log"`
+	LogPacket   types.String `tfsdk:"log_packet"`
+	Action      types.String `tfsdk:"action"`
+	Severity    types.String `tfsdk:"severity"`
+	Location    types.String `tfsdk:"location"`
+	Os          types.String `tfsdk:"os"`
+	Application types.String `tfsdk:"application"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Comment     types.String `tfsdk:"comment"`
+	RawJson     types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityIpsCustomSignatures) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,6 +52,7 @@ func (r *datasourceSecurityIpsCustomSignatures) Metadata(ctx context.Context, re
 func (r *datasourceSecurityIpsCustomSignatures) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 63),
@@ -67,7 +70,10 @@ func (r *datasourceSecurityIpsCustomSignatures) Schema(ctx context.Context, req
 				Computed: true,
 				Optional: true,
 			},
-			"rule_id": schema.Float64Attribute{
+			"rule_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -181,6 +187,8 @@ func (m *datasourceSecurityIpsCustomSignaturesModel) refreshSecurityIpsCustomSig
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["tag"]; ok {
 		m.Tag = parseStringValue(v)
 	}
@@ -190,7 +198,7 @@ func (m *datasourceSecurityIpsCustomSignaturesModel) refreshSecurityIpsCustomSig
 	}
 
 	if v, ok := o["ruleId"]; ok {
-		m.RuleId = parseFloat64Value(v)
+		m.RuleId = parseInt64Value(v)
 	}
 
 	if v, ok := o["status"]; ok {
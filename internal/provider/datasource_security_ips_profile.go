@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -36,6 +37,7 @@ type datasourceSecurityIpsProfileModel struct {
 	Comment                types.String                                        `tfsdk:"comment"`
 	Entries                []datasourceSecurityIpsProfileEntriesModel          `tfsdk:"entries"`
 	Direction              types.String                                        `tfsdk:"direction"`
+	RawJson                types.String                                        `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityIpsProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +47,7 @@ func (r *datasourceSecurityIpsProfile) Metadata(ctx context.Context, req datasou
 func (r *datasourceSecurityIpsProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -216,7 +219,10 @@ func (r *datasourceSecurityIpsProfile) Schema(ctx context.Context, req datasourc
 						"vuln_type": schema.ListNestedAttribute{
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
-									"id": schema.Float64Attribute{
+									"id": schema.Int64Attribute{
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
 										Computed: true,
 										Optional: true,
 									},
@@ -228,7 +234,10 @@ func (r *datasourceSecurityIpsProfile) Schema(ctx context.Context, req datasourc
 						"exempt_ip": schema.ListNestedAttribute{
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
-									"id": schema.Float64Attribute{
+									"id": schema.Int64Attribute{
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
 										Computed: true,
 										Optional: true,
 									},
@@ -317,6 +326,8 @@ func (m *datasourceSecurityIpsProfileModel) refreshSecurityIpsProfile(ctx contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["profileType"]; ok {
 		m.ProfileType = parseStringValue(v)
 	}
@@ -400,13 +411,13 @@ type datasourceSecurityIpsProfileEntriesRuleModel struct {
 }
 
 type datasourceSecurityIpsProfileEntriesVulnTypeModel struct {
-	Id types.Float64 `tfsdk:"id"`
+	Id types.Int64 `tfsdk:"id"`
 }
 
 type datasourceSecurityIpsProfileEntriesExemptIpModel struct {
-	Id    types.Float64 `tfsdk:"id"`
-	SrcIp types.String  `tfsdk:"src_ip"`
-	DstIp types.String  `tfsdk:"dst_ip"`
+	Id    types.Int64  `tfsdk:"id"`
+	SrcIp types.String `tfsdk:"src_ip"`
+	DstIp types.String `tfsdk:"dst_ip"`
 }
 
 func (m *datasourceSecurityIpsProfileCustomRuleGroupsModel) flattenSecurityIpsProfileCustomRuleGroups(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceSecurityIpsProfileCustomRuleGroupsModel {
@@ -650,7 +661,7 @@ func (m *datasourceSecurityIpsProfileEntriesVulnTypeModel) flattenSecurityIpsPro
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	return m
@@ -689,7 +700,7 @@ func (m *datasourceSecurityIpsProfileEntriesExemptIpModel) flattenSecurityIpsPro
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["src-ip"]; ok {
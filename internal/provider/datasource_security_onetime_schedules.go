@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -28,10 +28,11 @@ type datasourceSecurityOnetimeSchedules struct {
 
 // datasourceSecurityOnetimeSchedulesModel describes the datasource data model.
 type datasourceSecurityOnetimeSchedulesModel struct {
-	PrimaryKey     types.String  `tfsdk:"primary_key"`
-	ExpirationDays types.Float64 `tfsdk:"expiration_days"`
-	StartUtc       types.Float64 `tfsdk:"start_utc"`
-	EndUtc         types.Float64 `tfsdk:"end_utc"`
+	PrimaryKey     types.String `tfsdk:"primary_key"`
+	ExpirationDays types.Int64  `tfsdk:"expiration_days"`
+	StartUtc       types.Int64  `tfsdk:"start_utc"`
+	EndUtc         types.Int64  `tfsdk:"end_utc"`
+	RawJson        types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityOnetimeSchedules) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,24 +42,31 @@ func (r *datasourceSecurityOnetimeSchedules) Metadata(ctx context.Context, req d
 func (r *datasourceSecurityOnetimeSchedules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 31),
 				},
 				Required: true,
 			},
-			"expiration_days": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 100),
+			"expiration_days": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"start_utc": schema.Float64Attribute{
+			"start_utc": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"end_utc": schema.Float64Attribute{
+			"end_utc": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -129,16 +137,18 @@ func (m *datasourceSecurityOnetimeSchedulesModel) refreshSecurityOnetimeSchedule
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["expirationDays"]; ok {
-		m.ExpirationDays = parseFloat64Value(v)
+		m.ExpirationDays = parseInt64Value(v)
 	}
 
 	if v, ok := o["startUtc"]; ok {
-		m.StartUtc = parseFloat64Value(v)
+		m.StartUtc = parseInt64Value(v)
 	}
 
 	if v, ok := o["endUtc"]; ok {
-		m.EndUtc = parseFloat64Value(v)
+		m.EndUtc = parseInt64Value(v)
 	}
 
 	return diags
@@ -29,8 +29,9 @@ type datasourceSecurityPkiUsersModel struct {
 	Subject        types.String                       `tfsdk:"subject"`
 	Ca             *datasourceSecurityPkiUsersCaModel `tfsdk:"ca"`
 	IsStaticObject types.Bool                         `tfsdk:"is_static_object"`
-	References     types.Float64                      `tfsdk:"references"`
+	References     types.Int64                        `tfsdk:"references"`
 	IsGlobalEntry  types.Bool                         `tfsdk:"is_global_entry"`
+	RawJson        types.String                       `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityPkiUsers) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,6 +41,7 @@ func (r *datasourceSecurityPkiUsers) Metadata(ctx context.Context, req datasourc
 func (r *datasourceSecurityPkiUsers) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "Primary Key of PKI User.",
 				Required:            true,
@@ -51,7 +53,7 @@ func (r *datasourceSecurityPkiUsers) Schema(ctx context.Context, req datasource.
 			"is_static_object": schema.BoolAttribute{
 				Computed: true,
 			},
-			"references": schema.Float64Attribute{
+			"references": schema.Int64Attribute{
 				Computed: true,
 			},
 			"is_global_entry": schema.BoolAttribute{
@@ -135,6 +137,8 @@ func (m *datasourceSecurityPkiUsersModel) refreshSecurityPkiUsers(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["subject"]; ok {
 		m.Subject = parseStringValue(v)
 	}
@@ -148,7 +152,7 @@ func (m *datasourceSecurityPkiUsersModel) refreshSecurityPkiUsers(ctx context.Co
 	}
 
 	if v, ok := o["references"]; ok {
-		m.References = parseFloat64Value(v)
+		m.References = parseInt64Value(v)
 	}
 
 	if v, ok := o["isGlobalEntry"]; ok {
@@ -0,0 +1,283 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &datasourceSecurityPolicyOrder{}
+
+func newDatasourceSecurityPolicyOrder() datasource.DataSource {
+	return &datasourceSecurityPolicyOrder{}
+}
+
+type datasourceSecurityPolicyOrder struct {
+	fortiClient  *FortiClient
+	resourceName string
+}
+
+// datasourceSecurityPolicyOrderModel describes the datasource data model.
+type datasourceSecurityPolicyOrderModel struct {
+	ID       types.String `tfsdk:"id"`
+	Type     types.String `tfsdk:"type"`
+	Policies types.List   `tfsdk:"policies"`
+	Current  types.List   `tfsdk:"current"`
+	InOrder  types.Bool   `tfsdk:"in_order"`
+	Moves    types.List   `tfsdk:"moves"`
+}
+
+// securityPolicyRulebases are the collection endpoints of the policy types, the policies are listed in evaluation order.
+var securityPolicyRulebases = map[string]string{
+	"outbound":             "/resource-api/v2/security/outbound-policies",
+	"internal":             "/resource-api/v2/security/internal-policies",
+	"internal-reverse":     "/resource-api/v2/security/internal-reverse-policies",
+	"endpoint-to-endpoint": "/resource-api/v2/security/endpoint-to-endpoint-policies",
+}
+
+func (r *datasourceSecurityPolicyOrder) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_policy_order"
+}
+
+func (r *datasourceSecurityPolicyOrder) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks the evaluation order of the policies of one rulebase against the listed order. The FortiSASE API has no documented endpoint to reorder policies, so the order cannot be enforced: use this data source in a `check` block to report the policies reordered outside of Terraform, and the moves to make in the FortiSASE portal, as a warning. Policies that are not listed are ignored.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				Validators: []validator.String{
+					stringvalidator.OneOf("outbound", "internal", "internal-reverse", "endpoint-to-endpoint"),
+				},
+				MarkdownDescription: "The rulebase to check.\nSupported values: outbound, internal, internal-reverse, endpoint-to-endpoint.",
+				Required:            true,
+			},
+			"policies": schema.ListAttribute{
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+				MarkdownDescription: "The primary keys of the policies, in the order they should be evaluated.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"current": schema.ListAttribute{
+				MarkdownDescription: "The primary keys of the listed policies, in the order they are evaluated.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"in_order": schema.BoolAttribute{
+				MarkdownDescription: "Whether the listed policies are evaluated in the listed order.",
+				Computed:            true,
+			},
+			"moves": schema.ListAttribute{
+				MarkdownDescription: "The moves to make in the FortiSASE portal, in this order, to put the listed policies in the listed order, e.g. `move \"deny-all\" before \"allow-all\"`. Empty when `in_order` is true.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *datasourceSecurityPolicyOrder) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.resourceName = "fortisase_security_policy_order"
+}
+
+func (r *datasourceSecurityPolicyOrder) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	diags := &resp.Diagnostics
+	var data datasourceSecurityPolicyOrderModel
+
+	// Read Terraform prior config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	var desired []string
+	diags.Append(data.Policies.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	current, err := r.readOrder(ctx, data.Type.ValueString())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to read datasource %s: %v", r.resourceName, err), "")
+		return
+	}
+
+	order := policyOrderFilter(current, desired)
+	if len(order) != len(desired) {
+		for _, key := range desired {
+			if !policyOrderContains(order, key) {
+				diags.AddAttributeError(
+					path.Root("policies"),
+					"Policy Not Found",
+					fmt.Sprintf("The policy %q does not exist in the %s rulebase.", key, data.Type.ValueString()),
+				)
+			}
+		}
+		return
+	}
+
+	moves := make([]string, 0)
+	for _, move := range policyOrderMoves(order, desired) {
+		moves = append(moves, move.String())
+	}
+
+	data.ID = data.Type
+	data.InOrder = types.BoolValue(len(moves) == 0)
+	var d diag.Diagnostics
+	data.Current, d = types.ListValueFrom(ctx, types.StringType, order)
+	diags.Append(d...)
+	data.Moves, d = types.ListValueFrom(ctx, types.StringType, moves)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readOrder returns the primary keys of the policies of a rulebase in evaluation order.
+func (r *datasourceSecurityPolicyOrder) readOrder(ctx context.Context, policyType string) ([]string, error) {
+	url, ok := securityPolicyRulebases[policyType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported policy type %q", policyType)
+	}
+	return readPolicyOrder(ctx, r.fortiClient.Client, url)
+}
+
+// readPolicyOrder returns the primary keys of the policies listed by the collection endpoint url, in evaluation order.
+func readPolicyOrder(ctx context.Context, c *forticlient.FortiSDKClient, url string) ([]string, error) {
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.URL = url
+	output, err := c.ReadCollection(&input_model)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(output))
+	for _, item := range output {
+		if o, ok := item.(map[string]interface{}); ok {
+			if mkey := fortiStringValue(o["primaryKey"]); mkey != "" {
+				result = append(result, mkey)
+			}
+		}
+	}
+	return result, nil
+}
+
+// policyOrderExclude keeps the keys of current that are not listed in keys, in the order of current.
+func policyOrderExclude(current []string, keys []string) []string {
+	var result []string
+	for _, key := range current {
+		if !policyOrderContains(keys, key) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// policyOrderFilter keeps the keys of current that are listed in keys, in the order of current.
+func policyOrderFilter(current []string, keys []string) []string {
+	result := make([]string, 0, len(keys))
+	for _, key := range current {
+		if policyOrderContains(keys, key) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+func policyOrderContains(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// policyOrderMovesDetail describes the moves that put the policies of the rulebase policyType in the listed order.
+// The FortiSASE API has no documented endpoint to reorder policies, so the moves are made in the portal.
+func policyOrderMovesDetail(policyType string, moves []policyMove) string {
+	lines := make([]string, 0, len(moves))
+	for _, move := range moves {
+		lines = append(lines, "- "+move.String())
+	}
+	return fmt.Sprintf("The %s policies are not in the listed order and the FortiSASE API has no documented endpoint to reorder them. "+
+		"Make these moves in the FortiSASE portal, in this order, then apply again:\n%s", policyType, strings.Join(lines, "\n"))
+}
+
+// policyMove places a policy right before or after the target policy.
+type policyMove struct {
+	key    string
+	action string
+	target string
+}
+
+func (m policyMove) String() string {
+	return fmt.Sprintf("move %q %s %q", m.key, m.action, m.target)
+}
+
+// policyOrderMoves returns the moves that turn order into desired, both contain the same keys.
+// The first policy is moved before the others when needed,
+// then every following policy is moved after its predecessor when needed.
+func policyOrderMoves(order []string, desired []string) []policyMove {
+	order = append([]string(nil), order...)
+	var moves []policyMove
+	for i := 0; i < len(desired); i++ {
+		if order[i] == desired[i] {
+			continue
+		}
+		if i == 0 {
+			moves = append(moves, policyMove{key: desired[i], action: "before", target: order[0]})
+		} else {
+			moves = append(moves, policyMove{key: desired[i], action: "after", target: desired[i-1]})
+		}
+
+		// desired[:i] is already in place, so the policy is shifted to index i
+		for j := i + 1; j < len(order); j++ {
+			if order[j] == desired[i] {
+				copy(order[i+1:j+1], order[i:j])
+				order[i] = desired[i]
+				break
+			}
+		}
+	}
+	return moves
+}
@@ -0,0 +1,273 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// applyPolicyMoves returns order after the moves, as they are made in the FortiSASE portal.
+func applyPolicyMoves(t *testing.T, order []string, moves []policyMove) []string {
+	t.Helper()
+	result := append([]string(nil), order...)
+	for _, move := range moves {
+		from := -1
+		for i, key := range result {
+			if key == move.key {
+				from = i
+			}
+		}
+		if from < 0 {
+			t.Fatalf("move of unknown policy %q", move.key)
+		}
+		result = append(result[:from], result[from+1:]...)
+
+		to := -1
+		for i, key := range result {
+			if key == move.target {
+				to = i
+			}
+		}
+		if to < 0 {
+			t.Fatalf("move of %q relative to unknown policy %q", move.key, move.target)
+		}
+		if move.action == "after" {
+			to++
+		}
+		result = append(result[:to], append([]string{move.key}, result[to:]...)...)
+	}
+	return result
+}
+
+func TestPolicyOrderMoves(t *testing.T) {
+	cases := []struct {
+		name    string
+		order   []string
+		desired []string
+		moves   []policyMove
+	}{
+		{
+			name:    "in order",
+			order:   []string{"a", "b", "c"},
+			desired: []string{"a", "b", "c"},
+		},
+		{
+			name:    "empty",
+			order:   nil,
+			desired: nil,
+		},
+		{
+			name:    "last to first",
+			order:   []string{"a", "b", "c"},
+			desired: []string{"c", "a", "b"},
+			moves:   []policyMove{{key: "c", action: "before", target: "a"}},
+		},
+		{
+			name:    "swap last two",
+			order:   []string{"a", "b", "c"},
+			desired: []string{"a", "c", "b"},
+			moves:   []policyMove{{key: "c", action: "after", target: "a"}},
+		},
+		{
+			name:    "reverse",
+			order:   []string{"a", "b", "c", "d"},
+			desired: []string{"d", "c", "b", "a"},
+			moves: []policyMove{
+				{key: "d", action: "before", target: "a"},
+				{key: "c", action: "after", target: "d"},
+				{key: "b", action: "after", target: "c"},
+			},
+		},
+		{
+			name:    "first to last",
+			order:   []string{"a", "b", "c"},
+			desired: []string{"b", "c", "a"},
+			moves: []policyMove{
+				{key: "b", action: "before", target: "a"},
+				{key: "c", action: "after", target: "b"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			moves := policyOrderMoves(tc.order, tc.desired)
+			if !reflect.DeepEqual(moves, tc.moves) {
+				t.Errorf("policyOrderMoves(%v, %v) = %v, want %v", tc.order, tc.desired, moves, tc.moves)
+			}
+			if got := applyPolicyMoves(t, tc.order, moves); !reflect.DeepEqual(got, tc.desired) {
+				t.Errorf("the moves give %v, want %v", got, tc.desired)
+			}
+		})
+	}
+}
+
+func TestPolicyOrderMovesKeepsOrder(t *testing.T) {
+	order := []string{"a", "b", "c"}
+	policyOrderMoves(order, []string{"c", "b", "a"})
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("policyOrderMoves changed order to %v, want %v", order, want)
+	}
+}
+
+func TestPolicyOrderFilter(t *testing.T) {
+	cases := []struct {
+		name    string
+		current []string
+		keys    []string
+		want    []string
+	}{
+		{
+			name:    "keeps the order of current",
+			current: []string{"a", "b", "c"},
+			keys:    []string{"c", "a"},
+			want:    []string{"a", "c"},
+		},
+		{
+			name:    "drops the keys missing from current",
+			current: []string{"a", "b"},
+			keys:    []string{"b", "z"},
+			want:    []string{"b"},
+		},
+		{
+			name:    "no keys",
+			current: []string{"a", "b"},
+			keys:    nil,
+			want:    []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policyOrderFilter(tc.current, tc.keys); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("policyOrderFilter(%v, %v) = %v, want %v", tc.current, tc.keys, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPolicyOrderExclude(t *testing.T) {
+	cases := []struct {
+		name    string
+		current []string
+		keys    []string
+		want    []string
+	}{
+		{
+			name:    "unlisted policies in the order of current",
+			current: []string{"a", "b", "c", "d"},
+			keys:    []string{"c", "a"},
+			want:    []string{"b", "d"},
+		},
+		{
+			name:    "all listed",
+			current: []string{"a", "b"},
+			keys:    []string{"b", "a", "z"},
+			want:    nil,
+		},
+		{
+			name:    "empty rulebase",
+			current: nil,
+			keys:    []string{"a"},
+			want:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policyOrderExclude(tc.current, tc.keys); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("policyOrderExclude(%v, %v) = %v, want %v", tc.current, tc.keys, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPolicyOrderContains(t *testing.T) {
+	cases := []struct {
+		keys []string
+		key  string
+		want bool
+	}{
+		{keys: []string{"a", "b"}, key: "b", want: true},
+		{keys: []string{"a", "b"}, key: "c", want: false},
+		{keys: nil, key: "a", want: false},
+	}
+
+	for _, tc := range cases {
+		if got := policyOrderContains(tc.keys, tc.key); got != tc.want {
+			t.Errorf("policyOrderContains(%v, %q) = %v, want %v", tc.keys, tc.key, got, tc.want)
+		}
+	}
+}
+
+func TestSecurityPolicyOrderRead(t *testing.T) {
+	ctx := context.Background()
+	client := newTestFortiClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/resource-api/v2/security/outbound-policies" {
+			writeTestResponse(w, 404, nil)
+			return
+		}
+		writeTestResponse(w, 200, []interface{}{
+			map[string]interface{}{"primaryKey": "allow-all"},
+			map[string]interface{}{"primaryKey": "unlisted"},
+			map[string]interface{}{"primaryKey": "deny-all"},
+		})
+	}))
+	d := &datasourceSecurityPolicyOrder{fortiClient: client, resourceName: "fortisase_security_policy_order"}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	read := func(policies ...string) (datasourceSecurityPolicyOrderModel, datasource.ReadResponse) {
+		t.Helper()
+		config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		list, _ := types.ListValueFrom(ctx, types.StringType, policies)
+		if diags := config.Set(ctx, &datasourceSecurityPolicyOrderModel{
+			ID:       types.StringNull(),
+			Type:     types.StringValue("outbound"),
+			Policies: list,
+			Current:  types.ListNull(types.StringType),
+			InOrder:  types.BoolNull(),
+			Moves:    types.ListNull(types.StringType),
+		}); diags.HasError() {
+			t.Fatalf("config diagnostics: %v", diags)
+		}
+		resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+		var data datasourceSecurityPolicyOrderModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		}
+		return data, resp
+	}
+
+	data, resp := read("allow-all", "deny-all")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics: %v", resp.Diagnostics)
+	}
+	if !data.InOrder.ValueBool() || len(data.Moves.Elements()) != 0 {
+		t.Errorf("Read() in order = %v, moves %v, want in order without moves", data.InOrder, data.Moves)
+	}
+
+	data, resp = read("deny-all", "allow-all")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics: %v", resp.Diagnostics)
+	}
+	var current, moves []string
+	data.Current.ElementsAs(ctx, &current, false)
+	data.Moves.ElementsAs(ctx, &moves, false)
+	if data.InOrder.ValueBool() || !reflect.DeepEqual(current, []string{"allow-all", "deny-all"}) ||
+		!reflect.DeepEqual(moves, []string{`move "deny-all" before "allow-all"`}) {
+		t.Errorf("Read() in order = %v, current %v, moves %v, want the move of deny-all", data.InOrder, current, moves)
+	}
+
+	if _, resp = read("deny-all", "typo"); !resp.Diagnostics.HasError() {
+		t.Errorf("Read() of a missing policy did not fail")
+	}
+}
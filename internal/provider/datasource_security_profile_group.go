@@ -38,6 +38,7 @@ type datasourceSecurityProfileGroupModel struct {
 	IntrusionPreventionProfile *datasourceSecurityProfileGroupIntrusionPreventionProfileModel `tfsdk:"intrusion_prevention_profile"`
 	SslSshProfile              *datasourceSecurityProfileGroupSslSshProfileModel              `tfsdk:"ssl_ssh_profile"`
 	Direction                  types.String                                                   `tfsdk:"direction"`
+	RawJson                    types.String                                                   `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityProfileGroup) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +48,7 @@ func (r *datasourceSecurityProfileGroup) Metadata(ctx context.Context, req datas
 func (r *datasourceSecurityProfileGroup) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -398,6 +400,8 @@ func (m *datasourceSecurityProfileGroupModel) refreshSecurityProfileGroup(ctx co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["antivirusProfile"]; ok {
 		m.AntivirusProfile = m.AntivirusProfile.flattenSecurityProfileGroupAntivirusProfile(ctx, v, &diags)
 	}
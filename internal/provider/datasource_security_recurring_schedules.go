@@ -32,6 +32,7 @@ type datasourceSecurityRecurringSchedulesModel struct {
 	Days       types.Set    `tfsdk:"days"`
 	StartTime  types.String `tfsdk:"start_time"`
 	EndTime    types.String `tfsdk:"end_time"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityRecurringSchedules) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (r *datasourceSecurityRecurringSchedules) Metadata(ctx context.Context, req
 func (r *datasourceSecurityRecurringSchedules) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 31),
@@ -130,6 +132,8 @@ func (m *datasourceSecurityRecurringSchedulesModel) refreshSecurityRecurringSche
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["days"]; ok {
 		m.Days = parseSetValue(ctx, v, types.StringType)
 	}
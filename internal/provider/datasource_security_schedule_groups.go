@@ -29,6 +29,7 @@ type datasourceSecurityScheduleGroups struct {
 type datasourceSecurityScheduleGroupsModel struct {
 	PrimaryKey types.String                                   `tfsdk:"primary_key"`
 	Members    []datasourceSecurityScheduleGroupsMembersModel `tfsdk:"members"`
+	RawJson    types.String                                   `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityScheduleGroups) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,6 +39,7 @@ func (r *datasourceSecurityScheduleGroups) Metadata(ctx context.Context, req dat
 func (r *datasourceSecurityScheduleGroups) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 31),
@@ -130,6 +132,8 @@ func (m *datasourceSecurityScheduleGroupsModel) refreshSecurityScheduleGroups(ct
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["members"]; ok {
 		m.Members = m.flattenSecurityScheduleGroupsMembersList(ctx, v, &diags)
 	}
@@ -28,6 +28,7 @@ type datasourceSecurityServiceCategories struct {
 // datasourceSecurityServiceCategoriesModel describes the datasource data model.
 type datasourceSecurityServiceCategoriesModel struct {
 	PrimaryKey types.String `tfsdk:"primary_key"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityServiceCategories) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +38,7 @@ func (r *datasourceSecurityServiceCategories) Metadata(ctx context.Context, req
 func (r *datasourceSecurityServiceCategories) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 63),
@@ -110,6 +112,8 @@ func (m *datasourceSecurityServiceCategoriesModel) refreshSecurityServiceCategor
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	return diags
 }
 
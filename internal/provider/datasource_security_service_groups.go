@@ -30,6 +30,7 @@ type datasourceSecurityServiceGroupsModel struct {
 	PrimaryKey types.String                                  `tfsdk:"primary_key"`
 	Proxy      types.Bool                                    `tfsdk:"proxy"`
 	Members    []datasourceSecurityServiceGroupsMembersModel `tfsdk:"members"`
+	RawJson    types.String                                  `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityServiceGroups) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,6 +40,7 @@ func (r *datasourceSecurityServiceGroups) Metadata(ctx context.Context, req data
 func (r *datasourceSecurityServiceGroups) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(79),
@@ -135,6 +137,8 @@ func (m *datasourceSecurityServiceGroupsModel) refreshSecurityServiceGroups(ctx
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["proxy"]; ok {
 		m.Proxy = parseBoolValue(v)
 	}
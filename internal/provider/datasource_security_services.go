@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -32,11 +32,12 @@ type datasourceSecurityServicesModel struct {
 	Proxy          types.Bool                                     `tfsdk:"proxy"`
 	Category       types.String                                   `tfsdk:"category"`
 	Protocol       types.String                                   `tfsdk:"protocol"`
-	ProtocolNumber types.Float64                                  `tfsdk:"protocol_number"`
-	IcmpType       types.Float64                                  `tfsdk:"icmp_type"`
+	ProtocolNumber types.Int64                                    `tfsdk:"protocol_number"`
+	IcmpType       types.Int64                                    `tfsdk:"icmp_type"`
 	UdpPortrange   []datasourceSecurityServicesUdpPortrangeModel  `tfsdk:"udp_portrange"`
 	SctpPortrange  []datasourceSecurityServicesSctpPortrangeModel `tfsdk:"sctp_portrange"`
 	TcpPortrange   []datasourceSecurityServicesTcpPortrangeModel  `tfsdk:"tcp_portrange"`
+	RawJson        types.String                                   `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityServices) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,7 @@ func (r *datasourceSecurityServices) Metadata(ctx context.Context, req datasourc
 func (r *datasourceSecurityServices) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -70,16 +72,16 @@ func (r *datasourceSecurityServices) Schema(ctx context.Context, req datasource.
 				Computed: true,
 				Optional: true,
 			},
-			"protocol_number": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(254),
+			"protocol_number": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 254),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"icmp_type": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(4294967295),
+			"icmp_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 4294967295),
 				},
 				Computed: true,
 				Optional: true,
@@ -90,16 +92,16 @@ func (r *datasourceSecurityServices) Schema(ctx context.Context, req datasource.
 
 						"destination": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -110,16 +112,16 @@ func (r *datasourceSecurityServices) Schema(ctx context.Context, req datasource.
 						},
 						"source": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -139,16 +141,16 @@ func (r *datasourceSecurityServices) Schema(ctx context.Context, req datasource.
 
 						"destination": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -159,16 +161,16 @@ func (r *datasourceSecurityServices) Schema(ctx context.Context, req datasource.
 						},
 						"source": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -188,16 +190,16 @@ func (r *datasourceSecurityServices) Schema(ctx context.Context, req datasource.
 
 						"destination": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -208,16 +210,16 @@ func (r *datasourceSecurityServices) Schema(ctx context.Context, req datasource.
 						},
 						"source": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -298,6 +300,8 @@ func (m *datasourceSecurityServicesModel) refreshSecurityServices(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["proxy"]; ok {
 		m.Proxy = parseBoolValue(v)
 	}
@@ -311,11 +315,11 @@ func (m *datasourceSecurityServicesModel) refreshSecurityServices(ctx context.Co
 	}
 
 	if v, ok := o["protocolNumber"]; ok {
-		m.ProtocolNumber = parseFloat64Value(v)
+		m.ProtocolNumber = parseInt64Value(v)
 	}
 
 	if v, ok := o["icmpType"]; ok {
-		m.IcmpType = parseFloat64Value(v)
+		m.IcmpType = parseInt64Value(v)
 	}
 
 	if v, ok := o["udpPortrange"]; ok {
@@ -348,13 +352,13 @@ type datasourceSecurityServicesUdpPortrangeModel struct {
 }
 
 type datasourceSecurityServicesUdpPortrangeDestinationModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type datasourceSecurityServicesUdpPortrangeSourceModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type datasourceSecurityServicesSctpPortrangeModel struct {
@@ -363,13 +367,13 @@ type datasourceSecurityServicesSctpPortrangeModel struct {
 }
 
 type datasourceSecurityServicesSctpPortrangeDestinationModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type datasourceSecurityServicesSctpPortrangeSourceModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type datasourceSecurityServicesTcpPortrangeModel struct {
@@ -378,13 +382,13 @@ type datasourceSecurityServicesTcpPortrangeModel struct {
 }
 
 type datasourceSecurityServicesTcpPortrangeDestinationModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type datasourceSecurityServicesTcpPortrangeSourceModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 func (m *datasourceSecurityServicesUdpPortrangeModel) flattenSecurityServicesUdpPortrange(ctx context.Context, input interface{}, diags *diag.Diagnostics) *datasourceSecurityServicesUdpPortrangeModel {
@@ -439,11 +443,11 @@ func (m *datasourceSecurityServicesUdpPortrangeDestinationModel) flattenSecurity
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -458,11 +462,11 @@ func (m *datasourceSecurityServicesUdpPortrangeSourceModel) flattenSecurityServi
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -520,11 +524,11 @@ func (m *datasourceSecurityServicesSctpPortrangeDestinationModel) flattenSecurit
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -539,11 +543,11 @@ func (m *datasourceSecurityServicesSctpPortrangeSourceModel) flattenSecurityServ
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -601,11 +605,11 @@ func (m *datasourceSecurityServicesTcpPortrangeDestinationModel) flattenSecurity
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -620,11 +624,11 @@ func (m *datasourceSecurityServicesTcpPortrangeSourceModel) flattenSecurityServi
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -41,6 +41,7 @@ type datasourceSecuritySslSshProfileModel struct {
 	HostExemptions                      []datasourceSecuritySslSshProfileHostExemptionsModel        `tfsdk:"host_exemptions"`
 	UrlCategoryExemptions               []datasourceSecuritySslSshProfileUrlCategoryExemptionsModel `tfsdk:"url_category_exemptions"`
 	Direction                           types.String                                                `tfsdk:"direction"`
+	RawJson                             types.String                                                `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecuritySslSshProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,6 +51,7 @@ func (r *datasourceSecuritySslSshProfile) Metadata(ctx context.Context, req data
 func (r *datasourceSecuritySslSshProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -126,16 +128,16 @@ func (r *datasourceSecuritySslSshProfile) Schema(ctx context.Context, req dataso
 						Computed: true,
 						Optional: true,
 					},
-					"compressed_limit": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(10, 64),
+					"compressed_limit": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(10, 64),
 						},
 						Computed: true,
 						Optional: true,
 					},
-					"uncompressed_limit": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(10, 64),
+					"uncompressed_limit": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(10, 64),
 						},
 						Computed: true,
 						Optional: true,
@@ -266,6 +268,8 @@ func (m *datasourceSecuritySslSshProfileModel) refreshSecuritySslSshProfile(ctx
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["inspectionMode"]; ok {
 		m.InspectionMode = parseStringValue(v)
 	}
@@ -330,10 +334,10 @@ func (data *datasourceSecuritySslSshProfileModel) getURLObjectSecuritySslSshProf
 }
 
 type datasourceSecuritySslSshProfileProfileProtocolOptionsModel struct {
-	UnknownContentEncoding types.String  `tfsdk:"unknown_content_encoding"`
-	OversizedAction        types.String  `tfsdk:"oversized_action"`
-	CompressedLimit        types.Float64 `tfsdk:"compressed_limit"`
-	UncompressedLimit      types.Float64 `tfsdk:"uncompressed_limit"`
+	UnknownContentEncoding types.String `tfsdk:"unknown_content_encoding"`
+	OversizedAction        types.String `tfsdk:"oversized_action"`
+	CompressedLimit        types.Int64  `tfsdk:"compressed_limit"`
+	UncompressedLimit      types.Int64  `tfsdk:"uncompressed_limit"`
 }
 
 type datasourceSecuritySslSshProfileCaCertificateModel struct {
@@ -368,11 +372,11 @@ func (m *datasourceSecuritySslSshProfileProfileProtocolOptionsModel) flattenSecu
 	}
 
 	if v, ok := o["compressedLimit"]; ok {
-		m.CompressedLimit = parseFloat64Value(v)
+		m.CompressedLimit = parseInt64Value(v)
 	}
 
 	if v, ok := o["uncompressedLimit"]; ok {
-		m.UncompressedLimit = parseFloat64Value(v)
+		m.UncompressedLimit = parseInt64Value(v)
 	}
 
 	return m
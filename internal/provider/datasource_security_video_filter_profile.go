@@ -32,6 +32,7 @@ type datasourceSecurityVideoFilterProfileModel struct {
 	DefaultAction     types.String                                                 `tfsdk:"default_action"`
 	Channels          []datasourceSecurityVideoFilterProfileChannelsModel          `tfsdk:"channels"`
 	Direction         types.String                                                 `tfsdk:"direction"`
+	RawJson           types.String                                                 `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityVideoFilterProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (r *datasourceSecurityVideoFilterProfile) Metadata(ctx context.Context, req
 func (r *datasourceSecurityVideoFilterProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -184,6 +186,8 @@ func (m *datasourceSecurityVideoFilterProfileModel) refreshSecurityVideoFilterPr
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["fortiguardFilters"]; ok {
 		m.FortiguardFilters = m.flattenSecurityVideoFilterProfileFortiguardFiltersList(ctx, v, &diags)
 	}
@@ -29,6 +29,7 @@ type datasourceSecurityVideoFilterYoutubeKey struct {
 type datasourceSecurityVideoFilterYoutubeKeyModel struct {
 	PrimaryKey types.String `tfsdk:"primary_key"`
 	ApiKey     types.String `tfsdk:"api_key"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityVideoFilterYoutubeKey) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,6 +39,7 @@ func (r *datasourceSecurityVideoFilterYoutubeKey) Metadata(ctx context.Context,
 func (r *datasourceSecurityVideoFilterYoutubeKey) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -48,8 +50,9 @@ func (r *datasourceSecurityVideoFilterYoutubeKey) Schema(ctx context.Context, re
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 47),
 				},
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
 		},
 	}
@@ -117,6 +120,8 @@ func (m *datasourceSecurityVideoFilterYoutubeKeyModel) refreshSecurityVideoFilte
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["apiKey"]; ok {
 		m.ApiKey = parseStringValue(v)
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -40,6 +41,7 @@ type datasourceSecurityWebFilterProfileModel struct {
 	UrlFilters                     []datasourceSecurityWebFilterProfileUrlFiltersModel                     `tfsdk:"url_filters"`
 	HttpHeaders                    []datasourceSecurityWebFilterProfileHttpHeadersModel                    `tfsdk:"http_headers"`
 	Direction                      types.String                                                            `tfsdk:"direction"`
+	RawJson                        types.String                                                            `tfsdk:"raw_json"`
 }
 
 func (r *datasourceSecurityWebFilterProfile) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,6 +51,7 @@ func (r *datasourceSecurityWebFilterProfile) Metadata(ctx context.Context, req d
 func (r *datasourceSecurityWebFilterProfile) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -238,7 +241,10 @@ func (r *datasourceSecurityWebFilterProfile) Schema(ctx context.Context, req dat
 							Computed: true,
 							Optional: true,
 						},
-						"score": schema.Float64Attribute{
+						"score": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -389,6 +395,8 @@ func (m *datasourceSecurityWebFilterProfileModel) refreshSecurityWebFilterProfil
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["fortiguardFilters"]; ok {
 		m.FortiguardFilters = m.flattenSecurityWebFilterProfileFortiguardFiltersList(ctx, v, &diags)
 	}
@@ -486,12 +494,12 @@ type datasourceSecurityWebFilterProfileFqdnThreatFeedFiltersCategoryModel struct
 }
 
 type datasourceSecurityWebFilterProfileContentFiltersModel struct {
-	Status      types.String  `tfsdk:"status"`
-	Pattern     types.String  `tfsdk:"pattern"`
-	PatternType types.String  `tfsdk:"pattern_type"`
-	Lang        types.String  `tfsdk:"lang"`
-	Action      types.String  `tfsdk:"action"`
-	Score       types.Float64 `tfsdk:"score"`
+	Status      types.String `tfsdk:"status"`
+	Pattern     types.String `tfsdk:"pattern"`
+	PatternType types.String `tfsdk:"pattern_type"`
+	Lang        types.String `tfsdk:"lang"`
+	Action      types.String `tfsdk:"action"`
+	Score       types.Int64  `tfsdk:"score"`
 }
 
 type datasourceSecurityWebFilterProfileUrlFiltersModel struct {
@@ -740,7 +748,7 @@ func (m *datasourceSecurityWebFilterProfileContentFiltersModel) flattenSecurityW
 	}
 
 	if v, ok := o["score"]; ok {
-		m.Score = parseFloat64Value(v)
+		m.Score = parseInt64Value(v)
 	}
 
 	return m
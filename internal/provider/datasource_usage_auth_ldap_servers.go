@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,9 +27,10 @@ type datasourceUsageAuthLdapServers struct {
 
 // datasourceUsageAuthLdapServersModel describes the datasource data model.
 type datasourceUsageAuthLdapServersModel struct {
-	Type       types.String  `tfsdk:"type"`
-	Ftntcount  types.Float64 `tfsdk:"ftntcount"`
-	PrimaryKey types.String  `tfsdk:"primary_key"`
+	Type       types.String `tfsdk:"type"`
+	Ftntcount  types.Int64  `tfsdk:"ftntcount"`
+	PrimaryKey types.String `tfsdk:"primary_key"`
+	RawJson    types.String `tfsdk:"raw_json"`
 }
 
 func (r *datasourceUsageAuthLdapServers) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,11 +40,15 @@ func (r *datasourceUsageAuthLdapServers) Metadata(ctx context.Context, req datas
 func (r *datasourceUsageAuthLdapServers) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONDatasourceAttribute(),
 			"type": schema.StringAttribute{
 				Computed: true,
 				Optional: true,
 			},
-			"ftntcount": schema.Float64Attribute{
+			"ftntcount": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -116,12 +123,14 @@ func (m *datasourceUsageAuthLdapServersModel) refreshUsageAuthLdapServers(ctx co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["type"]; ok {
 		m.Type = parseStringValue(v)
 	}
 
 	if v, ok := o["count"]; ok {
-		m.Ftntcount = parseFloat64Value(v)
+		m.Ftntcount = parseInt64Value(v)
 	}
 
 	return diags
@@ -0,0 +1,52 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute is the deletion_protection attribute of the resources whose deletion may cut off users,
+// it is kept in the state only and never sent to FortiSASE.
+func deletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Whether to refuse to delete the object. Set it to `false` and apply before destroying the resource. Defaults to the provider argument `deletion_protection_default`.",
+		Computed:            true,
+		Optional:            true,
+	}
+}
+
+// planDeletionProtection sets the deletion_protection attribute at p to the provider default when it is not configured.
+func planDeletionProtection(ctx context.Context, client *FortiClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, p path.Path) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var v types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &v)...)
+	if resp.Diagnostics.HasError() || !v.IsNull() {
+		return
+	}
+
+	defaultValue := client != nil && client.DeletionProtectionDefault
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p, types.BoolValue(defaultValue))...)
+}
+
+// isDeletionProtected reports an error and returns true when the prior state protects the object against deletion.
+func isDeletionProtected(v types.Bool, resourceName string, mkey string, diags *diag.Diagnostics) bool {
+	if !v.ValueBool() {
+		return false
+	}
+
+	diags.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("The object %q of resource %s is protected against deletion. Set deletion_protection to false and apply it before destroying or replacing the resource.", mkey, resourceName),
+	)
+	return true
+}
@@ -0,0 +1,207 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ephemeralAccessToken{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralAccessToken{}
+var _ ephemeral.EphemeralResourceWithClose = &ephemeralAccessToken{}
+
+func newEphemeralAccessToken() ephemeral.EphemeralResource {
+	return &ephemeralAccessToken{}
+}
+
+type ephemeralAccessToken struct {
+	fortiClient  *FortiClient
+	resourceName string
+}
+
+// ephemeralAccessTokenModel describes the ephemeral resource data model.
+type ephemeralAccessTokenModel struct {
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	ClientId      types.String `tfsdk:"client_id"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	RefreshToken  types.String `tfsdk:"refresh_token"`
+	TokenType     types.String `tfsdk:"token_type"`
+	Scope         types.String `tfsdk:"scope"`
+	ExpiresIn     types.Int64  `tfsdk:"expires_in"`
+}
+
+// ephemeralAccessTokenPrivate is kept in the private data between Open and Close.
+type ephemeralAccessTokenPrivate struct {
+	AccessToken string `json:"access_token"`
+	ClientId    string `json:"client_id"`
+}
+
+func (r *ephemeralAccessToken) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *ephemeralAccessToken) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a short-lived access token that other providers or scripts can use. The token is never stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of API user. Defaults to the username of the provider.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of API user. Defaults to the password of the provider.",
+				Sensitive:           true,
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The client ID the token is scoped to. Defaults to `FortiSASE`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Optional: true,
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				MarkdownDescription: "Whether to revoke the token when Terraform no longer needs it. Defaults to `true`.",
+				Optional:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The generated access token.",
+				Sensitive:           true,
+				Computed:            true,
+			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token of the generated access token.",
+				Sensitive:           true,
+				Computed:            true,
+			},
+			"token_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"scope": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "The lifetime of the access token in seconds.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralAccessToken) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.resourceName = "fortisase_access_token"
+}
+
+func (r *ephemeralAccessToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	diags := &resp.Diagnostics
+	var data ephemeralAccessTokenModel
+
+	// Read Terraform config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	c := r.fortiClient.Client
+	username := c.Config.Auth.Username
+	if !data.Username.IsNull() {
+		username = data.Username.ValueString()
+	}
+	password := c.Config.Auth.Password
+	if !data.Password.IsNull() {
+		password = data.Password.ValueString()
+	}
+	client_id := "FortiSASE"
+	if !data.ClientId.IsNull() {
+		client_id = data.ClientId.ValueString()
+	}
+	if username == "" || password == "" {
+		diags.AddError(
+			fmt.Sprintf("Error to open ephemeral resource %s", r.resourceName),
+			"Username and password are required to generate an access token. Set them in the ephemeral resource or in the provider.",
+		)
+		return
+	}
+
+	output, err := c.CreateAccessToken(ctx, username, password, client_id)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to open ephemeral resource %s: %v", r.resourceName, err),
+			"",
+		)
+		return
+	}
+
+	data.ClientId = types.StringValue(client_id)
+	data.AccessToken = parseStringValue(output["access_token"])
+	data.RefreshToken = parseStringValue(output["refresh_token"])
+	data.TokenType = parseStringValue(output["token_type"])
+	data.Scope = parseStringValue(output["scope"])
+	data.ExpiresIn = types.Int64Value(int64(fortiIntValue(output["expires_in"])))
+
+	if data.RevokeOnClose.IsNull() || data.RevokeOnClose.ValueBool() {
+		private, err := json.Marshal(ephemeralAccessTokenPrivate{
+			AccessToken: data.AccessToken.ValueString(),
+			ClientId:    client_id,
+		})
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error to save private data of %s: %v", r.resourceName, err), "")
+			return
+		}
+		diags.Append(resp.Private.SetKey(ctx, "token", private)...)
+	}
+
+	diags.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ephemeralAccessToken) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	diags := &resp.Diagnostics
+
+	private, d := req.Private.GetKey(ctx, "token")
+	diags.Append(d...)
+	if diags.HasError() || private == nil {
+		return
+	}
+
+	var token ephemeralAccessTokenPrivate
+	if err := json.Unmarshal(private, &token); err != nil {
+		diags.AddError(fmt.Sprintf("Error to load private data of %s: %v", r.resourceName, err), "")
+		return
+	}
+
+	c := r.fortiClient.Client
+	if err := c.RevokeAccessToken(ctx, token.AccessToken, token.ClientId); err != nil {
+		diags.AddWarning(
+			fmt.Sprintf("Error to revoke the token of %s: %v", r.resourceName, err),
+			"The token stays valid until it expires.",
+		)
+	}
+}
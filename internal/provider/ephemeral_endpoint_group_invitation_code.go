@@ -0,0 +1,234 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ephemeralEndpointGroupInvitationCode{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralEndpointGroupInvitationCode{}
+var _ ephemeral.EphemeralResourceWithClose = &ephemeralEndpointGroupInvitationCode{}
+
+func newEphemeralEndpointGroupInvitationCode() ephemeral.EphemeralResource {
+	return &ephemeralEndpointGroupInvitationCode{}
+}
+
+type ephemeralEndpointGroupInvitationCode struct {
+	fortiClient  *FortiClient
+	resourceName string
+}
+
+// ephemeralEndpointGroupInvitationCodeModel describes the ephemeral resource data model.
+type ephemeralEndpointGroupInvitationCodeModel struct {
+	PrimaryKey      types.String                                              `tfsdk:"primary_key"`
+	ExpireDate      types.String                                              `tfsdk:"expire_date"`
+	GroupAssignment *resourceEndpointGroupInvitationCodesGroupAssignmentModel `tfsdk:"group_assignment"`
+	KeepOnClose     types.Bool                                                `tfsdk:"keep_on_close"`
+}
+
+func (r *ephemeralEndpointGroupInvitationCode) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_group_invitation_code"
+}
+
+func (r *ephemeralEndpointGroupInvitationCode) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates an endpoint group invitation code for the duration of a Terraform run and deletes it when Terraform no longer needs it.",
+		Attributes: map[string]schema.Attribute{
+			"primary_key": schema.StringAttribute{
+				MarkdownDescription: "The invitation code.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+				Sensitive: true,
+				Required:  true,
+			},
+			"expire_date": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+			},
+			"keep_on_close": schema.BoolAttribute{
+				MarkdownDescription: "Keep the invitation code in FortiSASE after the run instead of deleting it. Defaults to `false`.",
+				Optional:            true,
+			},
+			"group_assignment": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed: true,
+						Optional: true,
+					},
+					"group": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.Int64Attribute{
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+								Computed: true,
+								Optional: true,
+							},
+							"path": schema.StringAttribute{
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+								},
+								Computed: true,
+								Optional: true,
+							},
+						},
+						Computed: true,
+						Optional: true,
+					},
+				},
+				Computed: true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralEndpointGroupInvitationCode) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.resourceName = "fortisase_endpoint_group_invitation_code"
+}
+
+func (r *ephemeralEndpointGroupInvitationCode) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	lock := r.fortiClient.GetResourceLock("EndpointGroupInvitationCodes")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+	var data ephemeralEndpointGroupInvitationCodeModel
+
+	// Read Terraform config data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// The invitation code shares the request body with resource fortisase_endpoint_group_invitation_codes.
+	code := resourceEndpointGroupInvitationCodesModel{
+		PrimaryKey:      data.PrimaryKey,
+		ExpireDate:      data.ExpireDate,
+		GroupAssignment: data.GroupAssignment,
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.BodyParams = *(code.getCreateObjectEndpointGroupInvitationCodes(ctx, diags))
+	input_model.URLParams = *(code.getURLObjectEndpointGroupInvitationCodes(ctx, "create", diags))
+
+	if diags.HasError() {
+		return
+	}
+	output, err := c.CreateEndpointGroupInvitationCodes(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to open ephemeral resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+
+	mkey := fmt.Sprintf("%v", output["primaryKey"])
+	if !data.KeepOnClose.ValueBool() {
+		private, _ := json.Marshal(mkey)
+		diags.Append(resp.Private.SetKey(ctx, "primary_key", private)...)
+	}
+
+	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
+	read_input_model.Mkey = mkey
+	read_input_model.URLParams = *(code.getURLObjectEndpointGroupInvitationCodes(ctx, "read", diags))
+
+	read_output, err := c.ReadEndpointGroupInvitationCodes(&read_input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read ephemeral resource %s: %v", r.resourceName, err),
+			getErrorDetail(&read_input_model, read_output),
+		)
+		// Close is not called when Open fails, the code would never be deleted
+		r.deleteCode(ctx, mkey, diags)
+		return
+	}
+
+	diags.Append(code.refreshEndpointGroupInvitationCodes(ctx, read_output)...)
+	if diags.HasError() {
+		r.deleteCode(ctx, mkey, diags)
+		return
+	}
+	data.PrimaryKey = types.StringValue(mkey)
+	data.ExpireDate = code.ExpireDate
+	data.GroupAssignment = code.GroupAssignment
+
+	diags.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ephemeralEndpointGroupInvitationCode) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	lock := r.fortiClient.GetResourceLock("EndpointGroupInvitationCodes")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+
+	private, d := req.Private.GetKey(ctx, "primary_key")
+	diags.Append(d...)
+	if diags.HasError() || private == nil {
+		return
+	}
+
+	var mkey string
+	if err := json.Unmarshal(private, &mkey); err != nil {
+		diags.AddError(fmt.Sprintf("Error to load private data of %s: %v", r.resourceName, err), "")
+		return
+	}
+
+	r.deleteCode(ctx, mkey, diags)
+}
+
+// deleteCode deletes the invitation code mkey.
+func (r *ephemeralEndpointGroupInvitationCode) deleteCode(ctx context.Context, mkey string, diags *diag.Diagnostics) {
+	code := resourceEndpointGroupInvitationCodesModel{
+		PrimaryKey: types.StringValue(mkey),
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+	input_model.URLParams = *(code.getURLObjectEndpointGroupInvitationCodes(ctx, "delete", diags))
+
+	output, err := c.DeleteEndpointGroupInvitationCodes(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to delete the invitation code %s of ephemeral resource %s: %v", mkey, r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+}
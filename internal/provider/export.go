@@ -0,0 +1,553 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// exportCollection describes a collection endpoint walked by the export command.
+type exportCollection struct {
+	typeName    string
+	path        string
+	newResource func() resource.Resource
+	// mkey is the field holding the identifier of the objects, "primaryKey" when empty.
+	mkey string
+	// parent is the collection listing the objects when path cannot be listed,
+	// e.g. each profile group has a profile of each type with the same primary key.
+	parent string
+}
+
+// exportCollections lists the resources whose objects can be listed from a collection endpoint.
+var exportCollections = []exportCollection{
+	{typeName: "fortisase_auth_fsso_agents", path: "/resource-api/v2/auth/fsso-agents", newResource: newResourceAuthFssoAgents},
+	{typeName: "fortisase_auth_ldap_servers", path: "/resource-api/v2/auth/ldap-servers", newResource: newResourceAuthLdapServers},
+	{typeName: "fortisase_auth_radius_servers", path: "/resource-api/v2/auth/radius-servers", newResource: newResourceAuthRadiusServers},
+	{typeName: "fortisase_auth_user_groups", path: "/resource-api/v2/auth/user-groups", newResource: newResourceAuthUserGroups},
+	{typeName: "fortisase_auth_users", path: "/resource-api/v2/auth/users", newResource: newResourceAuthUsers},
+	{typeName: "fortisase_dem_custom_saas_apps", path: "/resource-api/v2/dem/custom-saas-apps", newResource: newResourceDemCustomSaasApps},
+	{typeName: "fortisase_dem_spa_applications", path: "/resource-api/v2/dem/spa-applications", newResource: newResourceDemSpaApplications},
+	{typeName: "fortisase_endpoint_policies", path: "/resource-api/v2/endpoint/policies", newResource: newResourceEndpointPolicies},
+	{typeName: "fortisase_endpoint_ztna_rules", path: "/resource-api/v2/endpoint/ztna-rules", newResource: newResourceEndpointZtnaRules},
+	{typeName: "fortisase_endpoint_ztna_tags", path: "/resource-api/v2/endpoint/ztna-tags", newResource: newResourceEndpointZtnaTags},
+	{typeName: "fortisase_endpoint_on_net_rules", path: "/resource-api/v2/endpoint/on-net-rules", newResource: newResourceEndpointOnNetRules},
+	{typeName: "fortisase_endpoint_group_invitation_codes", path: "/resource-api/v2/endpoint/group-invitation-codes", newResource: newResourceEndpointGroupInvitationCodes},
+	{typeName: "fortisase_infra_ssids", path: "/resource-api/v2/infra/ssids", newResource: newResourceInfraSsids},
+	{typeName: "fortisase_network_dns_rules", path: "/resource-api/v2/network/dns-rules", newResource: newResourceNetworkDnsRules},
+	{typeName: "fortisase_network_host_groups", path: "/resource-api/v2/network/host-groups", newResource: newResourceNetworkHostGroups},
+	{typeName: "fortisase_network_hosts", path: "/resource-api/v2/network/hosts", newResource: newResourceNetworkHosts},
+	{typeName: "fortisase_private_access_service_connections", path: "/resource-api/v1/private-access/service-connections", newResource: newResourcePrivateAccessServiceConnections, mkey: "id"},
+	{typeName: "fortisase_security_app_custom_signatures", path: "/resource-api/v2/security/app-custom-signatures", newResource: newResourceSecurityAppCustomSignatures},
+	{typeName: "fortisase_security_cert_local_ca_certs", path: "/resource-api/v1/security/cert/local-ca-certs", newResource: newResourceSecurityCertLocalCaCerts},
+	{typeName: "fortisase_security_cert_local_certs", path: "/resource-api/v1/security/cert/local-certs", newResource: newResourceSecurityCertLocalCerts},
+	{typeName: "fortisase_security_cert_remote_ca_certs", path: "/resource-api/v1/security/cert/remote-ca-certs", newResource: newResourceSecurityCertRemoteCaCerts},
+	{typeName: "fortisase_security_cert_remote_certs", path: "/resource-api/v1/security/cert/remote-certs", newResource: newResourceSecurityCertRemoteCerts},
+	{typeName: "fortisase_security_dlp_dictionaries", path: "/resource-api/v2/security/dlp-dictionaries", newResource: newResourceSecurityDlpDictionaries},
+	{typeName: "fortisase_security_dlp_exact_data_matches", path: "/resource-api/v2/security/dlp-exact-data-matches", newResource: newResourceSecurityDlpExactDataMatches},
+	{typeName: "fortisase_security_dlp_file_patterns", path: "/resource-api/v2/security/dlp-file-patterns", newResource: newResourceSecurityDlpFilePatterns},
+	{typeName: "fortisase_security_dlp_fingerprint_databases", path: "/resource-api/v2/security/dlp-fingerprint-databases", newResource: newResourceSecurityDlpFingerprintDatabases},
+	{typeName: "fortisase_security_dlp_sensors", path: "/resource-api/v2/security/dlp-sensors", newResource: newResourceSecurityDlpSensors},
+	{typeName: "fortisase_security_domain_threat_feeds", path: "/resource-api/v2/security/domain-threat-feeds", newResource: newResourceSecurityDomainThreatFeeds},
+	{typeName: "fortisase_security_endpoint_to_endpoint_policies", path: "/resource-api/v2/security/endpoint-to-endpoint-policies", newResource: newResourceSecurityEndpointToEndpointPolicies},
+	{typeName: "fortisase_security_fortiguard_local_categories", path: "/resource-api/v2/security/fortiguard-local-categories", newResource: newResourceSecurityFortiguardLocalCategories},
+	{typeName: "fortisase_security_internal_policies", path: "/resource-api/v2/security/internal-policies", newResource: newResourceSecurityInternalPolicies},
+	{typeName: "fortisase_security_internal_reverse_policies", path: "/resource-api/v2/security/internal-reverse-policies", newResource: newResourceSecurityInternalReversePolicies},
+	{typeName: "fortisase_security_ip_threat_feeds", path: "/resource-api/v2/security/ip-threat-feeds", newResource: newResourceSecurityIpThreatFeeds},
+	{typeName: "fortisase_security_ips_custom_signatures", path: "/resource-api/v2/security/ips-custom-signatures", newResource: newResourceSecurityIpsCustomSignatures},
+	{typeName: "fortisase_security_onetime_schedules", path: "/resource-api/v2/security/onetime-schedules", newResource: newResourceSecurityOnetimeSchedules},
+	{typeName: "fortisase_security_outbound_policies", path: "/resource-api/v2/security/outbound-policies", newResource: newResourceSecurityOutboundPolicies},
+	{typeName: "fortisase_security_pki_users", path: "/resource-api/v1/security/pki-users", newResource: newResourceSecurityPkiUsers},
+	{typeName: "fortisase_security_profile_group", path: "/resource-api/v2/security/profile-groups", newResource: newResourceSecurityProfileGroup},
+	{typeName: "fortisase_security_antivirus_profile", path: "/resource-api/v2/security/antivirus-profiles", newResource: newResourceSecurityAntivirusProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_application_control_profile", path: "/resource-api/v2/security/application-control-profiles", newResource: newResourceSecurityApplicationControlProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_dlp_profile", path: "/resource-api/v2/security/dlp-profiles", newResource: newResourceSecurityDlpProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_dns_filter_profile", path: "/resource-api/v2/security/dns-filter-profiles", newResource: newResourceSecurityDnsFilterProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_file_filter_profile", path: "/resource-api/v2/security/file-filter-profiles", newResource: newResourceSecurityFileFilterProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_ips_profile", path: "/resource-api/v2/security/ips-profiles", newResource: newResourceSecurityIpsProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_ssl_ssh_profile", path: "/resource-api/v2/security/ssl-ssh-profiles", newResource: newResourceSecuritySslSshProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_video_filter_profile", path: "/resource-api/v2/security/video-filter-profiles", newResource: newResourceSecurityVideoFilterProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_web_filter_profile", path: "/resource-api/v2/security/web-filter-profiles", newResource: newResourceSecurityWebFilterProfile, parent: "/resource-api/v2/security/profile-groups"},
+	{typeName: "fortisase_security_recurring_schedules", path: "/resource-api/v2/security/recurring-schedules", newResource: newResourceSecurityRecurringSchedules},
+	{typeName: "fortisase_security_schedule_groups", path: "/resource-api/v2/security/schedule-groups", newResource: newResourceSecurityScheduleGroups},
+	{typeName: "fortisase_security_service_groups", path: "/resource-api/v2/security/service-groups", newResource: newResourceSecurityServiceGroups},
+	{typeName: "fortisase_security_services", path: "/resource-api/v2/security/services", newResource: newResourceSecurityServices},
+	{typeName: "fortisase_security_url_threat_feeds", path: "/resource-api/v2/security/url-threat-feeds", newResource: newResourceSecurityUrlThreatFeeds},
+}
+
+var (
+	exportVersionPattern = regexp.MustCompile(`^/resource-api/v\d+/`)
+	exportLabelPattern   = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+	exportIdentPattern   = regexp.MustCompile(`^[a-z_]`)
+)
+
+// datasource returns the value used by {primary_key, datasource} references
+// to point at this collection, e.g. "network/hosts".
+func (e *exportCollection) datasource() string {
+	return exportVersionPattern.ReplaceAllString(e.path, "")
+}
+
+// objectKey returns the identifier of the object o of the collection, which is also its import ID.
+func (e *exportCollection) objectKey(o map[string]interface{}) string {
+	if e.mkey != "" {
+		return fortiStringValue(o[e.mkey])
+	}
+	return fortiStringValue(o["primaryKey"])
+}
+
+// list returns the objects of the collection. The objects of a collection with a parent
+// are read one by one with the primary keys of the parent objects.
+func (e *exportCollection) list(ctx context.Context, c *forticlient.FortiSDKClient) ([]map[string]interface{}, error) {
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.URL = e.path
+	if e.parent != "" {
+		input_model.URL = e.parent
+	}
+	output, err := c.ReadCollection(&input_model)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []map[string]interface{}
+	for _, v := range output {
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if e.parent == "" {
+			result = append(result, o)
+			continue
+		}
+
+		mkey := fortiStringValue(o["primaryKey"])
+		if mkey == "" {
+			continue
+		}
+		var read_input_model forticlient.InputModel
+		read_input_model.Ctx = ctx
+		read_input_model.Mkey = mkey
+		read_input_model.URL = e.path + "/{primaryKey}"
+		read_output, err := c.ReadObject(&read_input_model)
+		if err != nil {
+			return nil, err
+		}
+		if read_output == nil {
+			continue
+		}
+		if _, ok := read_output["primaryKey"]; !ok {
+			read_output["primaryKey"] = mkey
+		}
+		result = append(result, read_output)
+	}
+	return result, nil
+}
+
+// exportExpr is an HCL expression written verbatim, such as a resource reference.
+type exportExpr string
+
+// exportVariable is an input variable holding the value of a sensitive attribute, which is not exported.
+type exportVariable struct {
+	name      string
+	attribute schema.Attribute
+}
+
+type exportObject struct {
+	label      string
+	primaryKey string
+	data       map[string]interface{}
+}
+
+type exporter struct {
+	client  *forticlient.FortiSDKClient
+	schemas map[string]schema.Schema
+	objects map[string][]*exportObject
+	// addresses maps datasource and primary key to the resource address.
+	addresses map[string]map[string]string
+	// depends records the resource types referenced by each resource type.
+	depends map[string]map[string]bool
+}
+
+// RunExport implements the "export" subcommand. It walks every collection
+// endpoint of the tenant and writes one .tf file per resource type holding
+// resource and import blocks for the existing objects.
+func RunExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	outDir := fs.String("dir", ".", "directory the generated .tf files are written to")
+	types := fs.String("types", "", "comma separated resource types to export, e.g. fortisase_network_hosts; default is all")
+	username := fs.String("username", "", "the username of API user, defaults to FORTISASE_ACCESS_USERNAME")
+	password := fs.String("password", "", "the password of API user, defaults to FORTISASE_IAM_PASSWORD")
+	accessToken := fs.String("access-token", "", "the access token of API user, defaults to FORTISASE_ACCESS_TOKEN")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	config := Config{
+		Username:    *username,
+		Password:    *password,
+		AccessToken: *accessToken,
+	}
+	client, err := config.CreateClient()
+	if err != nil {
+		return err
+	}
+
+	selected := make(map[string]bool)
+	for _, t := range strings.Split(*types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			selected[t] = true
+		}
+	}
+
+	e := &exporter{
+		client:    client.(*FortiClient).Client,
+		schemas:   make(map[string]schema.Schema),
+		objects:   make(map[string][]*exportObject),
+		addresses: make(map[string]map[string]string),
+		depends:   make(map[string]map[string]bool),
+	}
+
+	var collections []exportCollection
+	for _, col := range exportCollections {
+		if len(selected) > 0 && !selected[col.typeName] {
+			continue
+		}
+		if err := e.load(ctx, col); err != nil {
+			return err
+		}
+		collections = append(collections, col)
+	}
+
+	if err := os.MkdirAll(*outDir, 0755); err != nil {
+		return err
+	}
+	for _, col := range e.order(collections) {
+		if len(e.objects[col.typeName]) == 0 {
+			continue
+		}
+		content, variables := e.render(col)
+		file := filepath.Join(*outDir, strings.TrimPrefix(col.typeName, "fortisase_")+".tf")
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			return err
+		}
+		log.Printf("[INFO] exported %d objects of %s to %s", len(e.objects[col.typeName]), col.typeName, file)
+		if len(variables) > 0 {
+			names := make([]string, 0, len(variables))
+			for _, v := range variables {
+				names = append(names, v.name)
+			}
+			log.Printf("[WARN] the sensitive attributes of %s are not exported, set the variables declared in %s: %s", col.typeName, file, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// load lists the objects of a collection and records their resource addresses.
+func (e *exporter) load(ctx context.Context, col exportCollection) error {
+	r := col.newResource()
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		return fmt.Errorf("Error to get schema of %s", col.typeName)
+	}
+	e.schemas[col.typeName] = resp.Schema
+
+	output, err := col.list(ctx, e.client)
+	if err != nil {
+		return fmt.Errorf("Error to list %s: %v", col.typeName, err)
+	}
+
+	labels := make(map[string]bool)
+	addresses := make(map[string]string)
+	for _, o := range output {
+		mkey := col.objectKey(o)
+		if mkey == "" {
+			continue
+		}
+		label := exportLabel(mkey, labels)
+		e.objects[col.typeName] = append(e.objects[col.typeName], &exportObject{
+			label:      label,
+			primaryKey: mkey,
+			data:       o,
+		})
+		addresses[mkey] = col.typeName + "." + label
+	}
+	e.addresses[col.datasource()] = addresses
+	return nil
+}
+
+// exportLabel converts a primary key into a unique Terraform resource name.
+func exportLabel(mkey string, used map[string]bool) string {
+	label := strings.ToLower(exportLabelPattern.ReplaceAllString(mkey, "_"))
+	if label == "" || !exportIdentPattern.MatchString(label) {
+		label = "_" + label
+	}
+	unique := label
+	for i := 2; used[unique]; i++ {
+		unique = label + "_" + strconv.Itoa(i)
+	}
+	used[unique] = true
+	return unique
+}
+
+// order sorts the collections so that referenced resource types come first.
+func (e *exporter) order(collections []exportCollection) []exportCollection {
+	// Rendering resolves the references, so render once to collect the dependencies.
+	for _, col := range collections {
+		e.render(col)
+	}
+
+	var result []exportCollection
+	visited := make(map[string]bool)
+	byType := make(map[string]exportCollection)
+	for _, col := range collections {
+		byType[col.typeName] = col
+	}
+
+	var visit func(col exportCollection)
+	visit = func(col exportCollection) {
+		if visited[col.typeName] {
+			return
+		}
+		visited[col.typeName] = true
+		var deps []string
+		for dep := range e.depends[col.typeName] {
+			deps = append(deps, dep)
+		}
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if d, ok := byType[dep]; ok {
+				visit(d)
+			}
+		}
+		result = append(result, col)
+	}
+	for _, col := range collections {
+		visit(col)
+	}
+	return result
+}
+
+// render returns the import, variable and resource blocks of the objects of col,
+// and the variables declared for their sensitive attributes.
+func (e *exporter) render(col exportCollection) (string, []exportVariable) {
+	var b strings.Builder
+	var all []exportVariable
+	for _, obj := range e.objects[col.typeName] {
+		var variables []exportVariable
+		prefix := strings.TrimPrefix(col.typeName, "fortisase_") + "_" + obj.label
+		values := e.convertAttributes(col.typeName, prefix, e.schemas[col.typeName].Attributes, obj.data, &variables)
+
+		fmt.Fprintf(&b, "import {\n  to = %s.%s\n  id = %s\n}\n\n", col.typeName, obj.label, quoteHCLString(obj.primaryKey))
+		for _, v := range variables {
+			fmt.Fprintf(&b, "variable %q {\n", v.name)
+			attributes := map[string]interface{}{"sensitive": true}
+			if _, ok := v.attribute.(schema.StringAttribute); ok {
+				attributes["type"] = exportExpr("string")
+			}
+			writeExportAttributes(&b, attributes, 1)
+			b.WriteString("}\n\n")
+		}
+		fmt.Fprintf(&b, "resource %q %q {\n", col.typeName, obj.label)
+		writeExportAttributes(&b, values, 1)
+		b.WriteString("}\n\n")
+		all = append(all, variables...)
+	}
+	return b.String(), all
+}
+
+// convertAttributes maps an API object onto the configurable attributes of a schema.
+// The sensitive attributes are read from variables named after prefix, which are added to variables.
+func (e *exporter) convertAttributes(typeName string, prefix string, attributes map[string]schema.Attribute, o map[string]interface{}, variables *[]exportVariable) map[string]interface{} {
+	keys := make(map[string]string)
+	for k := range o {
+		keys[exportNormalizeKey(k)] = k
+	}
+
+	result := make(map[string]interface{})
+	for name, attribute := range attributes {
+		if name == "id" || attribute.IsWriteOnly() || (!attribute.IsRequired() && !attribute.IsOptional()) {
+			continue
+		}
+		key, ok := keys[exportNormalizeKey(strings.TrimPrefix(name, "ftnt"))]
+		if attribute.IsSensitive() {
+			// The value is masked or missing, the required ones and those that are set are left to a variable
+			if attribute.IsRequired() || (ok && o[key] != nil) {
+				variable := exportVariable{name: prefix + "_" + name, attribute: attribute}
+				*variables = append(*variables, variable)
+				result[name] = exportExpr("var." + variable.name)
+			}
+			continue
+		}
+		if !ok || o[key] == nil {
+			continue
+		}
+		if v := e.convertValue(typeName, prefix+"_"+name, attribute, o[key], variables); v != nil {
+			result[name] = v
+		}
+	}
+
+	// Reference to another exported object.
+	if pk, ok := result["primary_key"].(string); ok {
+		if ds, ok := result["datasource"].(string); ok {
+			if address, ok := e.addresses[ds][pk]; ok {
+				result["primary_key"] = exportExpr(address + ".primary_key")
+				dep := strings.SplitN(address, ".", 2)[0]
+				if dep != typeName {
+					if e.depends[typeName] == nil {
+						e.depends[typeName] = make(map[string]bool)
+					}
+					e.depends[typeName][dep] = true
+				}
+			}
+		}
+	}
+	return result
+}
+
+func (e *exporter) convertValue(typeName string, prefix string, attribute schema.Attribute, v interface{}, variables *[]exportVariable) interface{} {
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		switch val := v.(type) {
+		case string:
+			return val
+		case bool:
+			return parseStringValue(val).ValueString()
+		case float64:
+			return strconv.FormatFloat(val, 'f', -1, 64)
+		}
+	case schema.BoolAttribute:
+		if b := parseBoolValue(v); !b.IsNull() {
+			return b.ValueBool()
+		}
+	case schema.Float64Attribute, schema.Int64Attribute, schema.NumberAttribute:
+		if f := parseFloat64Value(v); !f.IsNull() {
+			return f.ValueFloat64()
+		}
+	case schema.ListAttribute, schema.SetAttribute:
+		if l, ok := v.([]interface{}); ok {
+			return l
+		}
+	case schema.SingleNestedAttribute:
+		if m, ok := v.(map[string]interface{}); ok {
+			return e.convertAttributes(typeName, prefix, a.Attributes, m, variables)
+		}
+	case schema.ListNestedAttribute:
+		return e.convertNestedList(typeName, prefix, a.NestedObject.Attributes, v, variables)
+	case schema.SetNestedAttribute:
+		return e.convertNestedList(typeName, prefix, a.NestedObject.Attributes, v, variables)
+	}
+	return nil
+}
+
+func (e *exporter) convertNestedList(typeName string, prefix string, attributes map[string]schema.Attribute, v interface{}, variables *[]exportVariable) interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	result := make([]interface{}, 0, len(l))
+	for _, item := range l {
+		if m, ok := item.(map[string]interface{}); ok {
+			result = append(result, e.convertAttributes(typeName, prefix+"_"+strconv.Itoa(len(result)), attributes, m, variables))
+		}
+	}
+	return result
+}
+
+// exportNormalizeKey lets the snake_case attribute names match the camelCase API keys.
+func exportNormalizeKey(k string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(k))
+}
+
+func writeExportAttributes(b *strings.Builder, values map[string]interface{}, indent int) {
+	names := make([]string, 0, len(values))
+	width := 0
+	for name := range values {
+		names = append(names, name)
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(b, "%s%-*s = ", strings.Repeat("  ", indent), width, name)
+		writeExportValue(b, values[name], indent)
+		b.WriteString("\n")
+	}
+}
+
+func writeExportValue(b *strings.Builder, v interface{}, indent int) {
+	switch val := v.(type) {
+	case exportExpr:
+		b.WriteString(string(val))
+	case string:
+		b.WriteString(quoteHCLString(val))
+	case bool:
+		b.WriteString(strconv.FormatBool(val))
+	case float64:
+		b.WriteString(strconv.FormatFloat(val, 'f', -1, 64))
+	case []interface{}:
+		if len(val) == 0 {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteString("[\n")
+		for _, item := range val {
+			b.WriteString(strings.Repeat("  ", indent+1))
+			writeExportValue(b, item, indent+1)
+			b.WriteString(",\n")
+		}
+		b.WriteString(strings.Repeat("  ", indent) + "]")
+	case map[string]interface{}:
+		b.WriteString("{\n")
+		writeExportAttributes(b, val, indent+1)
+		b.WriteString(strings.Repeat("  ", indent) + "}")
+	default:
+		b.WriteString(quoteHCLString(fmt.Sprintf("%v", val)))
+	}
+}
+
+// quoteHCLString returns v as an HCL string literal. Only the escape sequences of HCL are used,
+// and the template sequences "${" and "%{" are escaped so that the value is not evaluated.
+func quoteHCLString(v string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range v {
+		switch {
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(v[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,238 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestQuoteHCLString(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "plain", value: "web-server", want: `"web-server"`},
+		{name: "quotes and backslashes", value: `a "b" \c`, want: `"a \"b\" \\c"`},
+		{name: "control characters", value: "a\nb\tc\r\x01", want: `"a\nb\tc\r\u0001"`},
+		{name: "templates", value: "${var.a} %{if x}", want: `"$${var.a} %%{if x}"`},
+		{name: "lone template characters", value: "$5 100%", want: `"$5 100%"`},
+		{name: "unicode", value: "café", want: `"café"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := quoteHCLString(tc.value); got != tc.want {
+				t.Errorf("quoteHCLString(%q) = %s, want %s", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExportLabel(t *testing.T) {
+	used := make(map[string]bool)
+	cases := []struct {
+		mkey string
+		want string
+	}{
+		{mkey: "Web Server", want: "web_server"},
+		{mkey: "web server", want: "web_server_2"},
+		{mkey: "10.0.0.1/32", want: "_10_0_0_1_32"},
+		{mkey: "", want: "_"},
+		{mkey: "all-hosts", want: "all-hosts"},
+	}
+
+	for _, tc := range cases {
+		if got := exportLabel(tc.mkey, used); got != tc.want {
+			t.Errorf("exportLabel(%q) = %q, want %q", tc.mkey, got, tc.want)
+		}
+	}
+}
+
+func TestExportCollectionDatasource(t *testing.T) {
+	cases := []struct {
+		path string
+		want string
+	}{
+		{path: "/resource-api/v2/network/hosts", want: "network/hosts"},
+		{path: "/resource-api/v1/security/cert/local-certs", want: "security/cert/local-certs"},
+	}
+
+	for _, tc := range cases {
+		col := exportCollection{path: tc.path}
+		if got := col.datasource(); got != tc.want {
+			t.Errorf("datasource() of %q = %q, want %q", tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestExportCollectionObjectKey(t *testing.T) {
+	o := map[string]interface{}{"primaryKey": "web", "id": "0a1b"}
+	if got := (&exportCollection{}).objectKey(o); got != "web" {
+		t.Errorf("objectKey() = %q, want %q", got, "web")
+	}
+	if got := (&exportCollection{mkey: "id"}).objectKey(o); got != "0a1b" {
+		t.Errorf("objectKey() with mkey id = %q, want %q", got, "0a1b")
+	}
+}
+
+func TestExportConvertAttributes(t *testing.T) {
+	attributes := map[string]schema.Attribute{
+		"id":          schema.StringAttribute{Computed: true},
+		"primary_key": schema.StringAttribute{Required: true},
+		"comments":    schema.StringAttribute{Optional: true},
+		"port":        schema.Int64Attribute{Optional: true},
+		"enabled":     schema.BoolAttribute{Optional: true},
+		"uuid":        schema.StringAttribute{Computed: true},
+		"password":    schema.StringAttribute{Optional: true, Sensitive: true},
+		"password_wo": schema.StringAttribute{Optional: true, Sensitive: true, WriteOnly: true},
+		"secret":      schema.StringAttribute{Required: true, Sensitive: true},
+		"token":       schema.StringAttribute{Optional: true, Sensitive: true},
+		"backup_links": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name":                 schema.StringAttribute{Optional: true},
+					"ipsec_pre_shared_key": schema.StringAttribute{Optional: true, Sensitive: true},
+				},
+			},
+		},
+	}
+	o := map[string]interface{}{
+		"id":          "1",
+		"primaryKey":  "web",
+		"comments":    "a",
+		"port":        443.0,
+		"enabled":     true,
+		"uuid":        "u",
+		"password":    "******",
+		"passwordWo":  "******",
+		"backupLinks": []interface{}{map[string]interface{}{"name": "b1", "ipsecPreSharedKey": "******"}},
+	}
+
+	e := &exporter{addresses: make(map[string]map[string]string), depends: make(map[string]map[string]bool)}
+	var variables []exportVariable
+	got := e.convertAttributes("fortisase_test", "test_web", attributes, o, &variables)
+	want := map[string]interface{}{
+		"primary_key": "web",
+		"comments":    "a",
+		"port":        443.0,
+		"enabled":     true,
+		"password":    exportExpr("var.test_web_password"),
+		"secret":      exportExpr("var.test_web_secret"),
+		"backup_links": []interface{}{
+			map[string]interface{}{"name": "b1", "ipsec_pre_shared_key": exportExpr("var.test_web_backup_links_0_ipsec_pre_shared_key")},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("convertAttributes() = %v, want %v", got, want)
+	}
+
+	var names []string
+	for _, v := range variables {
+		names = append(names, v.name)
+	}
+	// The map iteration order of the schema decides the order of the variables
+	sort.Strings(names)
+	wantNames := []string{"test_web_backup_links_0_ipsec_pre_shared_key", "test_web_password", "test_web_secret"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("variables = %v, want %v", names, wantNames)
+	}
+}
+
+func TestExportConvertAttributesReference(t *testing.T) {
+	attributes := map[string]schema.Attribute{
+		"primary_key": schema.StringAttribute{Required: true},
+		"datasource":  schema.StringAttribute{Optional: true},
+	}
+	e := &exporter{
+		addresses: map[string]map[string]string{"network/hosts": {"web": "fortisase_network_hosts.web"}},
+		depends:   make(map[string]map[string]bool),
+	}
+
+	got := e.convertAttributes("fortisase_network_host_groups", "network_host_groups_all", attributes, map[string]interface{}{"primaryKey": "web", "datasource": "network/hosts"}, new([]exportVariable))
+	want := map[string]interface{}{"primary_key": exportExpr("fortisase_network_hosts.web.primary_key"), "datasource": "network/hosts"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("convertAttributes() = %v, want %v", got, want)
+	}
+	if !e.depends["fortisase_network_host_groups"]["fortisase_network_hosts"] {
+		t.Errorf("the dependency on fortisase_network_hosts is not recorded: %v", e.depends)
+	}
+}
+
+func TestWriteExportAttributes(t *testing.T) {
+	values := map[string]interface{}{
+		"primary_key": "web",
+		"port":        443.0,
+		"enabled":     true,
+		"empty":       []interface{}{},
+		"members":     []interface{}{map[string]interface{}{"primary_key": exportExpr("fortisase_network_hosts.a.primary_key")}},
+		"comments":    "${x}",
+	}
+	want := strings.Join([]string{
+		`  comments    = "$${x}"`,
+		`  empty       = []`,
+		`  enabled     = true`,
+		`  members     = [`,
+		`    {`,
+		`      primary_key = fortisase_network_hosts.a.primary_key`,
+		`    },`,
+		`  ]`,
+		`  port        = 443`,
+		`  primary_key = "web"`,
+		``,
+	}, "\n")
+
+	var b strings.Builder
+	writeExportAttributes(&b, values, 1)
+	if got := b.String(); got != want {
+		t.Errorf("writeExportAttributes() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExportRender(t *testing.T) {
+	col := exportCollection{typeName: "fortisase_auth_users", path: "/resource-api/v2/auth/users"}
+	e := &exporter{
+		schemas: map[string]schema.Schema{
+			col.typeName: {Attributes: map[string]schema.Attribute{
+				"primary_key": schema.StringAttribute{Required: true},
+				"password":    schema.StringAttribute{Required: true, Sensitive: true},
+			}},
+		},
+		objects: map[string][]*exportObject{
+			col.typeName: {{label: "alice", primaryKey: "alice", data: map[string]interface{}{"primaryKey": "alice"}}},
+		},
+		addresses: make(map[string]map[string]string),
+		depends:   make(map[string]map[string]bool),
+	}
+	want := strings.Join([]string{
+		`import {`,
+		`  to = fortisase_auth_users.alice`,
+		`  id = "alice"`,
+		`}`,
+		``,
+		`variable "auth_users_alice_password" {`,
+		`  sensitive = true`,
+		`  type      = string`,
+		`}`,
+		``,
+		`resource "fortisase_auth_users" "alice" {`,
+		`  password    = var.auth_users_alice_password`,
+		`  primary_key = "alice"`,
+		`}`,
+		``,
+		``,
+	}, "\n")
+
+	got, variables := e.render(col)
+	if got != want {
+		t.Errorf("render() =\n%s\nwant\n%s", got, want)
+	}
+	if len(variables) != 1 || variables[0].name != "auth_users_alice_password" {
+		t.Errorf("render() variables = %v, want auth_users_alice_password", variables)
+	}
+}
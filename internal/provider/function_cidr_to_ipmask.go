@@ -0,0 +1,52 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &functionCidrToIpmask{}
+
+func newFunctionCidrToIpmask() function.Function {
+	return &functionCidrToIpmask{}
+}
+
+type functionCidrToIpmask struct{}
+
+func (f *functionCidrToIpmask) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_to_ipmask"
+}
+
+func (f *functionCidrToIpmask) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert a CIDR into the \"<ip> <netmask>\" format",
+		MarkdownDescription: "Converts an IPv4 CIDR such as `10.0.0.0/24` into the `10.0.0.0 255.255.255.0` format returned by FortiSASE.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The IPv4 CIDR to convert.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *functionCidrToIpmask) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := convCIDR2IPMask(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
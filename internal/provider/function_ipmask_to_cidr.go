@@ -0,0 +1,52 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &functionIpmaskToCidr{}
+
+func newFunctionIpmaskToCidr() function.Function {
+	return &functionIpmaskToCidr{}
+}
+
+type functionIpmaskToCidr struct{}
+
+func (f *functionIpmaskToCidr) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipmask_to_cidr"
+}
+
+func (f *functionIpmaskToCidr) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert an \"<ip> <netmask>\" address into a CIDR",
+		MarkdownDescription: "Converts an address in the `10.0.0.0 255.255.255.0` format returned by FortiSASE into the IPv4 CIDR `10.0.0.0/24`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ipmask",
+				MarkdownDescription: "The IPv4 address and netmask separated by a space.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *functionIpmaskToCidr) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipmask string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipmask))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := convIPMask2CIDR(ipmask)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
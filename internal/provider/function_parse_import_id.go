@@ -0,0 +1,84 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &functionParseImportId{}
+
+func newFunctionParseImportId() function.Function {
+	return &functionParseImportId{}
+}
+
+type functionParseImportId struct{}
+
+func (f *functionParseImportId) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *functionParseImportId) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse an import ID",
+		MarkdownDescription: "Parses an import ID in the format of `<primary_key>` or `<datasource>/<primary_key>`, such as `network/hosts/web-server`, into a `{primary_key, datasource}` object. `datasource` is null when the ID has no known datasource prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The import ID to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: functionReferenceAttrTypes,
+		},
+	}
+}
+
+func (f *functionParseImportId) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	id = strings.TrimPrefix(id, "/")
+	if id == "" {
+		resp.Error = function.NewArgumentFuncError(0, "id must not be empty")
+		return
+	}
+
+	// Match the longest datasource prefix, e.g. "security/dlp-sensors" before "security".
+	datasource := types.StringNull()
+	primaryKey := id
+	matched := ""
+	for _, v := range fortisaseDatasources {
+		if strings.HasPrefix(id, v+"/") && len(v) > len(matched) {
+			matched = v
+		}
+	}
+	if matched != "" {
+		datasource = types.StringValue(matched)
+		primaryKey = strings.TrimPrefix(id, matched+"/")
+	}
+	if primaryKey == "" {
+		resp.Error = function.NewArgumentFuncError(0, "the primary key of id must not be empty")
+		return
+	}
+
+	result, diags := types.ObjectValue(functionReferenceAttrTypes, map[string]attr.Value{
+		"primary_key": types.StringValue(primaryKey),
+		"datasource":  datasource,
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &functionPortRange{}
+
+func newFunctionPortRange() function.Function {
+	return &functionPortRange{}
+}
+
+type functionPortRange struct{}
+
+var functionPortRangeBoundAttrTypes = map[string]attr.Type{
+	"low":  types.Int64Type,
+	"high": types.Int64Type,
+}
+
+var functionPortRangeAttrTypes = map[string]attr.Type{
+	"destination": types.ObjectType{AttrTypes: functionPortRangeBoundAttrTypes},
+	"source":      types.ObjectType{AttrTypes: functionPortRangeBoundAttrTypes},
+}
+
+func (f *functionPortRange) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "port_range"
+}
+
+func (f *functionPortRange) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a port range string",
+		MarkdownDescription: "Parses a port range such as `443`, `8000-8080` or `8000-8080:1024-2048` into an element of `tcp_portrange`, `udp_portrange` or `sctp_portrange` of resource `fortisase_security_services`. The optional part after the colon is the source port range, `source` is null when it is omitted.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "range",
+				MarkdownDescription: "The port range in the format of `<low>[-<high>][:<low>[-<high>]]`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: functionPortRangeAttrTypes,
+		},
+	}
+}
+
+func (f *functionPortRange) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var portRange string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &portRange))
+	if resp.Error != nil {
+		return
+	}
+
+	dst, src, err := parsePortRange(portRange)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	bound := func(v []int64) attr.Value {
+		if v == nil {
+			return types.ObjectNull(functionPortRangeBoundAttrTypes)
+		}
+		result, _ := types.ObjectValue(functionPortRangeBoundAttrTypes, map[string]attr.Value{
+			"low":  types.Int64Value(v[0]),
+			"high": types.Int64Value(v[1]),
+		})
+		return result
+	}
+
+	result, diags := types.ObjectValue(functionPortRangeAttrTypes, map[string]attr.Value{
+		"destination": bound(dst),
+		"source":      bound(src),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &functionRef{}
+
+func newFunctionRef() function.Function {
+	return &functionRef{}
+}
+
+type functionRef struct{}
+
+// functionReferenceAttrTypes is the {primary_key, datasource} object used to reference other objects.
+var functionReferenceAttrTypes = map[string]attr.Type{
+	"primary_key": types.StringType,
+	"datasource":  types.StringType,
+}
+
+func (f *functionRef) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ref"
+}
+
+func (f *functionRef) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a reference object",
+		MarkdownDescription: "Builds the `{primary_key, datasource}` object used by nested reference attributes, such as the users, sources and destinations of security policies.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "kind",
+				MarkdownDescription: "The datasource path of the referenced object, for example `network/hosts` or `security/services`.",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The primary key of the referenced object.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: functionReferenceAttrTypes,
+		},
+	}
+}
+
+func (f *functionRef) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kind, name string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &kind, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	kind = strings.Trim(kind, "/")
+	if !slices.Contains(fortisaseDatasources, kind) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a known datasource, it must be one of: %s", kind, strings.Join(fortisaseDatasources, ", ")))
+		return
+	}
+	if name == "" {
+		resp.Error = function.NewArgumentFuncError(1, "name must not be empty")
+		return
+	}
+
+	result, diags := types.ObjectValue(functionReferenceAttrTypes, map[string]attr.Value{
+		"primary_key": types.StringValue(name),
+		"datasource":  types.StringValue(kind),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
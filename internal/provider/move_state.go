@@ -0,0 +1,70 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// moveStateFrom returns a state mover that retargets the state of another resource type of this provider,
+// e.g. a clone resource, onto this resource through a `moved` block.
+// The primary key becomes the ID and the attributes the target schema shares with the source are carried,
+// the remaining attributes are filled by the refresh that follows the move.
+// The attributes listed in dropped, such as the deprecated direction, are not carried and are null in the target state.
+func moveStateFrom(sourceTypeName string, dropped ...string) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceTypeName || !strings.HasSuffix(req.SourceProviderAddress, "fortinetdev/fortisase") {
+				return
+			}
+			if req.SourceRawState == nil || req.SourceRawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Error to move state from %s", sourceTypeName),
+					"The source state is not in JSON format. Please report this issue to the provider developers.",
+				)
+				return
+			}
+
+			var source map[string]interface{}
+			if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error to move state from %s: %v", sourceTypeName, err), "")
+				return
+			}
+
+			primaryKey := fortiStringValue(source["primary_key"])
+			if primaryKey == "" {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Error to move state from %s", sourceTypeName),
+					"The source state has no primary_key. Set primary_key in the source resource and apply it before moving it.",
+				)
+				return
+			}
+
+			for _, name := range dropped {
+				delete(source, name)
+			}
+			source["id"] = primaryKey
+
+			// The attributes that are not in the target schema, such as based_on, are ignored
+			// and the target attributes that are not in the source are null.
+			b, err := json.Marshal(source)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error to move state from %s: %v", sourceTypeName, err), "")
+				return
+			}
+			target, err := tftypes.ValueFromJSONWithOpts(b, resp.TargetState.Schema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{
+				IgnoreUndefinedAttributes: true,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error to move state from %s: %v", sourceTypeName, err), "")
+				return
+			}
+			resp.TargetState.Raw = target
+		},
+	}
+}
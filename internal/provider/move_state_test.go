@@ -0,0 +1,146 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testProviderAddress = "registry.terraform.io/fortinetdev/fortisase"
+
+// moveTestState runs the state movers of target on the raw JSON state of the source resource type.
+func moveTestState(t *testing.T, target resource.Resource, providerAddress, sourceTypeName, source string) resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	target.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: providerAddress,
+		SourceTypeName:        sourceTypeName,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(source)},
+	}
+	resp := resource.MoveStateResponse{
+		TargetState: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	for _, mover := range target.(resource.ResourceWithMoveState).MoveState(ctx) {
+		mover.StateMover(ctx, req, &resp)
+	}
+	return resp
+}
+
+func TestMoveStateFrom(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("clone to policy", func(t *testing.T) {
+		resp := moveTestState(t, newResourceSecurityOutboundPolicies(), testProviderAddress, "fortisase_security_outbound_policies_clone",
+			`{"id":"SecurityOutboundPoliciesClone","primary_key":"deny-all","based_on":"allow-all","action":"deny","enabled":true}`)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("MoveState() diagnostics: %v", resp.Diagnostics)
+		}
+		var data resourceSecurityOutboundPoliciesModel
+		if diags := resp.TargetState.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("moved state diagnostics: %v", diags)
+		}
+		if data.ID.ValueString() != "deny-all" || data.PrimaryKey.ValueString() != "deny-all" {
+			t.Errorf("moved id, primary_key = %v, %v, want the primary key of the clone", data.ID, data.PrimaryKey)
+		}
+		if data.Action.ValueString() != "deny" || !data.Enabled.ValueBool() {
+			t.Errorf("moved action, enabled = %v, %v, want the attributes of the clone", data.Action, data.Enabled)
+		}
+	})
+
+	t.Run("profile group without direction", func(t *testing.T) {
+		resp := moveTestState(t, newResourceSecurityProfileGroup(), testProviderAddress, "fortisase_security_profile_group_clone",
+			`{"id":"group","primary_key":"group","based_on":"default","direction":"outbound"}`)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("MoveState() diagnostics: %v", resp.Diagnostics)
+		}
+		var data resourceSecurityProfileGroupModel
+		if diags := resp.TargetState.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("moved state diagnostics: %v", diags)
+		}
+		if data.PrimaryKey.ValueString() != "group" || !data.Direction.IsNull() {
+			t.Errorf("moved primary_key, direction = %v, %v, want the primary key and no direction", data.PrimaryKey, data.Direction)
+		}
+	})
+
+	t.Run("missing primary key", func(t *testing.T) {
+		resp := moveTestState(t, newResourceSecurityOutboundPolicies(), testProviderAddress, "fortisase_security_outbound_policies_clone",
+			`{"id":"SecurityOutboundPoliciesClone","based_on":"allow-all"}`)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("MoveState() without primary_key did not fail")
+		}
+	})
+
+	t.Run("foreign provider", func(t *testing.T) {
+		resp := moveTestState(t, newResourceSecurityOutboundPolicies(), "registry.terraform.io/example/fortisase-fork", "fortisase_security_outbound_policies_clone",
+			`{"id":"deny-all","primary_key":"deny-all"}`)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			t.Errorf("MoveState() from another provider = %v, %v, want it left to the other movers", resp.TargetState.Raw, resp.Diagnostics)
+		}
+	})
+
+	t.Run("other resource type", func(t *testing.T) {
+		resp := moveTestState(t, newResourceSecurityOutboundPolicies(), testProviderAddress, "fortisase_security_internal_policies_clone",
+			`{"id":"deny-all","primary_key":"deny-all"}`)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			t.Errorf("MoveState() from another resource type = %v, %v, want it left to the other movers", resp.TargetState.Raw, resp.Diagnostics)
+		}
+	})
+}
+
+// TestMoveStateSharedAttributes checks that every attribute of the clone resources is carried by the move,
+// as the target state is built ignoring the attributes it does not have: a renamed attribute would be dropped silently.
+func TestMoveStateSharedAttributes(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		source resource.Resource
+		target resource.Resource
+	}{
+		{source: newResourceSecurityOutboundPoliciesClone(), target: newResourceSecurityOutboundPolicies()},
+		{source: newResourceSecurityInternalPoliciesClone(), target: newResourceSecurityInternalPolicies()},
+		{source: newResourceSecurityInternalReversePoliciesClone(), target: newResourceSecurityInternalReversePolicies()},
+		{source: newResourceSecurityEndpointToEndpointPoliciesClone(), target: newResourceSecurityEndpointToEndpointPolicies()},
+		{source: newResourceEndpointPoliciesClone(), target: newResourceEndpointPolicies()},
+		{source: newResourceEndpointProfileClone(), target: newResourceEndpointProfile()},
+		{source: newResourceSecurityProfileGroupClone(), target: newResourceSecurityProfileGroup()},
+	}
+
+	for _, tc := range cases {
+		var sourceName, targetName resource.MetadataResponse
+		tc.source.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "fortisase"}, &sourceName)
+		tc.target.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "fortisase"}, &targetName)
+		t.Run(sourceName.TypeName, func(t *testing.T) {
+			var source, target resource.SchemaResponse
+			tc.source.Schema(ctx, resource.SchemaRequest{}, &source)
+			tc.target.Schema(ctx, resource.SchemaRequest{}, &target)
+			sourceType := source.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			targetType := target.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+			for name, typ := range sourceType.AttributeTypes {
+				if name == "based_on" {
+					continue
+				}
+				targetTyp, ok := targetType.AttributeTypes[name]
+				if !ok {
+					t.Errorf("attribute %s of %s is not in %s, it is not carried by the move", name, sourceName.TypeName, targetName.TypeName)
+					continue
+				}
+				if !typ.Equal(targetTyp) {
+					t.Errorf("attribute %s of %s has type %s in %s, want %s", name, sourceName.TypeName, targetTyp, targetName.TypeName, typ)
+				}
+			}
+
+			movers := tc.target.(resource.ResourceWithMoveState).MoveState(ctx)
+			if len(movers) == 0 {
+				t.Errorf("%s has no state mover from %s", targetName.TypeName, sourceName.TypeName)
+			}
+		})
+	}
+}
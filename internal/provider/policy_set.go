@@ -0,0 +1,618 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// securityPolicySetExcludedAttributes are the attributes of the policy resources that the policy sets do not expose:
+// the listed policies are always taken over, they are deleted without checking their usage, the timeouts of the
+// policy set apply and the unmanaged fields are read again before each update.
+var securityPolicySetExcludedAttributes = []string{"adopt_existing", "force_detach", "raw_json", "timeouts"}
+
+// securityPolicySetType describes the policies of a policy set: their rulebase and the functions of their model M,
+// the model of the policy resource, e.g. resourceSecurityOutboundPoliciesModel.
+type securityPolicySetType[M any] struct {
+	// name is the rulebase, e.g. "outbound", it is the id of the policy set
+	name string
+	// url is the collection endpoint of the policies, listed in evaluation order
+	url string
+	// policyResource is the resource of a single policy, the policies of the set have its attributes
+	policyResource func() resource.Resource
+	// fields returns the attributes of a policy that the policy set uses
+	fields func(m *M) securityPolicySetFields
+
+	getCreateObject func(m *M, ctx context.Context, diags *diag.Diagnostics) *map[string]interface{}
+	getUpdateObject func(m *M, ctx context.Context, state M, diags *diag.Diagnostics) *map[string]interface{}
+	getURLObject    func(m *M, ctx context.Context, ope string, diags *diag.Diagnostics) *map[string]interface{}
+	refresh         func(m *M, ctx context.Context, o map[string]interface{}) diag.Diagnostics
+
+	create func(c *forticlient.FortiSDKClient, input_model *forticlient.InputModel) (map[string]interface{}, error)
+	read   func(c *forticlient.FortiSDKClient, input_model *forticlient.InputModel) (map[string]interface{}, error)
+	update func(c *forticlient.FortiSDKClient, input_model *forticlient.InputModel) (map[string]interface{}, error)
+	delete func(c *forticlient.FortiSDKClient, input_model *forticlient.InputModel) (map[string]interface{}, error)
+}
+
+// securityPolicySetFields points to the attributes of a policy model that the policy set uses.
+type securityPolicySetFields struct {
+	ID                 *types.String
+	PrimaryKey         *types.String
+	DeletionProtection *types.Bool
+	Timeouts           *timeouts.Value
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &resourceSecurityPolicySet[resourceSecurityOutboundPoliciesModel]{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityPolicySet[resourceSecurityOutboundPoliciesModel]{}
+var _ resource.ResourceWithImportState = &resourceSecurityPolicySet[resourceSecurityOutboundPoliciesModel]{}
+
+// resourceSecurityPolicySet manages a whole rulebase, the policies of type M.
+type resourceSecurityPolicySet[M any] struct {
+	fortiClient  *FortiClient
+	resourceName string
+	policyType   securityPolicySetType[M]
+}
+
+// resourceSecurityPolicySetModel describes the resource data model.
+// Each policy has the attributes of the policy resource, without securityPolicySetExcludedAttributes.
+type resourceSecurityPolicySetModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Policies types.List     `tfsdk:"policies"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *resourceSecurityPolicySet[M]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_" + r.policyType.typeName() + "_policy_set"
+}
+
+func (r *resourceSecurityPolicySet[M]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	policyAttributes, _ := r.policyType.schema(ctx)
+	policyAttributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Identifier of the policy, not configurable.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages the whole %s rulebase: the policies are created and updated as listed, and the policies that are not listed are deleted. The plan that creates the policy set warns about the existing policies that are not listed. The FortiSASE API has no documented endpoint to reorder policies, so the new policies are added at the end of the rulebase and must be listed after the existing ones, and the apply fails with the moves to make in the FortiSASE portal while the existing policies are not in the listed order.", strings.ReplaceAll(r.policyType.name, "-", " ")),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policies": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: policyAttributes,
+				},
+				MarkdownDescription: "The policies of the rulebase, in the order they are evaluated.",
+				Required:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceSecurityPolicySet[M]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.resourceName = "fortisase_security_" + r.policyType.typeName() + "_policy_set"
+}
+
+func (r *resourceSecurityPolicySet[M]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planUnlistedPolicies(ctx, r.fortiClient, r.policyType.url, req, resp)
+
+	var policies types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("policies"), &policies)...)
+	if resp.Diagnostics.HasError() || policies.IsUnknown() {
+		return
+	}
+	for i := range policies.Elements() {
+		planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("policies").AtListIndex(i).AtName("deletion_protection"))
+	}
+}
+
+func (r *resourceSecurityPolicySet[M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	lock := r.fortiClient.GetResourceLock("profile-group")
+	lock.Lock()
+	defer lock.Unlock()
+	var data resourceSecurityPolicySetModel
+	diags := &resp.Diagnostics
+
+	// Read Terraform config data into the model, the deletion protection of the policies is planned
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+	policies := r.policyType.policies(ctx, data.Policies, diags)
+	r.policyType.planDeletionProtection(ctx, req.Plan, policies, diags)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
+
+	data.ID = types.StringValue(r.policyType.name)
+	policies = r.apply(ctx, policies, nil, diags)
+	if diags.HasError() {
+		return
+	}
+	data.Policies = r.policyType.policiesList(ctx, policies, diags)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityPolicySet[M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	lock := r.fortiClient.GetResourceLock("profile-group")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+
+	// Read Terraform prior state data and config data into the model, the deletion protection of the policies is planned
+	var state resourceSecurityPolicySetModel
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() {
+		return
+	}
+	prior := r.policyType.policies(ctx, state.Policies, diags)
+
+	var data resourceSecurityPolicySetModel
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+	policies := r.policyType.policies(ctx, data.Policies, diags)
+	r.policyType.planDeletionProtection(ctx, req.Plan, policies, diags)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
+	data.ID = state.ID
+
+	policies = r.apply(ctx, policies, prior, diags)
+	if diags.HasError() {
+		return
+	}
+	data.Policies = r.policyType.policiesList(ctx, policies, diags)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityPolicySet[M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	lock := r.fortiClient.GetResourceLock("profile-group")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+	var data resourceSecurityPolicySetModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(req.State.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+	policies := r.policyType.policies(ctx, data.Policies, diags)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	for i := range policies {
+		f := r.policyType.fields(&policies[i])
+		if isDeletionProtected(*f.DeletionProtection, r.resourceName, f.PrimaryKey.ValueString(), diags) {
+			return
+		}
+	}
+
+	for i := range policies {
+		diags.Append(r.deletePolicy(ctx, r.policyType.fields(&policies[i]).PrimaryKey.ValueString())...)
+		if diags.HasError() {
+			return
+		}
+	}
+}
+
+func (r *resourceSecurityPolicySet[M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	diags := &resp.Diagnostics
+	var data resourceSecurityPolicySetModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(req.State.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
+
+	policies := r.policyType.policies(ctx, data.Policies, diags)
+	if diags.HasError() {
+		return
+	}
+	policies = r.read(ctx, policies, diags)
+	if diags.HasError() {
+		return
+	}
+	data.Policies = r.policyType.policiesList(ctx, policies, diags)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityPolicySet[M]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply makes the rulebase match the configured policies in a safe sequence: the missing policies are created,
+// the existing ones are updated, then the policies that are not listed are deleted last.
+// The FortiSASE API has no documented endpoint to reorder policies, the existing policies keep their order and
+// the created ones are added at the end of the rulebase, so nothing is written when the listed order cannot be reached.
+// It returns the policies as read from the rulebase.
+func (r *resourceSecurityPolicySet[M]) apply(ctx context.Context, policies []M, prior []M, diags *diag.Diagnostics) []M {
+	pt := r.policyType
+	desired := make([]string, 0, len(policies))
+	for i := range policies {
+		mkey := pt.fields(&policies[i]).PrimaryKey.ValueString()
+		if policyOrderContains(desired, mkey) {
+			diags.AddAttributeError(
+				path.Root("policies").AtListIndex(i).AtName("primary_key"),
+				"Duplicate Policy",
+				fmt.Sprintf("The policy %q is listed more than once.", mkey),
+			)
+			continue
+		}
+		desired = append(desired, mkey)
+	}
+	for i := range prior {
+		f := pt.fields(&prior[i])
+		if !policyOrderContains(desired, f.PrimaryKey.ValueString()) {
+			isDeletionProtected(*f.DeletionProtection, r.resourceName, f.PrimaryKey.ValueString(), diags)
+		}
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	c := r.fortiClient.Client
+	current, err := readPolicyOrder(ctx, c, pt.url)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err), "")
+		return nil
+	}
+	order := policyOrderFilter(current, desired)
+	if moves := policyOrderMoves(order, policyOrderFilter(desired, current)); len(moves) > 0 {
+		diags.AddAttributeError(path.Root("policies"), "Policies Out of Order", policyOrderMovesDetail(pt.name, moves))
+		return nil
+	}
+	if !slices.Equal(order, desired[:len(order)]) {
+		diags.AddAttributeError(
+			path.Root("policies"),
+			"Policies Out of Order",
+			fmt.Sprintf("The policies that do not exist yet are created at the end of the %s rulebase. List them after the existing policies: %s.", pt.name, strings.Join(policyOrderExclude(desired, current), ", ")),
+		)
+		return nil
+	}
+
+	for i := range policies {
+		p := &policies[i]
+		mkey := pt.fields(p).PrimaryKey.ValueString()
+
+		var input_model forticlient.InputModel
+		input_model.Ctx = ctx
+		var output map[string]interface{}
+		if policyOrderContains(current, mkey) {
+			state := *p
+			for j := range prior {
+				if pt.fields(&prior[j]).PrimaryKey.ValueString() == mkey {
+					state = prior[j]
+				}
+			}
+
+			// The policy is read again so that the fields this provider does not support are sent back
+			var read_input_model forticlient.InputModel
+			read_input_model.Ctx = ctx
+			read_input_model.Mkey = mkey
+			read_input_model.URLParams = *(pt.getURLObject(p, ctx, "read", diags))
+			read_output, err := pt.read(c, &read_input_model)
+			if err != nil {
+				diags.AddError(
+					fmt.Sprintf("Error to read policy %s of resource %s: %v", mkey, r.resourceName, err),
+					getErrorDetail(&read_input_model, read_output),
+				)
+				return nil
+			}
+
+			input_model.Mkey = mkey
+			input_model.BodyParams = *(pt.getUpdateObject(p, ctx, state, diags))
+			input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, rawJSONValue(read_output), *p)
+			input_model.URLParams = *(pt.getURLObject(p, ctx, "update", diags))
+			if diags.HasError() {
+				return nil
+			}
+			output, err = pt.update(c, &input_model)
+		} else {
+			input_model.BodyParams = *(pt.getCreateObject(p, ctx, diags))
+			input_model.URLParams = *(pt.getURLObject(p, ctx, "create", diags))
+			if diags.HasError() {
+				return nil
+			}
+			output, err = pt.create(c, &input_model)
+		}
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error to apply policy %s of resource %s: %v", mkey, r.resourceName, err),
+				getErrorDetail(&input_model, output),
+			)
+			return nil
+		}
+
+		// The rulebase is read once all the policies are applied, wait until this one reflects the write
+		var read_input_model forticlient.InputModel
+		read_input_model.Ctx = ctx
+		read_input_model.Mkey = mkey
+		read_input_model.URLParams = *(pt.getURLObject(p, ctx, "read", diags))
+		read_output, err := readAfterWrite(ctx, func(input_model *forticlient.InputModel) (map[string]interface{}, error) {
+			return pt.read(c, input_model)
+		}, &read_input_model, input_model.BodyParams, output, diags)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error to read policy %s of resource %s: %v", mkey, r.resourceName, err),
+				getErrorDetail(&read_input_model, read_output),
+			)
+			return nil
+		}
+	}
+
+	for _, mkey := range current {
+		if !policyOrderContains(desired, mkey) {
+			diags.Append(r.deletePolicy(ctx, mkey)...)
+			if diags.HasError() {
+				return nil
+			}
+		}
+	}
+
+	return r.read(ctx, policies, diags)
+}
+
+// read returns the policies of the whole rulebase, the policies that are not managed yet are added
+// so that they show up in the plan. The collection may omit fields, so each policy is read again.
+func (r *resourceSecurityPolicySet[M]) read(ctx context.Context, policies []M, diags *diag.Diagnostics) []M {
+	pt := r.policyType
+	c := r.fortiClient.Client
+	current, err := readPolicyOrder(ctx, c, pt.url)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err), "")
+		return nil
+	}
+
+	result := make([]M, 0, len(current))
+	for _, mkey := range current {
+		var p M
+		for i := range policies {
+			if pt.fields(&policies[i]).PrimaryKey.ValueString() == mkey {
+				p = policies[i]
+			}
+		}
+		f := pt.fields(&p)
+		*f.ID = types.StringValue(mkey)
+		*f.PrimaryKey = types.StringValue(mkey)
+
+		var input_model forticlient.InputModel
+		input_model.Ctx = ctx
+		input_model.Mkey = mkey
+		input_model.URLParams = *(pt.getURLObject(&p, ctx, "read", diags))
+		output, err := pt.read(c, &input_model)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error to read policy %s of resource %s: %v", mkey, r.resourceName, err),
+				getErrorDetail(&input_model, output),
+			)
+			return nil
+		}
+		diags.Append(pt.refresh(&p, ctx, output)...)
+		result = append(result, p)
+	}
+	return result
+}
+
+func (r *resourceSecurityPolicySet[M]) deletePolicy(ctx context.Context, mkey string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+
+	output, err := r.policyType.delete(c, &input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to delete policy %s of resource %s: %v", mkey, r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+	}
+	return diags
+}
+
+// typeName returns the name of the rulebase in the resource type names, e.g. "internal_reverse".
+func (pt securityPolicySetType[M]) typeName() string {
+	return strings.ReplaceAll(pt.name, "-", "_")
+}
+
+// schema returns the attributes of a policy of the set and the object type of a policy of the policy resource,
+// which also has securityPolicySetExcludedAttributes.
+func (pt securityPolicySetType[M]) schema(ctx context.Context) (map[string]schema.Attribute, types.ObjectType) {
+	var policy resource.SchemaResponse
+	pt.policyResource().Schema(ctx, resource.SchemaRequest{}, &policy)
+
+	attributes := make(map[string]schema.Attribute, len(policy.Schema.Attributes))
+	for name, a := range policy.Schema.Attributes {
+		attributes[name] = a
+	}
+	for _, name := range securityPolicySetExcludedAttributes {
+		delete(attributes, name)
+	}
+	return attributes, policy.Schema.Type().(types.ObjectType)
+}
+
+// policies converts the policies of a policy set into the model of the policy resource,
+// the excluded attributes are null.
+func (pt securityPolicySetType[M]) policies(ctx context.Context, list types.List, diags *diag.Diagnostics) []M {
+	_, policyType := pt.schema(ctx)
+	result := make([]M, 0, len(list.Elements()))
+	for _, e := range list.Elements() {
+		o, ok := e.(types.Object)
+		if !ok {
+			diags.AddError("Unexpected Policy Type", fmt.Sprintf("Expected an object, got: %T. Please report this issue to the provider developers.", e))
+			return nil
+		}
+
+		attributes := make(map[string]attr.Value, len(policyType.AttrTypes))
+		for name, t := range policyType.AttrTypes {
+			v, ok := o.Attributes()[name]
+			if !ok {
+				var err error
+				v, err = t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+				if err != nil {
+					diags.AddError(fmt.Sprintf("Error to convert policy attribute %s: %v", name, err), "")
+					return nil
+				}
+			}
+			attributes[name] = v
+		}
+		policy, d := types.ObjectValue(policyType.AttrTypes, attributes)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil
+		}
+
+		var p M
+		diags.Append(policy.As(ctx, &p, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// policiesList converts policies into the policies of a policy set, without the excluded attributes.
+func (pt securityPolicySetType[M]) policiesList(ctx context.Context, policies []M, diags *diag.Diagnostics) types.List {
+	attributes, policyType := pt.schema(ctx)
+	elementType := schema.NestedAttributeObject{Attributes: attributes}.Type().(types.ObjectType)
+
+	elements := make([]attr.Value, 0, len(policies))
+	for i := range policies {
+		p := policies[i]
+		*pt.fields(&p).Timeouts = timeoutsNull(ctx)
+		policy, d := types.ObjectValueFrom(ctx, policyType.AttrTypes, p)
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.ListNull(elementType)
+		}
+
+		values := make(map[string]attr.Value, len(elementType.AttrTypes))
+		for name := range elementType.AttrTypes {
+			values[name] = policy.Attributes()[name]
+		}
+		element, d := types.ObjectValue(elementType.AttrTypes, values)
+		diags.Append(d...)
+		elements = append(elements, element)
+	}
+
+	result, d := types.ListValue(elementType, elements)
+	diags.Append(d...)
+	return result
+}
+
+// planDeletionProtection sets the deletion protection of the policies, read from the configuration, to its planned value.
+func (pt securityPolicySetType[M]) planDeletionProtection(ctx context.Context, plan tfsdk.Plan, policies []M, diags *diag.Diagnostics) {
+	for i := range policies {
+		diags.Append(plan.GetAttribute(ctx, path.Root("policies").AtListIndex(i).AtName("deletion_protection"), pt.fields(&policies[i]).DeletionProtection)...)
+	}
+}
+
+// planUnlistedPolicies warns, when a policy set is created, about the policies of the rulebase url that are not listed
+// in the plan. They are deleted by the apply, but they are not in the state yet, so the plan does not show them.
+func planUnlistedPolicies(ctx context.Context, client *FortiClient, url string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var policies types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("policies"), &policies)...)
+	if resp.Diagnostics.HasError() || policies.IsUnknown() {
+		return
+	}
+	keys := make([]string, 0, len(policies.Elements()))
+	for i := range policies.Elements() {
+		var key types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("policies").AtListIndex(i).AtName("primary_key"), &key)...)
+		keys = append(keys, key.ValueString())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := readPolicyOrder(ctx, client.Client, url)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read the rulebase",
+			fmt.Sprintf("The policies that are not listed are deleted when the policy set is created, they cannot be listed in the plan: %v", err),
+		)
+		return
+	}
+	if unlisted := policyOrderExclude(current, keys); len(unlisted) > 0 {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("%d policies of the rulebase are not listed and will be deleted", len(unlisted)),
+			fmt.Sprintf("Creating the policy set deletes the policies that are not listed in policies: %s. "+
+				"List them in policies to keep them.", strings.Join(unlisted, ", ")),
+		)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecurityPolicySetPolicies(t *testing.T) {
+	ctx := context.Background()
+	r := newResourceSecurityOutboundPolicySet().(*resourceSecurityPolicySet[resourceSecurityOutboundPoliciesModel])
+
+	var diags diag.Diagnostics
+	policies := []resourceSecurityOutboundPoliciesModel{
+		{
+			ID:                 types.StringValue("deny"),
+			PrimaryKey:         types.StringValue("deny"),
+			Action:             types.StringValue("deny"),
+			Users:              []resourceSecurityOutboundPoliciesUsersModel{{PrimaryKey: types.StringValue("u"), Datasource: types.StringValue("auth/users")}},
+			DeletionProtection: types.BoolValue(true),
+			RawJson:            types.StringValue(`{"action":"deny"}`),
+			ForceDetach:        types.BoolValue(true),
+		},
+	}
+
+	list := r.policyType.policiesList(ctx, policies, &diags)
+	if diags.HasError() {
+		t.Fatalf("policiesList() diagnostics: %v", diags)
+	}
+	attributes, _ := r.policyType.schema(ctx)
+	for _, name := range securityPolicySetExcludedAttributes {
+		if _, ok := attributes[name]; ok {
+			t.Errorf("policy attribute %s is not excluded", name)
+		}
+	}
+
+	got := r.policyType.policies(ctx, list, &diags)
+	if diags.HasError() {
+		t.Fatalf("policies() diagnostics: %v", diags)
+	}
+	if len(got) != 1 {
+		t.Fatalf("policies() returned %d policies, want 1", len(got))
+	}
+	p := got[0]
+	if p.PrimaryKey.ValueString() != "deny" || p.Action.ValueString() != "deny" || !p.DeletionProtection.ValueBool() || len(p.Users) != 1 {
+		t.Errorf("policies() = %+v, want the converted policy", p)
+	}
+	if !p.RawJson.IsNull() || !p.ForceDetach.IsNull() || !p.Timeouts.IsNull() {
+		t.Errorf("policies() excluded attributes = %v, %v, %v, want null", p.RawJson, p.ForceDetach, p.Timeouts)
+	}
+}
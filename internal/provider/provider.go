@@ -4,7 +4,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,6 +16,9 @@ import (
 
 // Ensure FortisaseProvider satisfies various provider interfaces.
 var _ provider.Provider = &FortisaseProvider{}
+var _ provider.ProviderWithEphemeralResources = &FortisaseProvider{}
+var _ provider.ProviderWithFunctions = &FortisaseProvider{}
+var _ provider.ProviderWithActions = &FortisaseProvider{}
 
 // FortisaseProvider defines the provider implementation.
 type FortisaseProvider struct {
@@ -28,6 +34,10 @@ type FortisaseProviderModel struct {
 	Password     types.String `tfsdk:"password"`
 	AccessToken  types.String `tfsdk:"access_token"`
 	RefreshToken types.String `tfsdk:"refresh_token"`
+
+	ValidateReferences        types.Bool `tfsdk:"validate_references"`
+	DeletionProtectionDefault types.Bool `tfsdk:"deletion_protection_default"`
+	AdoptExistingDefault      types.Bool `tfsdk:"adopt_existing_default"`
 }
 
 func (p *FortisaseProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -53,6 +63,18 @@ func (p *FortisaseProvider) Schema(ctx context.Context, req provider.SchemaReque
 				MarkdownDescription: "The refresh token of API user.",
 				Optional:            true,
 			},
+			"validate_references": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check at plan time that the objects referenced by `{primary_key, datasource}` attributes exist. Only references that are known at plan time are checked, so objects that are created in the same run should be referenced through their `id`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"deletion_protection_default": schema.BoolAttribute{
+				MarkdownDescription: "The default value of `deletion_protection` for the policies, profile groups, authentication servers, private access service connections and SSIDs. Defaults to `false`.",
+				Optional:            true,
+			},
+			"adopt_existing_default": schema.BoolAttribute{
+				MarkdownDescription: "The default value of `adopt_existing` for the collection resources. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -71,6 +93,10 @@ func (p *FortisaseProvider) Configure(ctx context.Context, req provider.Configur
 		Password:     data.Password.ValueString(),
 		AccessToken:  data.AccessToken.ValueString(),
 		RefreshToken: data.RefreshToken.ValueString(),
+
+		ValidateReferences:        data.ValidateReferences.ValueBool(),
+		DeletionProtectionDefault: data.DeletionProtectionDefault.ValueBool(),
+		AdoptExistingDefault:      data.AdoptExistingDefault.ValueBool(),
 	}
 
 	sdkClient, err := config.CreateClient()
@@ -83,6 +109,7 @@ func (p *FortisaseProvider) Configure(ctx context.Context, req provider.Configur
 	resp.DataSourceData = sdkClient
 	resp.ResourceData = sdkClient
 	resp.EphemeralResourceData = sdkClient
+	resp.ActionData = sdkClient
 }
 func (p *FortisaseProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
@@ -140,14 +167,17 @@ func (p *FortisaseProvider) Resources(ctx context.Context) []func() resource.Res
 		newResourceSecurityFortiguardLocalCategories,
 		newResourceSecurityInternalPolicies,
 		newResourceSecurityInternalPoliciesClone,
+		newResourceSecurityInternalPolicySet,
 		newResourceSecurityInternalReversePolicies,
 		newResourceSecurityInternalReversePoliciesClone,
+		newResourceSecurityInternalReversePolicySet,
 		newResourceSecurityIpThreatFeeds,
 		newResourceSecurityIpsCustomSignatures,
 		newResourceSecurityIpsProfile,
 		newResourceSecurityOnetimeSchedules,
 		newResourceSecurityOutboundPolicies,
 		newResourceSecurityOutboundPoliciesClone,
+		newResourceSecurityOutboundPolicySet,
 		newResourceSecurityPkiUsers,
 		newResourceSecurityProfileGroup,
 		newResourceSecurityProfileGroupClone,
@@ -160,6 +190,7 @@ func (p *FortisaseProvider) Resources(ctx context.Context) []func() resource.Res
 		newResourceSecurityVideoFilterProfile,
 		newResourceSecurityVideoFilterYoutubeKey,
 		newResourceSecurityWebFilterProfile,
+		newResourceRestObject,
 		newResourceUserSwgSessionsDeauth,
 		newResourceUserVpnSessionsDeauth,
 		newResourceEndpointProfile,
@@ -243,6 +274,7 @@ func (p *FortisaseProvider) DataSources(ctx context.Context) []func() datasource
 		newDatasourceSecurityOnetimeSchedules,
 		newDatasourceSecurityOutboundPolicies,
 		newDatasourceSecurityPkiUsers,
+		newDatasourceSecurityPolicyOrder,
 		newDatasourceSecurityProfileGroup,
 		newDatasourceSecurityRecurringSchedules,
 		newDatasourceSecurityScheduleGroups,
@@ -255,6 +287,8 @@ func (p *FortisaseProvider) DataSources(ctx context.Context) []func() datasource
 		newDatasourceSecurityVideoFilterProfile,
 		newDatasourceSecurityVideoFilterYoutubeKey,
 		newDatasourceSecurityWebFilterProfile,
+		newDatasourceDependencyGraph,
+		newDatasourceRestCall,
 		newDatasourceUsageAuthFssoAgents,
 		newDatasourceUsageAuthLdapServers,
 		newDatasourceUsageAuthRadiusServers,
@@ -292,6 +326,34 @@ func (p *FortisaseProvider) DataSources(ctx context.Context) []func() datasource
 	}
 }
 
+func (p *FortisaseProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralAccessToken,
+		newEphemeralEndpointGroupInvitationCode,
+	}
+}
+
+func (p *FortisaseProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		newActionUserSwgSessionsDeauth,
+		newActionUserVpnSessionsDeauth,
+		newActionEndpointsAccessProxyAuthorize,
+		newActionEndpointsAccessProxyDisconnect,
+		newActionEndpointsEnableManagement,
+		newActionEndpointsDisableManagement,
+	}
+}
+
+func (p *FortisaseProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		newFunctionRef,
+		newFunctionCidrToIpmask,
+		newFunctionIpmaskToCidr,
+		newFunctionPortRange,
+		newFunctionParseImportId,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &FortisaseProvider{
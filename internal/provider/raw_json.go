@@ -0,0 +1,113 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"unicode"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const rawJSONDescription = "The object as last read from FortiSASE in JSON, including the fields that this provider does not support yet. The secrets are removed."
+
+// rawJSONAttribute is the raw_json attribute of the resources.
+func rawJSONAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: rawJSONDescription,
+		Computed:            true,
+		Sensitive:           true,
+	}
+}
+
+// rawJSONDatasourceAttribute is the raw_json attribute of the data sources.
+func rawJSONDatasourceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		MarkdownDescription: rawJSONDescription,
+		Computed:            true,
+		Sensitive:           true,
+	}
+}
+
+// rawJSONValue returns o in JSON without its secrets, or null if it cannot be encoded.
+func rawJSONValue(o map[string]interface{}) types.String {
+	v, err := json.Marshal(redactSecretFields(o))
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(v))
+}
+
+// withUnmanagedFields adds to body the fields of raw, the raw_json of the prior state, that model has no attribute for,
+// so that an update does not reset the fields that FortiSASE added after this version of the provider.
+// The meta fields, the read-only fields and the null fields are not sent back.
+func withUnmanagedFields(body map[string]interface{}, raw types.String, model interface{}) map[string]interface{} {
+	if raw.IsNull() || raw.IsUnknown() {
+		return body
+	}
+	var o map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(raw.ValueString()))
+	decoder.UseNumber()
+	if err := decoder.Decode(&o); err != nil {
+		return body
+	}
+
+	attributes := modelAttributeNames(reflect.TypeOf(model))
+	result := make(map[string]interface{}, len(body))
+	for k, v := range removeReadOnlyFields(o) {
+		name := apiFieldAttributeName(k)
+		if v == nil || attributes[name] || attributes["ftnt"+name] {
+			continue
+		}
+		result[k] = v
+	}
+	for k, v := range body {
+		result[k] = v
+	}
+	return result
+}
+
+// modelAttributeNames returns the attribute names of the model type t, including those of its embedded models.
+func modelAttributeNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return names
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			for name := range modelAttributeNames(f.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		if tag := f.Tag.Get("tfsdk"); tag != "" && tag != "-" {
+			names[tag] = true
+		}
+	}
+	return names
+}
+
+// apiFieldAttributeName returns the attribute name of the API field k, the field name in snake case,
+// e.g. "countryId" is "country_id" and "availableVPNs" is "available_vp_ns".
+// The names reserved by Terraform, such as "id", are prefixed with "ftnt" in the nested attributes.
+func apiFieldAttributeName(k string) string {
+	r := []rune(k)
+	var b strings.Builder
+	for i, c := range r {
+		if unicode.IsUpper(c) {
+			if i > 0 && (unicode.IsLower(r[i-1]) || unicode.IsDigit(r[i-1]) || (i+1 < len(r) && unicode.IsLower(r[i+1]))) {
+				b.WriteByte('_')
+			}
+			c = unicode.ToLower(c)
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rawJSONTestModel struct {
+	ID         types.String `tfsdk:"id"`
+	PrimaryKey types.String `tfsdk:"primary_key"`
+	Comments   types.String `tfsdk:"comments"`
+	Members    types.List   `tfsdk:"members"`
+}
+
+func TestWithUnmanagedFields(t *testing.T) {
+	body := map[string]interface{}{"primaryKey": "host", "comments": "new"}
+
+	cases := []struct {
+		name string
+		raw  types.String
+		want map[string]interface{}
+	}{
+		{
+			name: "unmanaged fields",
+			raw:  types.StringValue(`{"primaryKey":"host","comments":"old","members":[],"newField":1,"uuid":"u","$meta":{"state":"done"},"nullField":null}`),
+			want: map[string]interface{}{"primaryKey": "host", "comments": "new", "newField": json.Number("1")},
+		},
+		{
+			name: "without raw_json",
+			raw:  types.StringNull(),
+			want: body,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := withUnmanagedFields(body, tc.raw, rawJSONTestModel{})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("withUnmanagedFields() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// readAfterWriteTimeout bounds how long an object is read again after a create or update until it reflects the write.
+var readAfterWriteTimeout = 10 * time.Second
+
+// readAfterWriteInterval is the first interval between two reads, it doubles up to readAfterWriteMaxInterval.
+var readAfterWriteInterval = time.Second
+
+var readAfterWriteMaxInterval = 4 * time.Second
+
+// secretFields are the fields of the secrets, FortiSASE does not return them or returns them masked.
+// They are not compared after a write and are removed from raw_json and from the restore baselines.
+var secretFields = map[string]bool{
+	"password":              true,
+	"password2":             true,
+	"password3":             true,
+	"password4":             true,
+	"password5":             true,
+	"emsDisconnectPassword": true,
+	"preSharedKey":          true,
+	"ipsecPreSharedKey":     true,
+	"keyFileContent":        true,
+	"primarySecret":         true,
+	"secondarySecret":       true,
+	"apiKey":                true,
+	"token":                 true,
+}
+
+// defaultEntryFields are the list fields to which FortiSASE adds default entries, e.g. every FortiGuard category.
+// The refresh keeps the configured entries when the object has them all, so the read reflects the write then.
+var defaultEntryFields = map[string]bool{
+	"fortiguardFilters":              true,
+	"fortiguardLocalCategoryFilters": true,
+	"fqdnThreatFeedFilters":          true,
+	"domainThreatFeedFilters":        true,
+}
+
+// readAfterWrite reads the object with read after it was written with body, written is the response of the write.
+// FortiSASE may return the prior object for a short time after a write, so the object is read again until it reflects
+// the write, or until readAfterWriteTimeout or the deadline of ctx passes. The last read is returned then with a warning,
+// the read is not an error. All the fields of body but the secrets are compared. FortiSASE normalizes some fields,
+// so the value that the response echoes for a field, if any, is expected instead of the value sent.
+func readAfterWrite(ctx context.Context, read func(*forticlient.InputModel) (map[string]interface{}, error), read_input_model *forticlient.InputModel, body map[string]interface{}, written map[string]interface{}, diags *diag.Diagnostics) (map[string]interface{}, error) {
+	output, err := read(read_input_model)
+	expected := readAfterWriteExpected(body, written)
+	if err != nil || len(expected) == 0 {
+		return output, err
+	}
+
+	deadline := time.Now().Add(readAfterWriteTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	interval := readAfterWriteInterval
+	for !readAfterWriteMatches(ctx, expected, normalizeJSONValue(output)) {
+		if time.Now().Add(interval).After(deadline) || !waitTimeout(ctx, interval) {
+			var fields []string
+			actual, _ := normalizeJSONValue(output).(map[string]interface{})
+			for k, v := range expected {
+				if !readAfterWriteMatches(ctx, map[string]interface{}{k: v}, actual) {
+					fields = append(fields, k)
+				}
+			}
+			sort.Strings(fields)
+			diags.AddWarning(
+				fmt.Sprintf("Object %v does not reflect the write yet", read_input_model.Mkey),
+				fmt.Sprintf("FortiSASE still returns prior values for %s after %v, the state holds the last read. "+
+					"Run a refresh or apply again if the next plan shows a difference.", strings.Join(fields, ", "), readAfterWriteTimeout),
+			)
+			return output, nil
+		}
+		interval = min(interval*2, readAfterWriteMaxInterval)
+
+		output, err = read(read_input_model)
+		if err != nil {
+			return output, err
+		}
+	}
+	return output, nil
+}
+
+// readAfterWriteExpected returns the fields of body without the secrets and the meta fields,
+// with the value that written, the response of the write, echoes for them, if any.
+func readAfterWriteExpected(body map[string]interface{}, written map[string]interface{}) map[string]interface{} {
+	expected := make(map[string]interface{}, len(body))
+	for k, v := range body {
+		if secretFields[k] || strings.HasPrefix(k, "$") {
+			continue
+		}
+		if echoed, ok := written[k]; ok {
+			v = echoed
+		}
+		expected[k] = v
+	}
+	return normalizeJSONValue(expected).(map[string]interface{})
+}
+
+// normalizeJSONValue returns v as decoded from its JSON, so the numbers of the body and of the read are both float64.
+func normalizeJSONValue(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var result interface{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return v
+	}
+	return result
+}
+
+// readAfterWriteMatches returns whether actual, the read object, reflects expected, the written one.
+// The fields that are not returned are skipped, they are write-only. The lists are compared regardless of
+// their order, as the sets are, and the strings are compared as subnets, IP addresses, FQDNs and MAC addresses too.
+func readAfterWriteMatches(ctx context.Context, expected, actual interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range e {
+			if secretFields[k] || strings.HasPrefix(k, "$") {
+				continue
+			}
+			av, ok := a[k]
+			if !ok {
+				continue
+			}
+			if defaultEntryFields[k] {
+				if !readAfterWriteContains(ctx, v, av) {
+					return false
+				}
+			} else if !readAfterWriteMatches(ctx, v, av) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			return len(e) == 0 && actual == nil
+		}
+		if len(e) != len(a) {
+			return false
+		}
+		used := make([]bool, len(a))
+		for _, v := range e {
+			found := false
+			for i, av := range a {
+				if !used[i] && readAfterWriteMatches(ctx, v, av) {
+					used[i], found = true, true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	case string:
+		a, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		return e == a || semanticStringEquals(ctx, e, a)
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
+
+// readAfterWriteContains returns whether actual, a list of the read object, has all the entries of expected.
+func readAfterWriteContains(ctx context.Context, expected, actual interface{}) bool {
+	e, ok := expected.([]interface{})
+	if !ok {
+		return readAfterWriteMatches(ctx, expected, actual)
+	}
+	a, _ := actual.([]interface{})
+	for _, v := range e {
+		found := false
+		for _, av := range a {
+			if readAfterWriteMatches(ctx, v, av) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// semanticStringEquals returns whether the strings a and b are the same subnet, IP address, FQDN or MAC address.
+func semanticStringEquals(ctx context.Context, a, b string) bool {
+	pairs := [][2]basetypes.StringValuableWithSemanticEquals{
+		{subnetValue{types.StringValue(a)}, subnetValue{types.StringValue(b)}},
+		{ipAddressValue{types.StringValue(a)}, ipAddressValue{types.StringValue(b)}},
+		{fqdnValue{types.StringValue(a)}, fqdnValue{types.StringValue(b)}},
+		{macAddressValue{types.StringValue(a)}, macAddressValue{types.StringValue(b)}},
+	}
+	for _, p := range pairs {
+		if eq, _ := p[0].StringSemanticEquals(ctx, p[1]); eq {
+			return true
+		}
+	}
+	return false
+}
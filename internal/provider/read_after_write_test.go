@@ -0,0 +1,176 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestReadAfterWriteMatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected interface{}
+		actual   interface{}
+		want     bool
+	}{
+		{
+			name:     "same object",
+			expected: map[string]interface{}{"name": "a", "port": 443.0, "enabled": true},
+			actual:   map[string]interface{}{"name": "a", "port": 443.0, "enabled": true, "extra": "x"},
+			want:     true,
+		},
+		{
+			name:     "prior value",
+			expected: map[string]interface{}{"name": "a", "port": 443.0},
+			actual:   map[string]interface{}{"name": "a", "port": 80.0},
+			want:     false,
+		},
+		{
+			name:     "fields that are not returned",
+			expected: map[string]interface{}{"name": "a", "comments": "b"},
+			actual:   map[string]interface{}{"name": "a"},
+			want:     true,
+		},
+		{
+			name:     "secrets and meta fields",
+			expected: map[string]interface{}{"password": "secret", "$meta": map[string]interface{}{"state": "done"}},
+			actual:   map[string]interface{}{"password": "******", "$meta": map[string]interface{}{"state": "pending"}},
+			want:     true,
+		},
+		{
+			name:     "nested secrets",
+			expected: map[string]interface{}{"config": map[string]interface{}{"preSharedKey": "secret", "port": 500.0}},
+			actual:   map[string]interface{}{"config": map[string]interface{}{"preSharedKey": "******", "port": 500.0}},
+			want:     true,
+		},
+		{
+			name:     "default entries added",
+			expected: map[string]interface{}{"fortiguardFilters": []interface{}{map[string]interface{}{"id": 1.0, "action": "block"}}},
+			actual:   map[string]interface{}{"fortiguardFilters": []interface{}{map[string]interface{}{"id": 2.0, "action": "monitor"}, map[string]interface{}{"id": 1.0, "action": "block"}}},
+			want:     true,
+		},
+		{
+			name:     "default entries without the written one",
+			expected: map[string]interface{}{"fortiguardFilters": []interface{}{map[string]interface{}{"id": 1.0, "action": "block"}}},
+			actual:   map[string]interface{}{"fortiguardFilters": []interface{}{map[string]interface{}{"id": 1.0, "action": "monitor"}, map[string]interface{}{"id": 2.0, "action": "block"}}},
+			want:     false,
+		},
+		{
+			name:     "lists in another order",
+			expected: map[string]interface{}{"members": []interface{}{map[string]interface{}{"primaryKey": "a"}, map[string]interface{}{"primaryKey": "b"}}},
+			actual:   map[string]interface{}{"members": []interface{}{map[string]interface{}{"primaryKey": "b"}, map[string]interface{}{"primaryKey": "a"}}},
+			want:     true,
+		},
+		{
+			name:     "lists with duplicates",
+			expected: []interface{}{"a", "a"},
+			actual:   []interface{}{"a", "b"},
+			want:     false,
+		},
+		{
+			name:     "lists of another length",
+			expected: []interface{}{"a"},
+			actual:   []interface{}{"a", "b"},
+			want:     false,
+		},
+		{
+			name:     "empty list read as null",
+			expected: map[string]interface{}{"members": []interface{}{}},
+			actual:   map[string]interface{}{"members": nil},
+			want:     true,
+		},
+		{
+			name:     "list read as null",
+			expected: []interface{}{"a"},
+			actual:   nil,
+			want:     false,
+		},
+		{
+			name:     "object read as another type",
+			expected: map[string]interface{}{"config": map[string]interface{}{"port": 500.0}},
+			actual:   map[string]interface{}{"config": "x"},
+			want:     false,
+		},
+		{
+			name:     "equivalent subnets",
+			expected: "10.0.0.0/24",
+			actual:   "10.0.0.0 255.255.255.0",
+			want:     true,
+		},
+		{
+			name:     "equivalent MAC addresses",
+			expected: "AA-BB-CC-DD-EE-FF",
+			actual:   "aa:bb:cc:dd:ee:ff",
+			want:     true,
+		},
+		{
+			name:     "different strings",
+			expected: "a",
+			actual:   "b",
+			want:     false,
+		},
+		{
+			name:     "string read as a number",
+			expected: "1",
+			actual:   1.0,
+			want:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := readAfterWriteMatches(context.Background(), tc.expected, tc.actual); got != tc.want {
+				t.Errorf("readAfterWriteMatches(%v, %v) = %v, want %v", tc.expected, tc.actual, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadAfterWriteExpected(t *testing.T) {
+	cases := []struct {
+		name    string
+		body    map[string]interface{}
+		written map[string]interface{}
+		want    map[string]interface{}
+	}{
+		{
+			name:    "echoed values",
+			body:    map[string]interface{}{"name": "Web", "port": 443},
+			written: map[string]interface{}{"name": "web", "port": 443.0, "primaryKey": "web"},
+			want:    map[string]interface{}{"name": "web", "port": 443.0},
+		},
+		{
+			name:    "fields that are not echoed",
+			body:    map[string]interface{}{"name": "web", "comments": "a"},
+			written: map[string]interface{}{"name": "web"},
+			want:    map[string]interface{}{"name": "web", "comments": "a"},
+		},
+		{
+			name:    "secrets and meta fields",
+			body:    map[string]interface{}{"password": "secret", "$meta": "x", "name": "web"},
+			written: map[string]interface{}{"password": "******", "$meta": "x", "name": "web"},
+			want:    map[string]interface{}{"name": "web"},
+		},
+		{
+			name:    "primary key only response",
+			body:    map[string]interface{}{"primaryKey": "web", "members": []interface{}{map[string]interface{}{"primaryKey": "a"}}},
+			written: map[string]interface{}{"primaryKey": "web"},
+			want:    map[string]interface{}{"primaryKey": "web", "members": []interface{}{map[string]interface{}{"primaryKey": "a"}}},
+		},
+		{
+			name:    "no response",
+			body:    map[string]interface{}{"name": "web", "port": 443},
+			written: nil,
+			want:    map[string]interface{}{"name": "web", "port": 443.0},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := readAfterWriteExpected(tc.body, tc.written); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("readAfterWriteExpected(%v, %v) = %v, want %v", tc.body, tc.written, got, tc.want)
+			}
+		})
+	}
+}
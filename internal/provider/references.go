@@ -0,0 +1,108 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// referenceUncheckedDatasources lists the datasources that cannot be read by primary key,
+// references to them are never checked.
+var referenceUncheckedDatasources = []string{
+	"auth/ad-groups",
+	"auth/swg-saml-server",
+	"auth/vpn-saml-server",
+	"network/internet-services",
+	"security/ips-rule",
+	"system/certificate/",
+}
+
+// validatePlanReferences checks that every {primary_key, datasource} reference of the plan points to an existing object.
+// It only runs when validate_references is enabled in the provider, references that are unknown at plan time are skipped.
+func validatePlanReferences(ctx context.Context, client *FortiClient, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	if client == nil || !client.ValidateReferences || plan.Raw.IsNull() || !plan.Raw.IsKnown() {
+		return
+	}
+
+	err := tftypes.Walk(plan.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		objectType, ok := v.Type().(tftypes.Object)
+		if !ok || len(objectType.AttributeTypes) != 2 || v.IsNull() || !v.IsKnown() {
+			return true, nil
+		}
+		if _, ok := objectType.AttributeTypes["primary_key"]; !ok {
+			return true, nil
+		}
+		if _, ok := objectType.AttributeTypes["datasource"]; !ok {
+			return true, nil
+		}
+
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return false, err
+		}
+		if !attrs["primary_key"].IsKnown() || attrs["primary_key"].IsNull() || !attrs["datasource"].IsKnown() || attrs["datasource"].IsNull() {
+			return false, nil
+		}
+		var primaryKey, datasource string
+		if err := attrs["primary_key"].As(&primaryKey); err != nil {
+			return false, err
+		}
+		if err := attrs["datasource"].As(&datasource); err != nil {
+			return false, err
+		}
+		if primaryKey == "" || datasource == "" {
+			return false, nil
+		}
+		for _, unchecked := range referenceUncheckedDatasources {
+			if strings.HasPrefix(datasource, unchecked) {
+				return false, nil
+			}
+		}
+
+		exists, err := client.ReferenceExists(ctx, datasource, primaryKey)
+		if err != nil {
+			diags.AddAttributeWarning(
+				referencePath(p),
+				fmt.Sprintf("Error to check reference %s/%s: %v", datasource, primaryKey, err),
+				"The reference could not be checked, it will be checked by FortiSASE when the plan is applied.",
+			)
+			return false, nil
+		}
+		if !exists {
+			diags.AddAttributeError(
+				referencePath(p),
+				"Referenced Object Not Found",
+				fmt.Sprintf("The object %q referenced from datasource %q does not exist in FortiSASE. Check the primary_key and the datasource of the reference.", primaryKey, datasource),
+			)
+		}
+		return false, nil
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to check references: %v", err), "")
+	}
+}
+
+// referencePath converts the path of a plan value into an attribute path.
+// Set elements cannot be addressed, so the path stops at the set itself.
+func referencePath(p *tftypes.AttributePath) path.Path {
+	result := path.Empty()
+	for _, step := range p.Steps() {
+		switch s := step.(type) {
+		case tftypes.AttributeName:
+			result = result.AtName(string(s))
+		case tftypes.ElementKeyInt:
+			result = result.AtListIndex(int(s))
+		case tftypes.ElementKeyString:
+			result = result.AtMapKey(string(s))
+		default:
+			return result
+		}
+	}
+	return result
+}
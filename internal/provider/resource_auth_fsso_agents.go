@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceAuthFssoAgents{}
+var _ resource.ResourceWithModifyPlan = &resourceAuthFssoAgents{}
 
 func newResourceAuthFssoAgents() resource.Resource {
 	return &resourceAuthFssoAgents{}
@@ -30,22 +32,27 @@ type resourceAuthFssoAgents struct {
 
 // resourceAuthFssoAgentsModel describes the resource data model.
 type resourceAuthFssoAgentsModel struct {
-	ID             types.String `tfsdk:"id"`
-	PrimaryKey     types.String `tfsdk:"primary_key"`
-	ActiveServer   types.String `tfsdk:"active_server"`
-	Status         types.String `tfsdk:"status"`
-	Name           types.String `tfsdk:"name"`
-	Server         types.String `tfsdk:"server"`
-	Password       types.String `tfsdk:"password"`
-	Server2        types.String `tfsdk:"server2"`
-	Password2      types.String `tfsdk:"password2"`
-	Server3        types.String `tfsdk:"server3"`
-	Password3      types.String `tfsdk:"password3"`
-	Server4        types.String `tfsdk:"server4"`
-	Password4      types.String `tfsdk:"password4"`
-	Server5        types.String `tfsdk:"server5"`
-	Password5      types.String `tfsdk:"password5"`
-	SslTrustedCert types.String `tfsdk:"ssl_trusted_cert"`
+	ID                 types.String   `tfsdk:"id"`
+	PrimaryKey         types.String   `tfsdk:"primary_key"`
+	ActiveServer       types.String   `tfsdk:"active_server"`
+	Status             types.String   `tfsdk:"status"`
+	Name               types.String   `tfsdk:"name"`
+	Server             types.String   `tfsdk:"server"`
+	Password           types.String   `tfsdk:"password"`
+	Server2            types.String   `tfsdk:"server2"`
+	Password2          types.String   `tfsdk:"password2"`
+	Server3            types.String   `tfsdk:"server3"`
+	Password3          types.String   `tfsdk:"password3"`
+	Server4            types.String   `tfsdk:"server4"`
+	Password4          types.String   `tfsdk:"password4"`
+	Server5            types.String   `tfsdk:"server5"`
+	Password5          types.String   `tfsdk:"password5"`
+	SslTrustedCert     types.String   `tfsdk:"ssl_trusted_cert"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach        types.Bool     `tfsdk:"force_detach"`
+	RawJson            types.String   `tfsdk:"raw_json"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceAuthFssoAgents) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,6 +62,7 @@ func (r *resourceAuthFssoAgents) Metadata(ctx context.Context, req resource.Meta
 func (r *resourceAuthFssoAgents) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -62,6 +70,9 @@ func (r *resourceAuthFssoAgents) Schema(ctx context.Context, req resource.Schema
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -169,6 +180,9 @@ func (r *resourceAuthFssoAgents) Schema(ctx context.Context, req resource.Schema
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -194,6 +208,10 @@ func (r *resourceAuthFssoAgents) Configure(ctx context.Context, req resource.Con
 	r.resourceName = "fortisase_auth_fsso_agents"
 }
 
+func (r *resourceAuthFssoAgents) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
 func (r *resourceAuthFssoAgents) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("AuthFssoAgents")
 	lock.Lock()
@@ -203,12 +221,16 @@ func (r *resourceAuthFssoAgents) Create(ctx context.Context, req resource.Create
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectAuthFssoAgents(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectAuthFssoAgents(ctx, "create", diags))
 
@@ -216,6 +238,17 @@ func (r *resourceAuthFssoAgents) Create(ctx context.Context, req resource.Create
 		return
 	}
 	output, err := c.CreateAuthFssoAgents(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectAuthFssoAgents(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectAuthFssoAgents(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadAuthFssoAgents, c.UpdateAuthFssoAgents, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -227,10 +260,11 @@ func (r *resourceAuthFssoAgents) Create(ctx context.Context, req resource.Create
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthFssoAgents(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthFssoAgents(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthFssoAgents, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -262,17 +296,22 @@ func (r *resourceAuthFssoAgents) Update(ctx context.Context, req resource.Update
 
 	var data resourceAuthFssoAgentsModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectAuthFssoAgents(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectAuthFssoAgents(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -288,10 +327,11 @@ func (r *resourceAuthFssoAgents) Update(ctx context.Context, req resource.Update
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthFssoAgents(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthFssoAgents(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthFssoAgents, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -321,14 +361,25 @@ func (r *resourceAuthFssoAgents) Delete(ctx context.Context, req resource.Delete
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthFssoAgents(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "auth/fsso-agents", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteAuthFssoAgents(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -349,11 +400,14 @@ func (r *resourceAuthFssoAgents) Read(ctx context.Context, req resource.ReadRequ
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthFssoAgents(ctx, "read", diags))
 
@@ -384,6 +438,8 @@ func (m *resourceAuthFssoAgentsModel) refreshAuthFssoAgents(ctx context.Context,
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["activeServer"]; ok {
 		m.ActiveServer = parseStringValue(v)
 	}
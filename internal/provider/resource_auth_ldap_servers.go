@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceAuthLdapServers{}
+var _ resource.ResourceWithModifyPlan = &resourceAuthLdapServers{}
+var _ resource.ResourceWithUpgradeState = &resourceAuthLdapServers{}
 
 func newResourceAuthLdapServers() resource.Resource {
 	return &resourceAuthLdapServers{}
@@ -34,7 +37,7 @@ type resourceAuthLdapServersModel struct {
 	ID                           types.String                             `tfsdk:"id"`
 	PrimaryKey                   types.String                             `tfsdk:"primary_key"`
 	Server                       types.String                             `tfsdk:"server"`
-	Port                         types.Float64                            `tfsdk:"port"`
+	Port                         types.Int64                              `tfsdk:"port"`
 	Cnid                         types.String                             `tfsdk:"cnid"`
 	Dn                           types.String                             `tfsdk:"dn"`
 	BindType                     types.String                             `tfsdk:"bind_type"`
@@ -52,6 +55,13 @@ type resourceAuthLdapServersModel struct {
 	ClientCert                   *resourceAuthLdapServersClientCertModel  `tfsdk:"client_cert"`
 	Username                     types.String                             `tfsdk:"username"`
 	Password                     types.String                             `tfsdk:"password"`
+	PasswordWo                   types.String                             `tfsdk:"password_wo"`
+	PasswordWoVersion            types.Int64                              `tfsdk:"password_wo_version"`
+	DeletionProtection           types.Bool                               `tfsdk:"deletion_protection"`
+	AdoptExisting                types.Bool                               `tfsdk:"adopt_existing"`
+	ForceDetach                  types.Bool                               `tfsdk:"force_detach"`
+	RawJson                      types.String                             `tfsdk:"raw_json"`
+	Timeouts                     timeouts.Value                           `tfsdk:"timeouts"`
 }
 
 func (r *resourceAuthLdapServers) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,7 +70,9 @@ func (r *resourceAuthLdapServers) Metadata(ctx context.Context, req resource.Met
 
 func (r *resourceAuthLdapServers) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -68,6 +80,9 @@ func (r *resourceAuthLdapServers) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -81,9 +96,9 @@ func (r *resourceAuthLdapServers) Schema(ctx context.Context, req resource.Schem
 				Computed: true,
 				Optional: true,
 			},
-			"port": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 65535),
+			"port": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
 				},
 				Computed: true,
 				Optional: true,
@@ -175,8 +190,23 @@ func (r *resourceAuthLdapServers) Schema(ctx context.Context, req resource.Schem
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
 				},
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `password`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password_wo`. The value of `password_wo` is sent on every create and update, change the version to send a new value when no other attribute changes.",
+				Optional:            true,
 			},
 			"certificate": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -213,6 +243,9 @@ func (r *resourceAuthLdapServers) Schema(ctx context.Context, req resource.Schem
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -238,6 +271,18 @@ func (r *resourceAuthLdapServers) Configure(ctx context.Context, req resource.Co
 	r.resourceName = "fortisase_auth_ldap_servers"
 }
 
+func (r *resourceAuthLdapServers) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
+func (r *resourceAuthLdapServers) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceAuthLdapServers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("AuthLdapServers")
 	lock.Lock()
@@ -247,12 +292,16 @@ func (r *resourceAuthLdapServers) Create(ctx context.Context, req resource.Creat
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectAuthLdapServers(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectAuthLdapServers(ctx, "create", diags))
 
@@ -260,6 +309,17 @@ func (r *resourceAuthLdapServers) Create(ctx context.Context, req resource.Creat
 		return
 	}
 	output, err := c.CreateAuthLdapServers(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectAuthLdapServers(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectAuthLdapServers(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadAuthLdapServers, c.UpdateAuthLdapServers, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -271,10 +331,11 @@ func (r *resourceAuthLdapServers) Create(ctx context.Context, req resource.Creat
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthLdapServers(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthLdapServers(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthLdapServers, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -306,17 +367,22 @@ func (r *resourceAuthLdapServers) Update(ctx context.Context, req resource.Updat
 
 	var data resourceAuthLdapServersModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectAuthLdapServers(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectAuthLdapServers(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -332,10 +398,11 @@ func (r *resourceAuthLdapServers) Update(ctx context.Context, req resource.Updat
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthLdapServers(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthLdapServers(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthLdapServers, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -365,14 +432,25 @@ func (r *resourceAuthLdapServers) Delete(ctx context.Context, req resource.Delet
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthLdapServers(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "auth/ldap-servers", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteAuthLdapServers(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -393,11 +471,14 @@ func (r *resourceAuthLdapServers) Read(ctx context.Context, req resource.ReadReq
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthLdapServers(ctx, "read", diags))
 
@@ -428,12 +509,14 @@ func (m *resourceAuthLdapServersModel) refreshAuthLdapServers(ctx context.Contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["server"]; ok {
 		m.Server = parseStringValue(v)
 	}
 
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	if v, ok := o["cnid"]; ok {
@@ -518,7 +601,7 @@ func (data *resourceAuthLdapServersModel) getCreateObjectAuthLdapServers(ctx con
 	}
 
 	if !data.Port.IsNull() {
-		result["port"] = data.Port.ValueFloat64()
+		result["port"] = data.Port.ValueInt64()
 	}
 
 	if !data.Cnid.IsNull() {
@@ -589,6 +672,10 @@ func (data *resourceAuthLdapServersModel) getCreateObjectAuthLdapServers(ctx con
 		result["password"] = data.Password.ValueString()
 	}
 
+	if !data.PasswordWo.IsNull() {
+		result["password"] = data.PasswordWo.ValueString()
+	}
+
 	return &result
 }
 
@@ -603,7 +690,7 @@ func (data *resourceAuthLdapServersModel) getUpdateObjectAuthLdapServers(ctx con
 	}
 
 	if !data.Port.IsNull() {
-		result["port"] = data.Port.ValueFloat64()
+		result["port"] = data.Port.ValueInt64()
 	}
 
 	if !data.Cnid.IsNull() {
@@ -674,6 +761,10 @@ func (data *resourceAuthLdapServersModel) getUpdateObjectAuthLdapServers(ctx con
 		result["password"] = data.Password.ValueString()
 	}
 
+	if !data.PasswordWo.IsNull() {
+		result["password"] = data.PasswordWo.ValueString()
+	}
+
 	return &result
 }
 
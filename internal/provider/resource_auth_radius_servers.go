@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceAuthRadiusServers{}
+var _ resource.ResourceWithModifyPlan = &resourceAuthRadiusServers{}
 
 func newResourceAuthRadiusServers() resource.Resource {
 	return &resourceAuthRadiusServers{}
@@ -30,14 +32,23 @@ type resourceAuthRadiusServers struct {
 
 // resourceAuthRadiusServersModel describes the resource data model.
 type resourceAuthRadiusServersModel struct {
-	ID                         types.String `tfsdk:"id"`
-	PrimaryKey                 types.String `tfsdk:"primary_key"`
-	AuthType                   types.String `tfsdk:"auth_type"`
-	PrimaryServer              types.String `tfsdk:"primary_server"`
-	PrimarySecret              types.String `tfsdk:"primary_secret"`
-	IncludedInDefaultUserGroup types.Bool   `tfsdk:"included_in_default_user_group"`
-	SecondaryServer            types.String `tfsdk:"secondary_server"`
-	SecondarySecret            types.String `tfsdk:"secondary_secret"`
+	ID                         types.String   `tfsdk:"id"`
+	PrimaryKey                 types.String   `tfsdk:"primary_key"`
+	AuthType                   types.String   `tfsdk:"auth_type"`
+	PrimaryServer              types.String   `tfsdk:"primary_server"`
+	PrimarySecret              types.String   `tfsdk:"primary_secret"`
+	PrimarySecretWo            types.String   `tfsdk:"primary_secret_wo"`
+	PrimarySecretWoVersion     types.Int64    `tfsdk:"primary_secret_wo_version"`
+	IncludedInDefaultUserGroup types.Bool     `tfsdk:"included_in_default_user_group"`
+	SecondaryServer            types.String   `tfsdk:"secondary_server"`
+	SecondarySecret            types.String   `tfsdk:"secondary_secret"`
+	SecondarySecretWo          types.String   `tfsdk:"secondary_secret_wo"`
+	SecondarySecretWoVersion   types.Int64    `tfsdk:"secondary_secret_wo_version"`
+	DeletionProtection         types.Bool     `tfsdk:"deletion_protection"`
+	AdoptExisting              types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach                types.Bool     `tfsdk:"force_detach"`
+	RawJson                    types.String   `tfsdk:"raw_json"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceAuthRadiusServers) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,6 +58,7 @@ func (r *resourceAuthRadiusServers) Metadata(ctx context.Context, req resource.M
 func (r *resourceAuthRadiusServers) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -54,6 +66,9 @@ func (r *resourceAuthRadiusServers) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -82,6 +97,20 @@ func (r *resourceAuthRadiusServers) Schema(ctx context.Context, req resource.Sch
 				Computed:  true,
 				Optional:  true,
 			},
+			"primary_secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `primary_secret`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("primary_secret")),
+					stringvalidator.AlsoRequires(path.MatchRoot("primary_secret_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"primary_secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `primary_secret_wo`. The value of `primary_secret_wo` is sent on every create and update, change the version to send a new value when no other attribute changes.",
+				Optional:            true,
+			},
 			"included_in_default_user_group": schema.BoolAttribute{
 				Computed: true,
 				Optional: true,
@@ -101,6 +130,23 @@ func (r *resourceAuthRadiusServers) Schema(ctx context.Context, req resource.Sch
 				Computed:  true,
 				Optional:  true,
 			},
+			"secondary_secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `secondary_secret`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("secondary_secret")),
+					stringvalidator.AlsoRequires(path.MatchRoot("secondary_secret_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"secondary_secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `secondary_secret_wo`. The value of `secondary_secret_wo` is sent on every create and update, change the version to send a new value when no other attribute changes.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -127,6 +173,10 @@ func (r *resourceAuthRadiusServers) Configure(ctx context.Context, req resource.
 	r.resourceName = "fortisase_auth_radius_servers"
 }
 
+func (r *resourceAuthRadiusServers) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
 func (r *resourceAuthRadiusServers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("AuthRadiusServers")
 	lock.Lock()
@@ -136,12 +186,16 @@ func (r *resourceAuthRadiusServers) Create(ctx context.Context, req resource.Cre
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectAuthRadiusServers(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectAuthRadiusServers(ctx, "create", diags))
 
@@ -149,6 +203,17 @@ func (r *resourceAuthRadiusServers) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	output, err := c.CreateAuthRadiusServers(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectAuthRadiusServers(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectAuthRadiusServers(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadAuthRadiusServers, c.UpdateAuthRadiusServers, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -160,10 +225,11 @@ func (r *resourceAuthRadiusServers) Create(ctx context.Context, req resource.Cre
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthRadiusServers(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthRadiusServers(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthRadiusServers, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -195,17 +261,22 @@ func (r *resourceAuthRadiusServers) Update(ctx context.Context, req resource.Upd
 
 	var data resourceAuthRadiusServersModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectAuthRadiusServers(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectAuthRadiusServers(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -221,10 +292,11 @@ func (r *resourceAuthRadiusServers) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthRadiusServers(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthRadiusServers(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthRadiusServers, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -254,14 +326,25 @@ func (r *resourceAuthRadiusServers) Delete(ctx context.Context, req resource.Del
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthRadiusServers(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "auth/radius-servers", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteAuthRadiusServers(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -282,11 +365,14 @@ func (r *resourceAuthRadiusServers) Read(ctx context.Context, req resource.ReadR
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthRadiusServers(ctx, "read", diags))
 
@@ -317,6 +403,8 @@ func (m *resourceAuthRadiusServersModel) refreshAuthRadiusServers(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["authType"]; ok {
 		m.AuthType = parseStringValue(v)
 	}
@@ -354,6 +442,10 @@ func (data *resourceAuthRadiusServersModel) getCreateObjectAuthRadiusServers(ctx
 		result["primarySecret"] = data.PrimarySecret.ValueString()
 	}
 
+	if !data.PrimarySecretWo.IsNull() {
+		result["primarySecret"] = data.PrimarySecretWo.ValueString()
+	}
+
 	if !data.IncludedInDefaultUserGroup.IsNull() {
 		result["includedInDefaultUserGroup"] = data.IncludedInDefaultUserGroup.ValueBool()
 	}
@@ -366,6 +458,10 @@ func (data *resourceAuthRadiusServersModel) getCreateObjectAuthRadiusServers(ctx
 		result["secondarySecret"] = data.SecondarySecret.ValueString()
 	}
 
+	if !data.SecondarySecretWo.IsNull() {
+		result["secondarySecret"] = data.SecondarySecretWo.ValueString()
+	}
+
 	return &result
 }
 
@@ -399,6 +495,14 @@ func (data *resourceAuthRadiusServersModel) getUpdateObjectAuthRadiusServers(ctx
 		result["secondarySecret"] = data.SecondarySecret.ValueString()
 	}
 
+	if !data.PrimarySecretWo.IsNull() {
+		result["primarySecret"] = data.PrimarySecretWo.ValueString()
+	}
+
+	if !data.SecondarySecretWo.IsNull() {
+		result["secondarySecret"] = data.SecondarySecretWo.ValueString()
+	}
+
 	return &result
 }
 
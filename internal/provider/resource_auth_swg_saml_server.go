@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceAuthSwgSamlServer{}
+var _ resource.ResourceWithModifyPlan = &resourceAuthSwgSamlServer{}
+var _ resource.ResourceWithUpgradeState = &resourceAuthSwgSamlServer{}
 
 func newResourceAuthSwgSamlServer() resource.Resource {
 	return &resourceAuthSwgSamlServer{}
@@ -31,19 +34,23 @@ type resourceAuthSwgSamlServer struct {
 
 // resourceAuthSwgSamlServerModel describes the resource data model.
 type resourceAuthSwgSamlServerModel struct {
-	ID             types.String                                  `tfsdk:"id"`
-	PrimaryKey     types.String                                  `tfsdk:"primary_key"`
-	IdpEntityId    types.String                                  `tfsdk:"idp_entity_id"`
-	IdpSignOnUrl   types.String                                  `tfsdk:"idp_sign_on_url"`
-	IdpLogOutUrl   types.String                                  `tfsdk:"idp_log_out_url"`
-	Username       types.String                                  `tfsdk:"username"`
-	GroupName      types.String                                  `tfsdk:"group_name"`
-	GroupMatch     types.String                                  `tfsdk:"group_match"`
-	SpCert         *resourceAuthSwgSamlServerSpCertModel         `tfsdk:"sp_cert"`
-	IdpCertificate *resourceAuthSwgSamlServerIdpCertificateModel `tfsdk:"idp_certificate"`
-	DigestMethod   types.String                                  `tfsdk:"digest_method"`
-	ScimEnabled    types.Bool                                    `tfsdk:"scim_enabled"`
-	Scim           *resourceAuthSwgSamlServerScimModel           `tfsdk:"scim"`
+	ID                 types.String                                  `tfsdk:"id"`
+	PrimaryKey         types.String                                  `tfsdk:"primary_key"`
+	RestoreOnDestroy   types.Bool                                    `tfsdk:"restore_on_destroy"`
+	IdpEntityId        types.String                                  `tfsdk:"idp_entity_id"`
+	IdpSignOnUrl       types.String                                  `tfsdk:"idp_sign_on_url"`
+	IdpLogOutUrl       types.String                                  `tfsdk:"idp_log_out_url"`
+	Username           types.String                                  `tfsdk:"username"`
+	GroupName          types.String                                  `tfsdk:"group_name"`
+	GroupMatch         types.String                                  `tfsdk:"group_match"`
+	SpCert             *resourceAuthSwgSamlServerSpCertModel         `tfsdk:"sp_cert"`
+	IdpCertificate     *resourceAuthSwgSamlServerIdpCertificateModel `tfsdk:"idp_certificate"`
+	DigestMethod       types.String                                  `tfsdk:"digest_method"`
+	ScimEnabled        types.Bool                                    `tfsdk:"scim_enabled"`
+	Scim               *resourceAuthSwgSamlServerScimModel           `tfsdk:"scim"`
+	DeletionProtection types.Bool                                    `tfsdk:"deletion_protection"`
+	RawJson            types.String                                  `tfsdk:"raw_json"`
+	Timeouts           timeouts.Value                                `tfsdk:"timeouts"`
 }
 
 func (r *resourceAuthSwgSamlServer) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,7 +59,9 @@ func (r *resourceAuthSwgSamlServer) Metadata(ctx context.Context, req resource.M
 
 func (r *resourceAuthSwgSamlServer) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -60,6 +69,8 @@ func (r *resourceAuthSwgSamlServer) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"restore_on_destroy": restoreOnDestroyAttribute(),
+			"raw_json":           rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -169,14 +180,18 @@ func (r *resourceAuthSwgSamlServer) Schema(ctx context.Context, req resource.Sch
 						Validators: []validator.String{
 							stringvalidator.LengthBetween(1, 128),
 						},
-						Computed: true,
-						Optional: true,
+						Sensitive: true,
+						Computed:  true,
+						Optional:  true,
 					},
 				},
 				Computed: true,
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -202,18 +217,48 @@ func (r *resourceAuthSwgSamlServer) Configure(ctx context.Context, req resource.
 	r.resourceName = "fortisase_auth_swg_saml_server"
 }
 
+func (r *resourceAuthSwgSamlServer) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
+func (r *resourceAuthSwgSamlServer) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.1.0 removed the attribute enabled, which is handled by the provider internally.
+		removeStateAttributes("enabled"),
+	)
+}
+
 func (r *resourceAuthSwgSamlServer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resourceAuthSwgSamlServerModel
 	diags := &resp.Diagnostics
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
+	// Capture the object before updating it, it is restored when the resource is destroyed
+	var baseline_input_model forticlient.InputModel
+	baseline_input_model.Ctx = ctx
+	baseline_input_model.Mkey = data.PrimaryKey.ValueString()
+	baseline, err := c.ReadAuthSwgSamlServer(&baseline_input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&baseline_input_model, baseline),
+		)
+		return
+	}
+
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectAuthSwgSamlServer(ctx, diags))
 	input_model.BodyParams["enabled"] = true
@@ -233,9 +278,10 @@ func (r *resourceAuthSwgSamlServer) Create(ctx context.Context, req resource.Cre
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
-	read_output, err := c.ReadAuthSwgSamlServer(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthSwgSamlServer, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -249,6 +295,7 @@ func (r *resourceAuthSwgSamlServer) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	diags.Append(saveBaseline(ctx, resp.Private, baseline)...)
 	diags.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -267,17 +314,23 @@ func (r *resourceAuthSwgSamlServer) Update(ctx context.Context, req resource.Upd
 
 	var data resourceAuthSwgSamlServerModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectAuthSwgSamlServer(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.BodyParams["enabled"] = true
 
 	if diags.HasError() {
@@ -293,9 +346,10 @@ func (r *resourceAuthSwgSamlServer) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
-	read_output, err := c.ReadAuthSwgSamlServer(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthSwgSamlServer, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -325,15 +379,32 @@ func (r *resourceAuthSwgSamlServer) Delete(ctx context.Context, req resource.Del
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
+	if !isRestoreOnDestroy(data.RestoreOnDestroy) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
-	result_model := make(map[string]interface{})
+	// Restore the baseline captured on create, or disable the server when there is none
+	result_model := loadBaseline(ctx, req.Private, diags)
+	if diags.HasError() {
+		return
+	}
+	if result_model == nil {
+		result_model = make(map[string]interface{})
+		result_model["enabled"] = false
+	}
 	result_model["primaryKey"] = mkey
-	result_model["enabled"] = false
 	input_model.BodyParams = result_model
 
 	output, err := c.UpdateAuthSwgSamlServer(&input_model)
@@ -356,11 +427,14 @@ func (r *resourceAuthSwgSamlServer) Read(ctx context.Context, req resource.ReadR
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 
 	read_output, err := c.ReadAuthSwgSamlServer(&input_model)
@@ -382,6 +456,7 @@ func (r *resourceAuthSwgSamlServer) Read(ctx context.Context, req resource.ReadR
 
 func (r *resourceAuthSwgSamlServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
 }
 
 func (m *resourceAuthSwgSamlServerModel) refreshAuthSwgSamlServer(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
@@ -390,6 +465,8 @@ func (m *resourceAuthSwgSamlServerModel) refreshAuthSwgSamlServer(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["primaryKey"]; ok {
 		m.PrimaryKey = parseStringValue(v)
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceAuthUserGroups{}
+var _ resource.ResourceWithModifyPlan = &resourceAuthUserGroups{}
+var _ resource.ResourceWithUpgradeState = &resourceAuthUserGroups{}
 
 func newResourceAuthUserGroups() resource.Resource {
 	return &resourceAuthUserGroups{}
@@ -35,6 +38,10 @@ type resourceAuthUserGroupsModel struct {
 	GroupType        types.String                                  `tfsdk:"group_type"`
 	LocalUsers       []resourceAuthUserGroupsLocalUsersModel       `tfsdk:"local_users"`
 	RemoteUserGroups []resourceAuthUserGroupsRemoteUserGroupsModel `tfsdk:"remote_user_groups"`
+	AdoptExisting    types.Bool                                    `tfsdk:"adopt_existing"`
+	ForceDetach      types.Bool                                    `tfsdk:"force_detach"`
+	RawJson          types.String                                  `tfsdk:"raw_json"`
+	Timeouts         timeouts.Value                                `tfsdk:"timeouts"`
 }
 
 func (r *resourceAuthUserGroups) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,6 +50,7 @@ func (r *resourceAuthUserGroups) Metadata(ctx context.Context, req resource.Meta
 
 func (r *resourceAuthUserGroups) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -51,6 +59,9 @@ func (r *resourceAuthUserGroups) Schema(ctx context.Context, req resource.Schema
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(35),
@@ -64,7 +75,7 @@ func (r *resourceAuthUserGroups) Schema(ctx context.Context, req resource.Schema
 				Computed: true,
 				Optional: true,
 			},
-			"local_users": schema.ListNestedAttribute{
+			"local_users": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -83,7 +94,7 @@ func (r *resourceAuthUserGroups) Schema(ctx context.Context, req resource.Schema
 				Computed: true,
 				Optional: true,
 			},
-			"remote_user_groups": schema.ListNestedAttribute{
+			"remote_user_groups": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"matches": schema.SetAttribute{
@@ -114,6 +125,9 @@ func (r *resourceAuthUserGroups) Schema(ctx context.Context, req resource.Schema
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -139,6 +153,17 @@ func (r *resourceAuthUserGroups) Configure(ctx context.Context, req resource.Con
 	r.resourceName = "fortisase_auth_user_groups"
 }
 
+func (r *resourceAuthUserGroups) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceAuthUserGroups) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered lists local_users and remote_user_groups to sets.
+		keepState(),
+	)
+}
+
 func (r *resourceAuthUserGroups) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("AuthUserGroups")
 	lock.Lock()
@@ -151,9 +176,12 @@ func (r *resourceAuthUserGroups) Create(ctx context.Context, req resource.Create
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectAuthUserGroups(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectAuthUserGroups(ctx, "create", diags))
 
@@ -161,6 +189,17 @@ func (r *resourceAuthUserGroups) Create(ctx context.Context, req resource.Create
 		return
 	}
 	output, err := c.CreateAuthUserGroups(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectAuthUserGroups(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectAuthUserGroups(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadAuthUserGroups, c.UpdateAuthUserGroups, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -172,10 +211,11 @@ func (r *resourceAuthUserGroups) Create(ctx context.Context, req resource.Create
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthUserGroups(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthUserGroups(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthUserGroups, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -210,14 +250,18 @@ func (r *resourceAuthUserGroups) Update(ctx context.Context, req resource.Update
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectAuthUserGroups(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectAuthUserGroups(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -233,10 +277,11 @@ func (r *resourceAuthUserGroups) Update(ctx context.Context, req resource.Update
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthUserGroups(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthUserGroups(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthUserGroups, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -266,14 +311,21 @@ func (r *resourceAuthUserGroups) Delete(ctx context.Context, req resource.Delete
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthUserGroups(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "auth/user-groups", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteAuthUserGroups(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -294,11 +346,14 @@ func (r *resourceAuthUserGroups) Read(ctx context.Context, req resource.ReadRequ
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthUserGroups(ctx, "read", diags))
 
@@ -329,6 +384,8 @@ func (m *resourceAuthUserGroupsModel) refreshAuthUserGroups(ctx context.Context,
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["groupType"]; ok {
 		m.GroupType = parseStringValue(v)
 	}
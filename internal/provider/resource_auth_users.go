@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceAuthUsers{}
+var _ resource.ResourceWithModifyPlan = &resourceAuthUsers{}
+var _ resource.ResourceWithConfigValidators = &resourceAuthUsers{}
 
 func newResourceAuthUsers() resource.Resource {
 	return &resourceAuthUsers{}
@@ -30,13 +33,18 @@ type resourceAuthUsers struct {
 
 // resourceAuthUsersModel describes the resource data model.
 type resourceAuthUsersModel struct {
-	ID         types.String                      `tfsdk:"id"`
-	PrimaryKey types.String                      `tfsdk:"primary_key"`
-	AuthType   types.String                      `tfsdk:"auth_type"`
-	Status     types.String                      `tfsdk:"status"`
-	Email      types.String                      `tfsdk:"email"`
-	Password   types.String                      `tfsdk:"password"`
-	LdapServer *resourceAuthUsersLdapServerModel `tfsdk:"ldap_server"`
+	ID                types.String                      `tfsdk:"id"`
+	PrimaryKey        types.String                      `tfsdk:"primary_key"`
+	AuthType          types.String                      `tfsdk:"auth_type"`
+	Status            types.String                      `tfsdk:"status"`
+	Email             types.String                      `tfsdk:"email"`
+	Password          types.String                      `tfsdk:"password"`
+	PasswordWo        types.String                      `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64                       `tfsdk:"password_wo_version"`
+	LdapServer        *resourceAuthUsersLdapServerModel `tfsdk:"ldap_server"`
+	AdoptExisting     types.Bool                        `tfsdk:"adopt_existing"`
+	RawJson           types.String                      `tfsdk:"raw_json"`
+	Timeouts          timeouts.Value                    `tfsdk:"timeouts"`
 }
 
 func (r *resourceAuthUsers) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +61,8 @@ func (r *resourceAuthUsers) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 64),
@@ -85,6 +95,20 @@ func (r *resourceAuthUsers) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:  true,
 				Optional:  true,
 			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `password`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password_wo`. The value of `password_wo` is sent on every create and update, change the version to send a new value when no other attribute changes.",
+				Optional:            true,
+			},
 			"ldap_server": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"primary_key": schema.StringAttribute{
@@ -103,6 +127,23 @@ func (r *resourceAuthUsers) Schema(ctx context.Context, req resource.SchemaReque
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceAuthUsers) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validateDiscriminator(path.MatchRoot("auth_type"), map[string]discriminatorVariant{
+			"password": {
+				conflicting: []string{"ldap_server"},
+			},
+			"ldap": {
+				required:    [][]string{{"ldap_server"}},
+				conflicting: []string{"password", "password_wo"},
+			},
+		}),
 	}
 }
 
@@ -128,6 +169,10 @@ func (r *resourceAuthUsers) Configure(ctx context.Context, req resource.Configur
 	r.resourceName = "fortisase_auth_users"
 }
 
+func (r *resourceAuthUsers) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
 func (r *resourceAuthUsers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("AuthUsers")
 	lock.Lock()
@@ -140,9 +185,12 @@ func (r *resourceAuthUsers) Create(ctx context.Context, req resource.CreateReque
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectAuthUsers(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectAuthUsers(ctx, "create", diags))
 
@@ -150,6 +198,17 @@ func (r *resourceAuthUsers) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 	output, err := c.CreateAuthUsers(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectAuthUsers(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectAuthUsers(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadAuthUsers, c.UpdateAuthUsers, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -161,10 +220,11 @@ func (r *resourceAuthUsers) Create(ctx context.Context, req resource.CreateReque
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthUsers(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthUsers(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthUsers, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -199,14 +259,18 @@ func (r *resourceAuthUsers) Update(ctx context.Context, req resource.UpdateReque
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectAuthUsers(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectAuthUsers(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -222,10 +286,11 @@ func (r *resourceAuthUsers) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectAuthUsers(ctx, "read", diags))
 
-	read_output, err := c.ReadAuthUsers(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadAuthUsers, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -255,11 +320,14 @@ func (r *resourceAuthUsers) Delete(ctx context.Context, req resource.DeleteReque
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthUsers(ctx, "delete", diags))
 
@@ -283,11 +351,14 @@ func (r *resourceAuthUsers) Read(ctx context.Context, req resource.ReadRequest,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectAuthUsers(ctx, "read", diags))
 
@@ -318,6 +389,8 @@ func (m *resourceAuthUsersModel) refreshAuthUsers(ctx context.Context, o map[str
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["authType"]; ok {
 		m.AuthType = parseStringValue(v)
 	}
@@ -359,6 +432,10 @@ func (data *resourceAuthUsersModel) getCreateObjectAuthUsers(ctx context.Context
 		result["password"] = data.Password.ValueString()
 	}
 
+	if !data.PasswordWo.IsNull() {
+		result["password"] = data.PasswordWo.ValueString()
+	}
+
 	if data.LdapServer != nil && !isZeroStruct(*data.LdapServer) {
 		result["ldapServer"] = data.LdapServer.expandAuthUsersLdapServer(ctx, diags)
 	}
@@ -388,6 +465,10 @@ func (data *resourceAuthUsersModel) getUpdateObjectAuthUsers(ctx context.Context
 		result["ldapServer"] = data.LdapServer.expandAuthUsersLdapServer(ctx, diags)
 	}
 
+	if !data.PasswordWo.IsNull() {
+		result["password"] = data.PasswordWo.ValueString()
+	}
+
 	return &result
 }
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -20,6 +21,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceAuthVpnSamlServer{}
+var _ resource.ResourceWithModifyPlan = &resourceAuthVpnSamlServer{}
+var _ resource.ResourceWithUpgradeState = &resourceAuthVpnSamlServer{}
 
 func newResourceAuthVpnSamlServer() resource.Resource {
 	return &resourceAuthVpnSamlServer{}
@@ -32,21 +35,25 @@ type resourceAuthVpnSamlServer struct {
 
 // resourceAuthVpnSamlServerModel describes the resource data model.
 type resourceAuthVpnSamlServerModel struct {
-	ID             types.String                                  `tfsdk:"id"`
-	PrimaryKey     types.String                                  `tfsdk:"primary_key"`
-	IdpEntityId    types.String                                  `tfsdk:"idp_entity_id"`
-	IdpSignOnUrl   types.String                                  `tfsdk:"idp_sign_on_url"`
-	IdpLogOutUrl   types.String                                  `tfsdk:"idp_log_out_url"`
-	Username       types.String                                  `tfsdk:"username"`
-	GroupName      types.String                                  `tfsdk:"group_name"`
-	GroupId        types.String                                  `tfsdk:"group_id"`
-	SpCert         *resourceAuthVpnSamlServerSpCertModel         `tfsdk:"sp_cert"`
-	IdpCertificate *resourceAuthVpnSamlServerIdpCertificateModel `tfsdk:"idp_certificate"`
-	DigestMethod   types.String                                  `tfsdk:"digest_method"`
-	EntraIdEnabled types.Bool                                    `tfsdk:"entra_id_enabled"`
-	ScimEnabled    types.Bool                                    `tfsdk:"scim_enabled"`
-	DomainName     types.String                                  `tfsdk:"domain_name"`
-	ApplicationId  types.String                                  `tfsdk:"application_id"`
+	ID                 types.String                                  `tfsdk:"id"`
+	PrimaryKey         types.String                                  `tfsdk:"primary_key"`
+	RestoreOnDestroy   types.Bool                                    `tfsdk:"restore_on_destroy"`
+	IdpEntityId        types.String                                  `tfsdk:"idp_entity_id"`
+	IdpSignOnUrl       types.String                                  `tfsdk:"idp_sign_on_url"`
+	IdpLogOutUrl       types.String                                  `tfsdk:"idp_log_out_url"`
+	Username           types.String                                  `tfsdk:"username"`
+	GroupName          types.String                                  `tfsdk:"group_name"`
+	GroupId            types.String                                  `tfsdk:"group_id"`
+	SpCert             *resourceAuthVpnSamlServerSpCertModel         `tfsdk:"sp_cert"`
+	IdpCertificate     *resourceAuthVpnSamlServerIdpCertificateModel `tfsdk:"idp_certificate"`
+	DigestMethod       types.String                                  `tfsdk:"digest_method"`
+	EntraIdEnabled     types.Bool                                    `tfsdk:"entra_id_enabled"`
+	ScimEnabled        types.Bool                                    `tfsdk:"scim_enabled"`
+	DomainName         types.String                                  `tfsdk:"domain_name"`
+	ApplicationId      types.String                                  `tfsdk:"application_id"`
+	DeletionProtection types.Bool                                    `tfsdk:"deletion_protection"`
+	RawJson            types.String                                  `tfsdk:"raw_json"`
+	Timeouts           timeouts.Value                                `tfsdk:"timeouts"`
 }
 
 func (r *resourceAuthVpnSamlServer) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,7 +62,9 @@ func (r *resourceAuthVpnSamlServer) Metadata(ctx context.Context, req resource.M
 
 func (r *resourceAuthVpnSamlServer) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -63,6 +72,8 @@ func (r *resourceAuthVpnSamlServer) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"restore_on_destroy": restoreOnDestroyAttribute(),
+			"raw_json":           rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -174,6 +185,9 @@ func (r *resourceAuthVpnSamlServer) Schema(ctx context.Context, req resource.Sch
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -199,18 +213,48 @@ func (r *resourceAuthVpnSamlServer) Configure(ctx context.Context, req resource.
 	r.resourceName = "fortisase_auth_vpn_saml_server"
 }
 
+func (r *resourceAuthVpnSamlServer) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
+func (r *resourceAuthVpnSamlServer) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.1.0 removed the attribute enabled, which is handled by the provider internally.
+		removeStateAttributes("enabled"),
+	)
+}
+
 func (r *resourceAuthVpnSamlServer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resourceAuthVpnSamlServerModel
 	diags := &resp.Diagnostics
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
+	// Capture the object before updating it, it is restored when the resource is destroyed
+	var baseline_input_model forticlient.InputModel
+	baseline_input_model.Ctx = ctx
+	baseline_input_model.Mkey = data.PrimaryKey.ValueString()
+	baseline, err := c.ReadAuthVpnSamlServer(&baseline_input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&baseline_input_model, baseline),
+		)
+		return
+	}
+
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectAuthVpnSamlServer(ctx, diags))
 	input_model.BodyParams["enabled"] = true
@@ -249,11 +293,12 @@ func (r *resourceAuthVpnSamlServer) Create(ctx context.Context, req resource.Cre
 	mkey := "$sase-global"
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
 	read_output := make(map[string]interface{})
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	pending := true
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadAuthVpnSamlServer(&read_input_model)
 		if err != nil {
 			diags.AddError(
@@ -279,6 +324,7 @@ func (r *resourceAuthVpnSamlServer) Create(ctx context.Context, req resource.Cre
 				continue
 			}
 		}
+		pending = false
 		break
 	}
 
@@ -287,7 +333,16 @@ func (r *resourceAuthVpnSamlServer) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	diags.Append(saveBaseline(ctx, resp.Private, baseline)...)
 	diags.Append(resp.State.Set(ctx, &data)...)
+	if pending {
+		// The state is kept, so the resource is tainted and replaced by the next apply
+		meta, _ := read_output["$meta"].(map[string]interface{})
+		diags.AddError(
+			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, ctx.Err()),
+			pollTimeoutDetail("the state", meta["state"]),
+		)
+	}
 }
 
 func (r *resourceAuthVpnSamlServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -302,17 +357,23 @@ func (r *resourceAuthVpnSamlServer) Update(ctx context.Context, req resource.Upd
 
 	var data resourceAuthVpnSamlServerModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectAuthVpnSamlServer(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.BodyParams["enabled"] = true
 
 	if diags.HasError() {
@@ -347,11 +408,12 @@ func (r *resourceAuthVpnSamlServer) Update(ctx context.Context, req resource.Upd
 		}
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
 	read_output := make(map[string]interface{})
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	pending := true
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadAuthVpnSamlServer(&read_input_model)
 		if err != nil {
 			diags.AddError(
@@ -377,6 +439,7 @@ func (r *resourceAuthVpnSamlServer) Update(ctx context.Context, req resource.Upd
 				continue
 			}
 		}
+		pending = false
 		break
 	}
 
@@ -386,6 +449,13 @@ func (r *resourceAuthVpnSamlServer) Update(ctx context.Context, req resource.Upd
 	}
 
 	diags.Append(resp.State.Set(ctx, &data)...)
+	if pending {
+		meta, _ := read_output["$meta"].(map[string]interface{})
+		diags.AddError(
+			fmt.Sprintf("Error to update resource %s: %v", r.resourceName, ctx.Err()),
+			pollTimeoutDetail("the state", meta["state"]),
+		)
+	}
 }
 
 func (r *resourceAuthVpnSamlServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -398,15 +468,32 @@ func (r *resourceAuthVpnSamlServer) Delete(ctx context.Context, req resource.Del
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
+	if !isRestoreOnDestroy(data.RestoreOnDestroy) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
-	result_model := make(map[string]interface{})
+	// Restore the baseline captured on create, or disable the server when there is none
+	result_model := loadBaseline(ctx, req.Private, diags)
+	if diags.HasError() {
+		return
+	}
+	if result_model == nil {
+		result_model = make(map[string]interface{})
+		result_model["enabled"] = false
+	}
 	result_model["primaryKey"] = mkey
-	result_model["enabled"] = false
 	input_model.BodyParams = result_model
 
 	output, err := c.UpdateAuthVpnSamlServer(&input_model)
@@ -437,10 +524,10 @@ func (r *resourceAuthVpnSamlServer) Delete(ctx context.Context, req resource.Del
 		}
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_output := make(map[string]interface{})
-	for i := 0; i < 50; i++ {
-		time.Sleep(10 * time.Second)
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadAuthVpnSamlServer(&read_input_model)
 		if err != nil {
 			diags.AddError(
@@ -472,8 +559,8 @@ func (r *resourceAuthVpnSamlServer) Delete(ctx context.Context, req resource.Del
 		}
 	}
 	diags.AddError(
-		fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
-		fmt.Sprintf("The resource still exists: %v", read_output),
+		fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, ctx.Err()),
+		fmt.Sprintf("The timeout expired while the resource still exists: %v. Increase the timeout in the timeouts block.", read_output),
 	)
 }
 
@@ -487,11 +574,14 @@ func (r *resourceAuthVpnSamlServer) Read(ctx context.Context, req resource.ReadR
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 
 	read_output, err := c.ReadAuthVpnSamlServer(&input_model)
@@ -513,6 +603,7 @@ func (r *resourceAuthVpnSamlServer) Read(ctx context.Context, req resource.ReadR
 
 func (r *resourceAuthVpnSamlServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
 }
 
 func (m *resourceAuthVpnSamlServerModel) refreshAuthVpnSamlServer(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
@@ -521,6 +612,8 @@ func (m *resourceAuthVpnSamlServerModel) refreshAuthVpnSamlServer(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["primaryKey"]; ok {
 		m.PrimaryKey = parseStringValue(v)
 	}
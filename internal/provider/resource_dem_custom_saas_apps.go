@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,10 +31,13 @@ type resourceDemCustomSaasApps struct {
 
 // resourceDemCustomSaasAppsModel describes the resource data model.
 type resourceDemCustomSaasAppsModel struct {
-	ID         types.String `tfsdk:"id"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	Alias      types.String `tfsdk:"alias"`
-	Fqdn       types.String `tfsdk:"fqdn"`
+	ID            types.String   `tfsdk:"id"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	Alias         types.String   `tfsdk:"alias"`
+	Fqdn          fqdnValue      `tfsdk:"fqdn"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	RawJson       types.String   `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceDemCustomSaasApps) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +54,8 @@ func (r *resourceDemCustomSaasApps) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 253),
@@ -62,6 +68,7 @@ func (r *resourceDemCustomSaasApps) Schema(ctx context.Context, req resource.Sch
 				Optional: true,
 			},
 			"fqdn": schema.StringAttribute{
+				CustomType: fqdnType{},
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 253),
 				},
@@ -70,6 +77,9 @@ func (r *resourceDemCustomSaasApps) Schema(ctx context.Context, req resource.Sch
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -107,9 +117,12 @@ func (r *resourceDemCustomSaasApps) Create(ctx context.Context, req resource.Cre
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectDemCustomSaasApps(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectDemCustomSaasApps(ctx, "create", diags))
 
@@ -117,6 +130,17 @@ func (r *resourceDemCustomSaasApps) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	output, err := c.CreateDemCustomSaasApps(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectDemCustomSaasApps(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectDemCustomSaasApps(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadDemCustomSaasApps, c.UpdateDemCustomSaasApps, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -128,10 +152,11 @@ func (r *resourceDemCustomSaasApps) Create(ctx context.Context, req resource.Cre
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectDemCustomSaasApps(ctx, "read", diags))
 
-	read_output, err := c.ReadDemCustomSaasApps(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadDemCustomSaasApps, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -166,14 +191,18 @@ func (r *resourceDemCustomSaasApps) Update(ctx context.Context, req resource.Upd
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectDemCustomSaasApps(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectDemCustomSaasApps(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -189,10 +218,11 @@ func (r *resourceDemCustomSaasApps) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectDemCustomSaasApps(ctx, "read", diags))
 
-	read_output, err := c.ReadDemCustomSaasApps(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadDemCustomSaasApps, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -222,11 +252,14 @@ func (r *resourceDemCustomSaasApps) Delete(ctx context.Context, req resource.Del
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectDemCustomSaasApps(ctx, "delete", diags))
 
@@ -250,11 +283,14 @@ func (r *resourceDemCustomSaasApps) Read(ctx context.Context, req resource.ReadR
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectDemCustomSaasApps(ctx, "read", diags))
 
@@ -285,12 +321,14 @@ func (m *resourceDemCustomSaasAppsModel) refreshDemCustomSaasApps(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["alias"]; ok {
 		m.Alias = parseStringValue(v)
 	}
 
 	if v, ok := o["fqdn"]; ok {
-		m.Fqdn = parseStringValue(v)
+		m.Fqdn = fqdnValue{parseStringValue(v)}
 	}
 
 	return diags
@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceDemSpaApplications{}
+var _ resource.ResourceWithUpgradeState = &resourceDemSpaApplications{}
 
 func newResourceDemSpaApplications() resource.Resource {
 	return &resourceDemSpaApplications{}
@@ -31,15 +33,18 @@ type resourceDemSpaApplications struct {
 
 // resourceDemSpaApplicationsModel describes the resource data model.
 type resourceDemSpaApplicationsModel struct {
-	ID                  types.String  `tfsdk:"id"`
-	PrimaryKey          types.String  `tfsdk:"primary_key"`
-	Server              types.String  `tfsdk:"server"`
-	LatencyThreshold    types.Float64 `tfsdk:"latency_threshold"`
-	JitterThreshold     types.Float64 `tfsdk:"jitter_threshold"`
-	PacketlossThreshold types.Float64 `tfsdk:"packetloss_threshold"`
-	Interval            types.Float64 `tfsdk:"interval"`
-	FailTime            types.Float64 `tfsdk:"fail_time"`
-	RecoveryTime        types.Float64 `tfsdk:"recovery_time"`
+	ID                  types.String   `tfsdk:"id"`
+	PrimaryKey          types.String   `tfsdk:"primary_key"`
+	Server              types.String   `tfsdk:"server"`
+	LatencyThreshold    types.Int64    `tfsdk:"latency_threshold"`
+	JitterThreshold     types.Int64    `tfsdk:"jitter_threshold"`
+	PacketlossThreshold types.Int64    `tfsdk:"packetloss_threshold"`
+	Interval            types.Int64    `tfsdk:"interval"`
+	FailTime            types.Int64    `tfsdk:"fail_time"`
+	RecoveryTime        types.Int64    `tfsdk:"recovery_time"`
+	AdoptExisting       types.Bool     `tfsdk:"adopt_existing"`
+	RawJson             types.String   `tfsdk:"raw_json"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceDemSpaApplications) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +53,7 @@ func (r *resourceDemSpaApplications) Metadata(ctx context.Context, req resource.
 
 func (r *resourceDemSpaApplications) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -56,6 +62,8 @@ func (r *resourceDemSpaApplications) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -69,49 +77,52 @@ func (r *resourceDemSpaApplications) Schema(ctx context.Context, req resource.Sc
 				Computed: true,
 				Optional: true,
 			},
-			"latency_threshold": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(10000000),
+			"latency_threshold": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10000000),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"jitter_threshold": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(10000000),
+			"jitter_threshold": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10000000),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"packetloss_threshold": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(100),
+			"packetloss_threshold": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"interval": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(20, 3600000),
+			"interval": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(20, 3600000),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"fail_time": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 3600),
+			"fail_time": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3600),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"recovery_time": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 3600),
+			"recovery_time": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3600),
 				},
 				Computed: true,
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -137,6 +148,13 @@ func (r *resourceDemSpaApplications) Configure(ctx context.Context, req resource
 	r.resourceName = "fortisase_dem_spa_applications"
 }
 
+func (r *resourceDemSpaApplications) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceDemSpaApplications) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("DemSpaApplications")
 	lock.Lock()
@@ -149,9 +167,12 @@ func (r *resourceDemSpaApplications) Create(ctx context.Context, req resource.Cr
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectDemSpaApplications(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectDemSpaApplications(ctx, "create", diags))
 
@@ -159,6 +180,17 @@ func (r *resourceDemSpaApplications) Create(ctx context.Context, req resource.Cr
 		return
 	}
 	output, err := c.CreateDemSpaApplications(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectDemSpaApplications(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectDemSpaApplications(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadDemSpaApplications, c.UpdateDemSpaApplications, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -170,10 +202,11 @@ func (r *resourceDemSpaApplications) Create(ctx context.Context, req resource.Cr
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectDemSpaApplications(ctx, "read", diags))
 
-	read_output, err := c.ReadDemSpaApplications(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadDemSpaApplications, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -208,14 +241,18 @@ func (r *resourceDemSpaApplications) Update(ctx context.Context, req resource.Up
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectDemSpaApplications(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectDemSpaApplications(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -231,10 +268,11 @@ func (r *resourceDemSpaApplications) Update(ctx context.Context, req resource.Up
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectDemSpaApplications(ctx, "read", diags))
 
-	read_output, err := c.ReadDemSpaApplications(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadDemSpaApplications, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -264,11 +302,14 @@ func (r *resourceDemSpaApplications) Delete(ctx context.Context, req resource.De
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectDemSpaApplications(ctx, "delete", diags))
 
@@ -292,11 +333,14 @@ func (r *resourceDemSpaApplications) Read(ctx context.Context, req resource.Read
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectDemSpaApplications(ctx, "read", diags))
 
@@ -327,32 +371,34 @@ func (m *resourceDemSpaApplicationsModel) refreshDemSpaApplications(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["server"]; ok {
 		m.Server = parseStringValue(v)
 	}
 
 	if v, ok := o["latencyThreshold"]; ok {
-		m.LatencyThreshold = parseFloat64Value(v)
+		m.LatencyThreshold = parseInt64Value(v)
 	}
 
 	if v, ok := o["jitterThreshold"]; ok {
-		m.JitterThreshold = parseFloat64Value(v)
+		m.JitterThreshold = parseInt64Value(v)
 	}
 
 	if v, ok := o["packetlossThreshold"]; ok {
-		m.PacketlossThreshold = parseFloat64Value(v)
+		m.PacketlossThreshold = parseInt64Value(v)
 	}
 
 	if v, ok := o["interval"]; ok {
-		m.Interval = parseFloat64Value(v)
+		m.Interval = parseInt64Value(v)
 	}
 
 	if v, ok := o["failTime"]; ok {
-		m.FailTime = parseFloat64Value(v)
+		m.FailTime = parseInt64Value(v)
 	}
 
 	if v, ok := o["recoveryTime"]; ok {
-		m.RecoveryTime = parseFloat64Value(v)
+		m.RecoveryTime = parseInt64Value(v)
 	}
 
 	return diags
@@ -369,27 +415,27 @@ func (data *resourceDemSpaApplicationsModel) getCreateObjectDemSpaApplications(c
 	}
 
 	if !data.LatencyThreshold.IsNull() {
-		result["latencyThreshold"] = data.LatencyThreshold.ValueFloat64()
+		result["latencyThreshold"] = data.LatencyThreshold.ValueInt64()
 	}
 
 	if !data.JitterThreshold.IsNull() {
-		result["jitterThreshold"] = data.JitterThreshold.ValueFloat64()
+		result["jitterThreshold"] = data.JitterThreshold.ValueInt64()
 	}
 
 	if !data.PacketlossThreshold.IsNull() {
-		result["packetlossThreshold"] = data.PacketlossThreshold.ValueFloat64()
+		result["packetlossThreshold"] = data.PacketlossThreshold.ValueInt64()
 	}
 
 	if !data.Interval.IsNull() {
-		result["interval"] = data.Interval.ValueFloat64()
+		result["interval"] = data.Interval.ValueInt64()
 	}
 
 	if !data.FailTime.IsNull() {
-		result["failTime"] = data.FailTime.ValueFloat64()
+		result["failTime"] = data.FailTime.ValueInt64()
 	}
 
 	if !data.RecoveryTime.IsNull() {
-		result["recoveryTime"] = data.RecoveryTime.ValueFloat64()
+		result["recoveryTime"] = data.RecoveryTime.ValueInt64()
 	}
 
 	return &result
@@ -406,27 +452,27 @@ func (data *resourceDemSpaApplicationsModel) getUpdateObjectDemSpaApplications(c
 	}
 
 	if !data.LatencyThreshold.IsNull() {
-		result["latencyThreshold"] = data.LatencyThreshold.ValueFloat64()
+		result["latencyThreshold"] = data.LatencyThreshold.ValueInt64()
 	}
 
 	if !data.JitterThreshold.IsNull() {
-		result["jitterThreshold"] = data.JitterThreshold.ValueFloat64()
+		result["jitterThreshold"] = data.JitterThreshold.ValueInt64()
 	}
 
 	if !data.PacketlossThreshold.IsNull() {
-		result["packetlossThreshold"] = data.PacketlossThreshold.ValueFloat64()
+		result["packetlossThreshold"] = data.PacketlossThreshold.ValueInt64()
 	}
 
 	if !data.Interval.IsNull() {
-		result["interval"] = data.Interval.ValueFloat64()
+		result["interval"] = data.Interval.ValueInt64()
 	}
 
 	if !data.FailTime.IsNull() {
-		result["failTime"] = data.FailTime.ValueFloat64()
+		result["failTime"] = data.FailTime.ValueInt64()
 	}
 
 	if !data.RecoveryTime.IsNull() {
-		result["recoveryTime"] = data.RecoveryTime.ValueFloat64()
+		result["recoveryTime"] = data.RecoveryTime.ValueInt64()
 	}
 
 	return &result
@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointConnectionProfiles{}
+var _ resource.ResourceWithModifyPlan = &resourceEndpointConnectionProfiles{}
+var _ resource.ResourceWithUpgradeState = &resourceEndpointConnectionProfiles{}
 
 func newResourceEndpointConnectionProfiles() resource.Resource {
 	return &resourceEndpointConnectionProfiles{}
@@ -44,12 +47,15 @@ type resourceEndpointConnectionProfilesModel struct {
 	PreferredDtlsTunnel            types.String                                                 `tfsdk:"preferred_dtls_tunnel"`
 	UseGuiSamlAuth                 types.String                                                 `tfsdk:"use_gui_saml_auth"`
 	AllowPersonalVpns              types.Bool                                                   `tfsdk:"allow_personal_vpns"`
-	MtuSize                        types.Float64                                                `tfsdk:"mtu_size"`
+	MtuSize                        types.Int64                                                  `tfsdk:"mtu_size"`
 	AvailableVpNs                  []resourceEndpointConnectionProfilesAvailableVpNsModel       `tfsdk:"available_vp_ns"`
 	ShowDisconnectBtn              types.String                                                 `tfsdk:"show_disconnect_btn"`
 	EnableInvalidServerCertWarning types.String                                                 `tfsdk:"enable_invalid_server_cert_warning"`
 	PreLogon                       *resourceEndpointConnectionProfilesPreLogonModel             `tfsdk:"pre_logon"`
 	PrimaryKey                     types.String                                                 `tfsdk:"primary_key"`
+	RestoreOnDestroy               types.Bool                                                   `tfsdk:"restore_on_destroy"`
+	RawJson                        types.String                                                 `tfsdk:"raw_json"`
+	Timeouts                       timeouts.Value                                               `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointConnectionProfiles) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +64,7 @@ func (r *resourceEndpointConnectionProfiles) Metadata(ctx context.Context, req r
 
 func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -106,9 +113,9 @@ func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req res
 				Computed: true,
 				Optional: true,
 			},
-			"mtu_size": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(576, 1500),
+			"mtu_size": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(576, 1500),
 				},
 				Computed: true,
 				Optional: true,
@@ -127,6 +134,8 @@ func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req res
 				Computed: true,
 				Optional: true,
 			},
+			"restore_on_destroy": restoreOnDestroyAttribute(),
+			"raw_json":           rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
 				Required:            true,
@@ -143,13 +152,16 @@ func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req res
 						Computed: true,
 						Optional: true,
 					},
-					"grace_period": schema.Float64Attribute{
+					"grace_period": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
 						Computed: true,
 						Optional: true,
 					},
-					"max_attempts": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.AtLeast(1),
+					"max_attempts": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
 						},
 						Computed: true,
 						Optional: true,
@@ -485,16 +497,16 @@ func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req res
 							Computed: true,
 							Optional: true,
 						},
-						"udp_port": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(500, 65535),
+						"udp_port": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(500, 65535),
 							},
 							Computed: true,
 							Optional: true,
 						},
-						"tcp_port": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 65535),
+						"tcp_port": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
 							},
 							Computed: true,
 							Optional: true,
@@ -506,9 +518,9 @@ func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req res
 							Computed: true,
 							Optional: true,
 						},
-						"port": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.AtMost(65535),
+						"port": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
 							},
 							Computed: true,
 							Optional: true,
@@ -535,8 +547,9 @@ func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req res
 							Optional: true,
 						},
 						"pre_shared_key": schema.StringAttribute{
-							Computed: true,
-							Optional: true,
+							Sensitive: true,
+							Computed:  true,
+							Optional:  true,
 						},
 						"posture_check": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
@@ -577,9 +590,9 @@ func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req res
 						Computed: true,
 						Optional: true,
 					},
-					"port": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.AtMost(65535),
+					"port": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(0, 65535),
 						},
 						Computed: true,
 						Optional: true,
@@ -629,6 +642,9 @@ func (r *resourceEndpointConnectionProfiles) Schema(ctx context.Context, req res
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -654,6 +670,17 @@ func (r *resourceEndpointConnectionProfiles) Configure(ctx context.Context, req
 	r.resourceName = "fortisase_endpoint_connection_profiles"
 }
 
+func (r *resourceEndpointConnectionProfiles) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceEndpointConnectionProfiles) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceEndpointConnectionProfiles) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("EndpointConnectionProfiles")
 	lock.Lock()
@@ -663,13 +690,32 @@ func (r *resourceEndpointConnectionProfiles) Create(ctx context.Context, req res
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
+
+	c := r.fortiClient.Client
+	// Capture the object before updating it, it is restored when the resource is destroyed
+	var baseline_input_model forticlient.InputModel
+	baseline_input_model.Ctx = ctx
+	baseline_input_model.Mkey = data.PrimaryKey.ValueString()
+	baseline_input_model.URLParams = *(data.getURLObjectEndpointConnectionProfiles(ctx, "read", diags))
+	baseline, err := c.ReadEndpointConnectionProfiles(&baseline_input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&baseline_input_model, baseline),
+		)
+		return
+	}
 
 	for i := 0; i < 3; i++ {
 		c := r.fortiClient.Client
 		var input_model forticlient.InputModel
+		input_model.Ctx = ctx
 		input_model.Mkey = data.PrimaryKey.ValueString()
 		input_model.BodyParams = *(data.getCreateObjectEndpointConnectionProfiles(ctx, diags))
 		input_model.URLParams = *(data.getURLObjectEndpointConnectionProfiles(ctx, "create", diags))
@@ -689,10 +735,11 @@ func (r *resourceEndpointConnectionProfiles) Create(ctx context.Context, req res
 		mkey := fmt.Sprintf("%v", output["primaryKey"])
 		data.ID = types.StringValue(mkey)
 		var read_input_model forticlient.InputModel
+		read_input_model.Ctx = ctx
 		read_input_model.Mkey = mkey
 		read_input_model.URLParams = *(data.getURLObjectEndpointConnectionProfiles(ctx, "read", diags))
 
-		read_output, err := c.ReadEndpointConnectionProfiles(&read_input_model)
+		read_output, err := readAfterWrite(ctx, c.ReadEndpointConnectionProfiles, &read_input_model, input_model.BodyParams, output, diags)
 		if err != nil {
 			diags.AddError(
 				fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -715,6 +762,7 @@ func (r *resourceEndpointConnectionProfiles) Create(ctx context.Context, req res
 		}
 		break
 	}
+	diags.Append(saveBaseline(ctx, resp.Private, baseline)...)
 	diags.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -733,17 +781,22 @@ func (r *resourceEndpointConnectionProfiles) Update(ctx context.Context, req res
 
 	var data resourceEndpointConnectionProfilesModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointConnectionProfiles(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointConnectionProfiles(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -759,10 +812,11 @@ func (r *resourceEndpointConnectionProfiles) Update(ctx context.Context, req res
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointConnectionProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointConnectionProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointConnectionProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -791,20 +845,26 @@ func (r *resourceEndpointConnectionProfiles) Delete(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
-	result_model := make(map[string]interface{})
-	result_model["onFabricRuleSet"] = nil
-	secureInternetAccess := make(map[string]interface{})
-	postureTag := make(map[string]interface{})
-	postureTag["tag"] = ""
-	postureTag["action"] = "allow"
-	postureTag["checkFailedMessage"] = ""
-	secureInternetAccess["postureCheck"] = postureTag
-	result_model["secureInternetAccess"] = secureInternetAccess
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, diags)
+	defer cancel()
+	if !isRestoreOnDestroy(state.RestoreOnDestroy) {
+		return
+	}
+
+	// Restore the baseline captured on create, or reset the rules when there is none
+	result_model := loadBaseline(ctx, req.Private, diags)
+	if diags.HasError() {
+		return
+	}
+	if result_model == nil {
+		result_model = resetEndpointConnectionProfiles()
+	}
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = result_model
 	input_model.URLParams = *(state.getURLObjectEndpointConnectionProfiles(ctx, "delete", diags))
@@ -823,6 +883,21 @@ func (r *resourceEndpointConnectionProfiles) Delete(ctx context.Context, req res
 	}
 }
 
+// resetEndpointConnectionProfiles returns the request body removing the on-fabric rules and the posture check,
+// used on destroy when no baseline was captured.
+func resetEndpointConnectionProfiles() map[string]interface{} {
+	result_model := make(map[string]interface{})
+	result_model["onFabricRuleSet"] = nil
+	secureInternetAccess := make(map[string]interface{})
+	postureTag := make(map[string]interface{})
+	postureTag["tag"] = ""
+	postureTag["action"] = "allow"
+	postureTag["checkFailedMessage"] = ""
+	secureInternetAccess["postureCheck"] = postureTag
+	result_model["secureInternetAccess"] = secureInternetAccess
+	return result_model
+}
+
 func (r *resourceEndpointConnectionProfiles) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	diags := &resp.Diagnostics
 	var data resourceEndpointConnectionProfilesModel
@@ -833,11 +908,14 @@ func (r *resourceEndpointConnectionProfiles) Read(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointConnectionProfiles(ctx, "read", diags))
 
@@ -860,6 +938,7 @@ func (r *resourceEndpointConnectionProfiles) Read(ctx context.Context, req resou
 
 func (r *resourceEndpointConnectionProfiles) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
 }
 
 func (m *resourceEndpointConnectionProfilesModel) refreshEndpointConnectionProfiles(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
@@ -868,6 +947,8 @@ func (m *resourceEndpointConnectionProfilesModel) refreshEndpointConnectionProfi
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["connectToFortiSASE"]; ok {
 		m.ConnectToFortiSase = parseStringValue(v)
 	}
@@ -913,7 +994,7 @@ func (m *resourceEndpointConnectionProfilesModel) refreshEndpointConnectionProfi
 	}
 
 	if v, ok := o["mtuSize"]; ok {
-		m.MtuSize = parseFloat64Value(v)
+		m.MtuSize = parseInt64Value(v)
 	}
 
 	if v, ok := o["availableVPNs"]; ok {
@@ -987,7 +1068,7 @@ func (data *resourceEndpointConnectionProfilesModel) getCreateObjectEndpointConn
 	}
 
 	if !data.MtuSize.IsNull() {
-		result["mtuSize"] = data.MtuSize.ValueFloat64()
+		result["mtuSize"] = data.MtuSize.ValueInt64()
 	}
 
 	if data.AvailableVpNs != nil {
@@ -1061,7 +1142,7 @@ func (data *resourceEndpointConnectionProfilesModel) getUpdateObjectEndpointConn
 	}
 
 	if !data.MtuSize.IsNull() {
-		result["mtuSize"] = data.MtuSize.ValueFloat64()
+		result["mtuSize"] = data.MtuSize.ValueInt64()
 	}
 
 	if data.AvailableVpNs != nil {
@@ -1094,8 +1175,8 @@ func (data *resourceEndpointConnectionProfilesModel) getURLObjectEndpointConnect
 
 type resourceEndpointConnectionProfilesLockdownModel struct {
 	Status              types.String                                                        `tfsdk:"status"`
-	GracePeriod         types.Float64                                                       `tfsdk:"grace_period"`
-	MaxAttempts         types.Float64                                                       `tfsdk:"max_attempts"`
+	GracePeriod         types.Int64                                                         `tfsdk:"grace_period"`
+	MaxAttempts         types.Int64                                                         `tfsdk:"max_attempts"`
 	Ips                 []resourceEndpointConnectionProfilesLockdownIpsModel                `tfsdk:"ips"`
 	Domains             []resourceEndpointConnectionProfilesLockdownDomainsModel            `tfsdk:"domains"`
 	DetectCaptivePortal *resourceEndpointConnectionProfilesLockdownDetectCaptivePortalModel `tfsdk:"detect_captive_portal"`
@@ -1184,10 +1265,10 @@ type resourceEndpointConnectionProfilesAvailableVpNsModel struct {
 	AllowFidoAuth            types.String                                                      `tfsdk:"allow_fido_auth"`
 	EnableLocalLan           types.String                                                      `tfsdk:"enable_local_lan"`
 	EncapsulationMode        types.String                                                      `tfsdk:"encapsulation_mode"`
-	UdpPort                  types.Float64                                                     `tfsdk:"udp_port"`
-	TcpPort                  types.Float64                                                     `tfsdk:"tcp_port"`
+	UdpPort                  types.Int64                                                       `tfsdk:"udp_port"`
+	TcpPort                  types.Int64                                                       `tfsdk:"tcp_port"`
 	ExternalBrowserSamlLogin types.String                                                      `tfsdk:"external_browser_saml_login"`
-	Port                     types.Float64                                                     `tfsdk:"port"`
+	Port                     types.Int64                                                       `tfsdk:"port"`
 	RequireCertificate       types.String                                                      `tfsdk:"require_certificate"`
 	AuthMethod               types.String                                                      `tfsdk:"auth_method"`
 	ShowPasscode             types.String                                                      `tfsdk:"show_passcode"`
@@ -1206,7 +1287,7 @@ type resourceEndpointConnectionProfilesPreLogonModel struct {
 	RemoteGateway types.String                                               `tfsdk:"remote_gateway"`
 	CommonName    *resourceEndpointConnectionProfilesPreLogonCommonNameModel `tfsdk:"common_name"`
 	Issuer        *resourceEndpointConnectionProfilesPreLogonIssuerModel     `tfsdk:"issuer"`
-	Port          types.Float64                                              `tfsdk:"port"`
+	Port          types.Int64                                                `tfsdk:"port"`
 }
 
 type resourceEndpointConnectionProfilesPreLogonCommonNameModel struct {
@@ -1232,11 +1313,11 @@ func (m *resourceEndpointConnectionProfilesLockdownModel) flattenEndpointConnect
 	}
 
 	if v, ok := o["gracePeriod"]; ok {
-		m.GracePeriod = parseFloat64Value(v)
+		m.GracePeriod = parseInt64Value(v)
 	}
 
 	if v, ok := o["maxAttempts"]; ok {
-		m.MaxAttempts = parseFloat64Value(v)
+		m.MaxAttempts = parseInt64Value(v)
 	}
 
 	if v, ok := o["ips"]; ok {
@@ -1717,11 +1798,11 @@ func (m *resourceEndpointConnectionProfilesAvailableVpNsModel) flattenEndpointCo
 	}
 
 	if v, ok := o["udpPort"]; ok {
-		m.UdpPort = parseFloat64Value(v)
+		m.UdpPort = parseInt64Value(v)
 	}
 
 	if v, ok := o["tcpPort"]; ok {
-		m.TcpPort = parseFloat64Value(v)
+		m.TcpPort = parseInt64Value(v)
 	}
 
 	if v, ok := o["externalBrowserSamlLogin"]; ok {
@@ -1729,7 +1810,7 @@ func (m *resourceEndpointConnectionProfilesAvailableVpNsModel) flattenEndpointCo
 	}
 
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	if v, ok := o["requireCertificate"]; ok {
@@ -1827,7 +1908,7 @@ func (m *resourceEndpointConnectionProfilesPreLogonModel) flattenEndpointConnect
 	}
 
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	return m
@@ -1878,11 +1959,11 @@ func (data *resourceEndpointConnectionProfilesLockdownModel) expandEndpointConne
 	}
 
 	if !data.GracePeriod.IsNull() {
-		result["gracePeriod"] = data.GracePeriod.ValueFloat64()
+		result["gracePeriod"] = data.GracePeriod.ValueInt64()
 	}
 
 	if !data.MaxAttempts.IsNull() {
-		result["maxAttempts"] = data.MaxAttempts.ValueFloat64()
+		result["maxAttempts"] = data.MaxAttempts.ValueInt64()
 	}
 
 	if data.Ips != nil {
@@ -2191,11 +2272,11 @@ func (data *resourceEndpointConnectionProfilesAvailableVpNsModel) expandEndpoint
 	}
 
 	if !data.UdpPort.IsNull() {
-		result["udpPort"] = data.UdpPort.ValueFloat64()
+		result["udpPort"] = data.UdpPort.ValueInt64()
 	}
 
 	if !data.TcpPort.IsNull() {
-		result["tcpPort"] = data.TcpPort.ValueFloat64()
+		result["tcpPort"] = data.TcpPort.ValueInt64()
 	}
 
 	if !data.ExternalBrowserSamlLogin.IsNull() {
@@ -2203,7 +2284,7 @@ func (data *resourceEndpointConnectionProfilesAvailableVpNsModel) expandEndpoint
 	}
 
 	if !data.Port.IsNull() {
-		result["port"] = data.Port.ValueFloat64()
+		result["port"] = data.Port.ValueInt64()
 	}
 
 	if !data.RequireCertificate.IsNull() {
@@ -2273,7 +2354,7 @@ func (data *resourceEndpointConnectionProfilesPreLogonModel) expandEndpointConne
 	}
 
 	if !data.Port.IsNull() {
-		result["port"] = data.Port.ValueFloat64()
+		result["port"] = data.Port.ValueInt64()
 	}
 
 	return result
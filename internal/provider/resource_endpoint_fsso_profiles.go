@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointFssoProfiles{}
+var _ resource.ResourceWithUpgradeState = &resourceEndpointFssoProfiles{}
 
 func newResourceEndpointFssoProfiles() resource.Resource {
 	return &resourceEndpointFssoProfiles{}
@@ -31,13 +33,15 @@ type resourceEndpointFssoProfiles struct {
 
 // resourceEndpointFssoProfilesModel describes the resource data model.
 type resourceEndpointFssoProfilesModel struct {
-	ID            types.String  `tfsdk:"id"`
-	Enabled       types.Bool    `tfsdk:"enabled"`
-	PreferEntraId types.String  `tfsdk:"prefer_entra_id"`
-	Host          types.String  `tfsdk:"host"`
-	Port          types.Float64 `tfsdk:"port"`
-	PreSharedKey  types.String  `tfsdk:"pre_shared_key"`
-	PrimaryKey    types.String  `tfsdk:"primary_key"`
+	ID            types.String   `tfsdk:"id"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	PreferEntraId types.String   `tfsdk:"prefer_entra_id"`
+	Host          types.String   `tfsdk:"host"`
+	Port          types.Int64    `tfsdk:"port"`
+	PreSharedKey  types.String   `tfsdk:"pre_shared_key"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	RawJson       types.String   `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointFssoProfiles) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,6 +50,7 @@ func (r *resourceEndpointFssoProfiles) Metadata(ctx context.Context, req resourc
 
 func (r *resourceEndpointFssoProfiles) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -69,17 +74,19 @@ func (r *resourceEndpointFssoProfiles) Schema(ctx context.Context, req resource.
 				Computed: true,
 				Optional: true,
 			},
-			"port": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(65535),
+			"port": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
 				},
 				Computed: true,
 				Optional: true,
 			},
 			"pre_shared_key": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
 				Required:            true,
@@ -88,6 +95,9 @@ func (r *resourceEndpointFssoProfiles) Schema(ctx context.Context, req resource.
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -113,6 +123,13 @@ func (r *resourceEndpointFssoProfiles) Configure(ctx context.Context, req resour
 	r.resourceName = "fortisase_endpoint_fsso_profiles"
 }
 
+func (r *resourceEndpointFssoProfiles) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceEndpointFssoProfiles) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("EndpointFssoProfiles")
 	lock.Lock()
@@ -125,9 +142,12 @@ func (r *resourceEndpointFssoProfiles) Create(ctx context.Context, req resource.
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectEndpointFssoProfiles(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointFssoProfiles(ctx, "create", diags))
@@ -147,10 +167,11 @@ func (r *resourceEndpointFssoProfiles) Create(ctx context.Context, req resource.
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointFssoProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointFssoProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointFssoProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -185,14 +206,18 @@ func (r *resourceEndpointFssoProfiles) Update(ctx context.Context, req resource.
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointFssoProfiles(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointFssoProfiles(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -208,10 +233,11 @@ func (r *resourceEndpointFssoProfiles) Update(ctx context.Context, req resource.
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointFssoProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointFssoProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointFssoProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -242,11 +268,14 @@ func (r *resourceEndpointFssoProfiles) Read(ctx context.Context, req resource.Re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointFssoProfiles(ctx, "read", diags))
 
@@ -277,6 +306,8 @@ func (m *resourceEndpointFssoProfilesModel) refreshEndpointFssoProfiles(ctx cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
@@ -290,7 +321,7 @@ func (m *resourceEndpointFssoProfilesModel) refreshEndpointFssoProfiles(ctx cont
 	}
 
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	if v, ok := o["preSharedKey"]; ok {
@@ -315,7 +346,7 @@ func (data *resourceEndpointFssoProfilesModel) getCreateObjectEndpointFssoProfil
 	}
 
 	if !data.Port.IsNull() {
-		result["port"] = data.Port.ValueFloat64()
+		result["port"] = data.Port.ValueInt64()
 	}
 
 	if !data.PreSharedKey.IsNull() {
@@ -340,7 +371,7 @@ func (data *resourceEndpointFssoProfilesModel) getUpdateObjectEndpointFssoProfil
 	}
 
 	if !data.Port.IsNull() {
-		result["port"] = data.Port.ValueFloat64()
+		result["port"] = data.Port.ValueInt64()
 	}
 
 	if !data.PreSharedKey.IsNull() {
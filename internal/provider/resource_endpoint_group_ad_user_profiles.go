@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,10 +29,12 @@ type resourceEndpointGroupAdUserProfiles struct {
 
 // resourceEndpointGroupAdUserProfilesModel describes the resource data model.
 type resourceEndpointGroupAdUserProfilesModel struct {
-	ID         types.String `tfsdk:"id"`
-	AdUserIds  types.Set    `tfsdk:"ad_user_ids"`
-	GroupIds   types.Set    `tfsdk:"group_ids"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
+	ID         types.String   `tfsdk:"id"`
+	AdUserIds  types.Set      `tfsdk:"ad_user_ids"`
+	GroupIds   types.Set      `tfsdk:"group_ids"`
+	PrimaryKey types.String   `tfsdk:"primary_key"`
+	RawJson    types.String   `tfsdk:"raw_json"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointGroupAdUserProfiles) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +61,7 @@ func (r *resourceEndpointGroupAdUserProfiles) Schema(ctx context.Context, req re
 				Optional:    true,
 				ElementType: types.Int64Type,
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
 				Required:            true,
@@ -66,6 +70,9 @@ func (r *resourceEndpointGroupAdUserProfiles) Schema(ctx context.Context, req re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -103,9 +110,12 @@ func (r *resourceEndpointGroupAdUserProfiles) Create(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectEndpointGroupAdUserProfiles(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointGroupAdUserProfiles(ctx, "create", diags))
@@ -125,10 +135,11 @@ func (r *resourceEndpointGroupAdUserProfiles) Create(ctx context.Context, req re
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointGroupAdUserProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointGroupAdUserProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointGroupAdUserProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -163,14 +174,18 @@ func (r *resourceEndpointGroupAdUserProfiles) Update(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointGroupAdUserProfiles(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointGroupAdUserProfiles(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -186,10 +201,11 @@ func (r *resourceEndpointGroupAdUserProfiles) Update(ctx context.Context, req re
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointGroupAdUserProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointGroupAdUserProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointGroupAdUserProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -220,11 +236,14 @@ func (r *resourceEndpointGroupAdUserProfiles) Read(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointGroupAdUserProfiles(ctx, "read", diags))
 
@@ -255,6 +274,8 @@ func (m *resourceEndpointGroupAdUserProfilesModel) refreshEndpointGroupAdUserPro
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["adUserIds"]; ok {
 		m.AdUserIds = parseSetValue(ctx, v, types.Int64Type)
 	}
@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointGroupInvitationCodes{}
+var _ resource.ResourceWithUpgradeState = &resourceEndpointGroupInvitationCodes{}
 
 func newResourceEndpointGroupInvitationCodes() resource.Resource {
 	return &resourceEndpointGroupInvitationCodes{}
@@ -35,6 +37,9 @@ type resourceEndpointGroupInvitationCodesModel struct {
 	PrimaryKey      types.String                                              `tfsdk:"primary_key"`
 	ExpireDate      types.String                                              `tfsdk:"expire_date"`
 	GroupAssignment *resourceEndpointGroupInvitationCodesGroupAssignmentModel `tfsdk:"group_assignment"`
+	AdoptExisting   types.Bool                                                `tfsdk:"adopt_existing"`
+	RawJson         types.String                                              `tfsdk:"raw_json"`
+	Timeouts        timeouts.Value                                            `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointGroupInvitationCodes) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,6 +48,7 @@ func (r *resourceEndpointGroupInvitationCodes) Metadata(ctx context.Context, req
 
 func (r *resourceEndpointGroupInvitationCodes) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -51,6 +57,8 @@ func (r *resourceEndpointGroupInvitationCodes) Schema(ctx context.Context, req r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -69,9 +77,9 @@ func (r *resourceEndpointGroupInvitationCodes) Schema(ctx context.Context, req r
 					},
 					"group": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
-							"id": schema.Float64Attribute{
-								Validators: []validator.Float64{
-									float64validator.AtLeast(1),
+							"id": schema.Int64Attribute{
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
 								},
 								Computed: true,
 								Optional: true,
@@ -92,6 +100,9 @@ func (r *resourceEndpointGroupInvitationCodes) Schema(ctx context.Context, req r
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -117,6 +128,13 @@ func (r *resourceEndpointGroupInvitationCodes) Configure(ctx context.Context, re
 	r.resourceName = "fortisase_endpoint_group_invitation_codes"
 }
 
+func (r *resourceEndpointGroupInvitationCodes) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceEndpointGroupInvitationCodes) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("EndpointGroupInvitationCodes")
 	lock.Lock()
@@ -129,9 +147,12 @@ func (r *resourceEndpointGroupInvitationCodes) Create(ctx context.Context, req r
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointGroupInvitationCodes(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointGroupInvitationCodes(ctx, "create", diags))
 
@@ -139,6 +160,17 @@ func (r *resourceEndpointGroupInvitationCodes) Create(ctx context.Context, req r
 		return
 	}
 	output, err := c.CreateEndpointGroupInvitationCodes(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectEndpointGroupInvitationCodes(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectEndpointGroupInvitationCodes(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadEndpointGroupInvitationCodes, c.UpdateEndpointGroupInvitationCodes, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -150,10 +182,11 @@ func (r *resourceEndpointGroupInvitationCodes) Create(ctx context.Context, req r
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointGroupInvitationCodes(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointGroupInvitationCodes(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointGroupInvitationCodes, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -188,14 +221,18 @@ func (r *resourceEndpointGroupInvitationCodes) Update(ctx context.Context, req r
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointGroupInvitationCodes(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointGroupInvitationCodes(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -211,10 +248,11 @@ func (r *resourceEndpointGroupInvitationCodes) Update(ctx context.Context, req r
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointGroupInvitationCodes(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointGroupInvitationCodes(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointGroupInvitationCodes, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -244,11 +282,14 @@ func (r *resourceEndpointGroupInvitationCodes) Delete(ctx context.Context, req r
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointGroupInvitationCodes(ctx, "delete", diags))
 
@@ -272,11 +313,14 @@ func (r *resourceEndpointGroupInvitationCodes) Read(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointGroupInvitationCodes(ctx, "read", diags))
 
@@ -307,6 +351,8 @@ func (m *resourceEndpointGroupInvitationCodesModel) refreshEndpointGroupInvitati
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["expireDate"]; ok {
 		m.ExpireDate = parseStringValue(v)
 	}
@@ -367,8 +413,8 @@ type resourceEndpointGroupInvitationCodesGroupAssignmentModel struct {
 }
 
 type resourceEndpointGroupInvitationCodesGroupAssignmentGroupModel struct {
-	Id   types.Float64 `tfsdk:"id"`
-	Path types.String  `tfsdk:"path"`
+	Id   types.Int64  `tfsdk:"id"`
+	Path types.String `tfsdk:"path"`
 }
 
 func (m *resourceEndpointGroupInvitationCodesGroupAssignmentModel) flattenEndpointGroupInvitationCodesGroupAssignment(ctx context.Context, input interface{}, diags *diag.Diagnostics) *resourceEndpointGroupInvitationCodesGroupAssignmentModel {
@@ -399,7 +445,7 @@ func (m *resourceEndpointGroupInvitationCodesGroupAssignmentGroupModel) flattenE
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["path"]; ok {
@@ -425,7 +471,7 @@ func (data *resourceEndpointGroupInvitationCodesGroupAssignmentModel) expandEndp
 func (data *resourceEndpointGroupInvitationCodesGroupAssignmentGroupModel) expandEndpointGroupInvitationCodesGroupAssignmentGroup(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Id.IsNull() {
-		result["id"] = data.Id.ValueFloat64()
+		result["id"] = data.Id.ValueInt64()
 	}
 
 	if !data.Path.IsNull() {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -34,15 +35,18 @@ type resourceEndpointOnNetRulesModel struct {
 	PrimaryKey      types.String                                     `tfsdk:"primary_key"`
 	PublicIp        types.String                                     `tfsdk:"public_ip"`
 	DhcpServerIp    types.String                                     `tfsdk:"dhcp_server_ip"`
-	DhcpServerMac   types.String                                     `tfsdk:"dhcp_server_mac"`
+	DhcpServerMac   macAddressValue                                  `tfsdk:"dhcp_server_mac"`
 	DhcpServerCode  types.String                                     `tfsdk:"dhcp_server_code"`
 	DnsServerIp     types.String                                     `tfsdk:"dns_server_ip"`
 	PingServer      types.String                                     `tfsdk:"ping_server"`
 	LocalIp         types.String                                     `tfsdk:"local_ip"`
-	GatewayMac      types.String                                     `tfsdk:"gateway_mac"`
+	GatewayMac      macAddressValue                                  `tfsdk:"gateway_mac"`
 	WebRequestHttp  types.String                                     `tfsdk:"web_request_http"`
 	WebRequestHttps []resourceEndpointOnNetRulesWebRequestHttpsModel `tfsdk:"web_request_https"`
 	DnsRequest      []resourceEndpointOnNetRulesDnsRequestModel      `tfsdk:"dns_request"`
+	AdoptExisting   types.Bool                                       `tfsdk:"adopt_existing"`
+	RawJson         types.String                                     `tfsdk:"raw_json"`
+	Timeouts        timeouts.Value                                   `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointOnNetRules) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,6 +63,8 @@ func (r *resourceEndpointOnNetRules) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -74,8 +80,9 @@ func (r *resourceEndpointOnNetRules) Schema(ctx context.Context, req resource.Sc
 				Optional: true,
 			},
 			"dhcp_server_mac": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				CustomType: macAddressType{},
+				Computed:   true,
+				Optional:   true,
 			},
 			"dhcp_server_code": schema.StringAttribute{
 				Computed: true,
@@ -94,8 +101,9 @@ func (r *resourceEndpointOnNetRules) Schema(ctx context.Context, req resource.Sc
 				Optional: true,
 			},
 			"gateway_mac": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				CustomType: macAddressType{},
+				Computed:   true,
+				Optional:   true,
 			},
 			"web_request_http": schema.StringAttribute{
 				Computed: true,
@@ -134,6 +142,9 @@ func (r *resourceEndpointOnNetRules) Schema(ctx context.Context, req resource.Sc
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -168,9 +179,12 @@ func (r *resourceEndpointOnNetRules) Create(ctx context.Context, req resource.Cr
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointOnNetRules(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointOnNetRules(ctx, "create", diags))
 
@@ -178,6 +192,17 @@ func (r *resourceEndpointOnNetRules) Create(ctx context.Context, req resource.Cr
 		return
 	}
 	output, err := c.CreateEndpointOnNetRules(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectEndpointOnNetRules(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectEndpointOnNetRules(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadEndpointOnNetRules, c.UpdateEndpointOnNetRules, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -189,10 +214,11 @@ func (r *resourceEndpointOnNetRules) Create(ctx context.Context, req resource.Cr
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointOnNetRules(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointOnNetRules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointOnNetRules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -224,14 +250,18 @@ func (r *resourceEndpointOnNetRules) Update(ctx context.Context, req resource.Up
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointOnNetRules(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointOnNetRules(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -247,10 +277,11 @@ func (r *resourceEndpointOnNetRules) Update(ctx context.Context, req resource.Up
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointOnNetRules(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointOnNetRules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointOnNetRules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -277,11 +308,14 @@ func (r *resourceEndpointOnNetRules) Delete(ctx context.Context, req resource.De
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointOnNetRules(ctx, "delete", diags))
 
@@ -305,11 +339,14 @@ func (r *resourceEndpointOnNetRules) Read(ctx context.Context, req resource.Read
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointOnNetRules(ctx, "read", diags))
 
@@ -340,6 +377,8 @@ func (m *resourceEndpointOnNetRulesModel) refreshEndpointOnNetRules(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["publicIp"]; ok {
 		m.PublicIp = parseStringValue(v)
 	}
@@ -349,7 +388,7 @@ func (m *resourceEndpointOnNetRulesModel) refreshEndpointOnNetRules(ctx context.
 	}
 
 	if v, ok := o["dhcpServerMac"]; ok {
-		m.DhcpServerMac = parseStringValue(v)
+		m.DhcpServerMac = macAddressValue{parseStringValue(v)}
 	}
 
 	if v, ok := o["dhcpServerCode"]; ok {
@@ -369,7 +408,7 @@ func (m *resourceEndpointOnNetRulesModel) refreshEndpointOnNetRules(ctx context.
 	}
 
 	if v, ok := o["gatewayMac"]; ok {
-		m.GatewayMac = parseStringValue(v)
+		m.GatewayMac = macAddressValue{parseStringValue(v)}
 	}
 
 	if v, ok := o["webRequestHttp"]; ok {
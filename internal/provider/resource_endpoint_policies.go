@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointPolicies{}
+var _ resource.ResourceWithMoveState = &resourceEndpointPolicies{}
 
 func newResourceEndpointPolicies() resource.Resource {
 	return &resourceEndpointPolicies{}
@@ -30,10 +32,13 @@ type resourceEndpointPolicies struct {
 
 // resourceEndpointPoliciesModel describes the resource data model.
 type resourceEndpointPoliciesModel struct {
-	ID                              types.String `tfsdk:"id"`
-	PrimaryKey                      types.String `tfsdk:"primary_key"`
-	Enabled                         types.Bool   `tfsdk:"enabled"`
-	SkipOffNetProfileCreationOnEdit types.Bool   `tfsdk:"skip_off_net_profile_creation_on_edit"`
+	ID                              types.String   `tfsdk:"id"`
+	PrimaryKey                      types.String   `tfsdk:"primary_key"`
+	Enabled                         types.Bool     `tfsdk:"enabled"`
+	SkipOffNetProfileCreationOnEdit types.Bool     `tfsdk:"skip_off_net_profile_creation_on_edit"`
+	AdoptExisting                   types.Bool     `tfsdk:"adopt_existing"`
+	RawJson                         types.String   `tfsdk:"raw_json"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointPolicies) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +55,8 @@ func (r *resourceEndpointPolicies) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -68,6 +75,9 @@ func (r *resourceEndpointPolicies) Schema(ctx context.Context, req resource.Sche
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -105,9 +115,12 @@ func (r *resourceEndpointPolicies) Create(ctx context.Context, req resource.Crea
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointPolicies(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "create", diags))
 
@@ -115,6 +128,17 @@ func (r *resourceEndpointPolicies) Create(ctx context.Context, req resource.Crea
 		return
 	}
 	output, err := c.CreateEndpointPolicies(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectEndpointPolicies(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadEndpointPolicies, c.UpdateEndpointPolicies, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -126,10 +150,11 @@ func (r *resourceEndpointPolicies) Create(ctx context.Context, req resource.Crea
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointPolicies(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointPolicies, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -164,14 +189,18 @@ func (r *resourceEndpointPolicies) Update(ctx context.Context, req resource.Upda
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointPolicies(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -187,10 +216,11 @@ func (r *resourceEndpointPolicies) Update(ctx context.Context, req resource.Upda
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointPolicies(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointPolicies, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -220,11 +250,14 @@ func (r *resourceEndpointPolicies) Delete(ctx context.Context, req resource.Dele
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "delete", diags))
 
@@ -248,11 +281,14 @@ func (r *resourceEndpointPolicies) Read(ctx context.Context, req resource.ReadRe
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "read", diags))
 
@@ -277,12 +313,20 @@ func (r *resourceEndpointPolicies) ImportState(ctx context.Context, req resource
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *resourceEndpointPolicies) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateFrom("fortisase_endpoint_policies_clone"),
+	}
+}
+
 func (m *resourceEndpointPoliciesModel) refreshEndpointPolicies(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if o == nil {
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
@@ -28,12 +28,10 @@ type resourceEndpointPoliciesClone2Edl struct {
 }
 
 // resourceEndpointPoliciesClone2EdlModel describes the resource data model.
+// The attributes of the cloned object are those of fortisase_endpoint_policies.
 type resourceEndpointPoliciesClone2EdlModel struct {
-	ID                              types.String `tfsdk:"id"`
-	PrimaryKey                      types.String `tfsdk:"primary_key"`
-	Enabled                         types.Bool   `tfsdk:"enabled"`
-	SkipOffNetProfileCreationOnEdit types.Bool   `tfsdk:"skip_off_net_profile_creation_on_edit"`
-	BasedOn                         types.String `tfsdk:"based_on"`
+	resourceEndpointPoliciesModel
+	BasedOn types.String `tfsdk:"based_on"`
 }
 
 func (r *resourceEndpointPoliciesClone2Edl) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,7 +40,7 @@ func (r *resourceEndpointPoliciesClone2Edl) Metadata(ctx context.Context, req re
 
 func (r *resourceEndpointPoliciesClone2Edl) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
+		Attributes: cloneSchemaAttributes(ctx, newResourceEndpointPolicies(), map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -54,8 +52,13 @@ func (r *resourceEndpointPoliciesClone2Edl) Schema(ctx context.Context, req reso
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
 				},
-				Computed: true,
-				Optional: true,
+				MarkdownDescription: "The primary key of the cloned object.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Computed: true,
@@ -69,7 +72,14 @@ func (r *resourceEndpointPoliciesClone2Edl) Schema(ctx context.Context, req reso
 				MarkdownDescription: "The endpoint profile you what to clone.",
 				Computed:            true,
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
+		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -97,17 +107,23 @@ func (r *resourceEndpointPoliciesClone2Edl) Configure(ctx context.Context, req r
 }
 
 func (r *resourceEndpointPoliciesClone2Edl) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	lock := r.fortiClient.GetResourceLock("EndpointPolicies")
+	lock.Lock()
+	defer lock.Unlock()
 	var data resourceEndpointPoliciesClone2EdlModel
 	diags := &resp.Diagnostics
 
 	// Read Terraform config data into the model
-	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(getCloneData(ctx, req.Config, &data)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointPoliciesClone(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointPoliciesClone(ctx, "create", diags))
 
@@ -123,42 +139,56 @@ func (r *resourceEndpointPoliciesClone2Edl) Create(ctx context.Context, req reso
 		return
 	}
 
-	mkey := "EndpointPoliciesClone"
+	mkey := cloneCreatedMkey(output, data.PrimaryKey)
 	data.ID = types.StringValue(mkey)
+	data.PrimaryKey = types.StringValue(mkey)
+
+	diags.Append(r.read(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
 
-	diags.Append(resp.State.Set(ctx, &data)...)
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
 }
 
 func (r *resourceEndpointPoliciesClone2Edl) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	lock := r.fortiClient.GetResourceLock("EndpointPolicies")
+	lock.Lock()
+	defer lock.Unlock()
 	diags := &resp.Diagnostics
 
 	// Read Terraform plan data into the model
 	var state resourceEndpointPoliciesClone2EdlModel
-	diags.Append(req.State.Get(ctx, &state)...)
+	diags.Append(getCloneData(ctx, req.State, &state)...)
 	if diags.HasError() {
 		return
 	}
 
 	var data resourceEndpointPoliciesClone2EdlModel
-	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(getCloneData(ctx, req.Config, &data)...)
 	if diags.HasError() {
 		return
 	}
-	data.ID = state.ID
-
-	mkey := state.ID.ValueString()
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
+	mkey := cloneMkey(state.ID, state.PrimaryKey, "EndpointPoliciesClone")
+	data.ID = types.StringValue(mkey)
+	data.PrimaryKey = types.StringValue(mkey)
+	data.BasedOn = state.BasedOn
 
+	// The cloned object is updated as a fortisase_endpoint_policies resource
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
-	input_model.BodyParams = *(data.getUpdateObjectEndpointPoliciesClone(ctx, state, diags))
-	input_model.URLParams = *(data.getURLObjectEndpointPoliciesClone(ctx, "update", diags))
+	input_model.BodyParams = *(data.getUpdateObjectEndpointPolicies(ctx, state.resourceEndpointPoliciesModel, diags))
+	input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "update", diags))
 
 	if diags.HasError() {
 		return
 	}
 
-	output, err := c.CreateEndpointPoliciesClone(&input_model)
+	output, err := c.UpdateEndpointPolicies(&input_model)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to update resource %s: %v", r.resourceName, err),
@@ -167,35 +197,103 @@ func (r *resourceEndpointPoliciesClone2Edl) Update(ctx context.Context, req reso
 		return
 	}
 
-	diags.Append(resp.State.Set(ctx, &data)...)
+	diags.Append(r.read(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
 }
 
 func (r *resourceEndpointPoliciesClone2Edl) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No delete operation for this resource
+	lock := r.fortiClient.GetResourceLock("EndpointPolicies")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+	var data resourceEndpointPoliciesClone2EdlModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(getCloneData(ctx, req.State, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	mkey := cloneMkey(data.ID, data.PrimaryKey, "EndpointPoliciesClone")
+	if mkey == "" {
+		return
+	}
+	data.PrimaryKey = types.StringValue(mkey)
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+	input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "delete", diags))
+
+	output, err := c.DeleteEndpointPolicies(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
 }
 
 func (r *resourceEndpointPoliciesClone2Edl) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// No read operation for this resource
-}
+	diags := &resp.Diagnostics
+	var data resourceEndpointPoliciesClone2EdlModel
 
-func (data *resourceEndpointPoliciesClone2EdlModel) getCreateObjectEndpointPoliciesClone(ctx context.Context, diags *diag.Diagnostics) *map[string]interface{} {
-	result := make(map[string]interface{})
-	if !data.PrimaryKey.IsNull() {
-		result["primaryKey"] = data.PrimaryKey.ValueString()
+	// Read Terraform prior state data into the model
+	diags.Append(getCloneData(ctx, req.State, &data)...)
+
+	if diags.HasError() {
+		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
-	if !data.Enabled.IsNull() {
-		result["enabled"] = data.Enabled.ValueBool()
+	diags.Append(r.read(ctx, &data)...)
+	if diags.HasError() {
+		return
 	}
 
-	if !data.SkipOffNetProfileCreationOnEdit.IsNull() {
-		result["skipOffNetProfileCreationOnEdit"] = data.SkipOffNetProfileCreationOnEdit.ValueBool()
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
+}
+
+// read refreshes the model from the cloned object, which is read by its primary key.
+func (r *resourceEndpointPoliciesClone2Edl) read(ctx context.Context, data *resourceEndpointPoliciesClone2EdlModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mkey := cloneMkey(data.ID, data.PrimaryKey, "EndpointPoliciesClone")
+	if mkey == "" {
+		return diags
 	}
+	data.ID = types.StringValue(mkey)
+	data.PrimaryKey = types.StringValue(mkey)
 
-	return &result
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+	input_model.URLParams = *(data.getURLObjectEndpointPolicies(ctx, "read", &diags))
+
+	read_output, err := c.ReadEndpointPolicies(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, read_output),
+		)
+		return diags
+	}
+
+	diags.Append(data.refreshEndpointPolicies(ctx, read_output)...)
+	return diags
 }
 
-func (data *resourceEndpointPoliciesClone2EdlModel) getUpdateObjectEndpointPoliciesClone(ctx context.Context, state resourceEndpointPoliciesClone2EdlModel, diags *diag.Diagnostics) *map[string]interface{} {
+func (data *resourceEndpointPoliciesClone2EdlModel) getCreateObjectEndpointPoliciesClone(ctx context.Context, diags *diag.Diagnostics) *map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.PrimaryKey.IsNull() {
 		result["primaryKey"] = data.PrimaryKey.ValueString()
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointProfile{}
+var _ resource.ResourceWithMoveState = &resourceEndpointProfile{}
 
 func newResourceEndpointProfile() resource.Resource {
 	return &resourceEndpointProfile{}
@@ -30,10 +32,13 @@ type resourceEndpointProfile struct {
 
 // resourceEndpointProfileModel describes the resource data model.
 type resourceEndpointProfileModel struct {
-	ID                              types.String `tfsdk:"id"`
-	PrimaryKey                      types.String `tfsdk:"primary_key"`
-	Enabled                         types.Bool   `tfsdk:"enabled"`
-	SkipOffNetProfileCreationOnEdit types.Bool   `tfsdk:"skip_off_net_profile_creation_on_edit"`
+	ID                              types.String   `tfsdk:"id"`
+	PrimaryKey                      types.String   `tfsdk:"primary_key"`
+	Enabled                         types.Bool     `tfsdk:"enabled"`
+	SkipOffNetProfileCreationOnEdit types.Bool     `tfsdk:"skip_off_net_profile_creation_on_edit"`
+	AdoptExisting                   types.Bool     `tfsdk:"adopt_existing"`
+	RawJson                         types.String   `tfsdk:"raw_json"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +55,8 @@ func (r *resourceEndpointProfile) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -65,6 +72,9 @@ func (r *resourceEndpointProfile) Schema(ctx context.Context, req resource.Schem
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -102,9 +112,12 @@ func (r *resourceEndpointProfile) Create(ctx context.Context, req resource.Creat
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointProfile(ctx, "create", diags))
 
@@ -112,6 +125,17 @@ func (r *resourceEndpointProfile) Create(ctx context.Context, req resource.Creat
 		return
 	}
 	output, err := c.CreateEndpointProfile(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectEndpointProfile(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectEndpointProfile(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadEndpointProfile, c.UpdateEndpointProfile, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -123,10 +147,11 @@ func (r *resourceEndpointProfile) Create(ctx context.Context, req resource.Creat
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -161,14 +186,18 @@ func (r *resourceEndpointProfile) Update(ctx context.Context, req resource.Updat
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -184,10 +213,11 @@ func (r *resourceEndpointProfile) Update(ctx context.Context, req resource.Updat
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -217,11 +247,14 @@ func (r *resourceEndpointProfile) Delete(ctx context.Context, req resource.Delet
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointProfile(ctx, "delete", diags))
 
@@ -245,11 +278,14 @@ func (r *resourceEndpointProfile) Read(ctx context.Context, req resource.ReadReq
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointProfile(ctx, "read", diags))
 
@@ -274,12 +310,20 @@ func (r *resourceEndpointProfile) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *resourceEndpointProfile) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateFrom("fortisase_endpoint_profile_clone"),
+	}
+}
+
 func (m *resourceEndpointProfileModel) refreshEndpointProfile(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if o == nil {
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
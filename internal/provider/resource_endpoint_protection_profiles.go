@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointProtectionProfiles{}
+var _ resource.ResourceWithUpgradeState = &resourceEndpointProtectionProfiles{}
 
 func newResourceEndpointProtectionProfiles() resource.Resource {
 	return &resourceEndpointProtectionProfiles{}
@@ -47,6 +49,9 @@ type resourceEndpointProtectionProfilesModel struct {
 	ScheduledScan                     *resourceEndpointProtectionProfilesScheduledScanModel          `tfsdk:"scheduled_scan"`
 	ScheduledAntivirusScan            *resourceEndpointProtectionProfilesScheduledAntivirusScanModel `tfsdk:"scheduled_antivirus_scan"`
 	PrimaryKey                        types.String                                                   `tfsdk:"primary_key"`
+	RestoreOnDestroy                  types.Bool                                                     `tfsdk:"restore_on_destroy"`
+	RawJson                           types.String                                                   `tfsdk:"raw_json"`
+	Timeouts                          timeouts.Value                                                 `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointProtectionProfiles) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,6 +60,7 @@ func (r *resourceEndpointProtectionProfiles) Metadata(ctx context.Context, req r
 
 func (r *resourceEndpointProtectionProfiles) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -131,6 +137,8 @@ func (r *resourceEndpointProtectionProfiles) Schema(ctx context.Context, req res
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"restore_on_destroy": restoreOnDestroyAttribute(),
+			"raw_json":           rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
 				Required:            true,
@@ -216,9 +224,9 @@ func (r *resourceEndpointProtectionProfiles) Schema(ctx context.Context, req res
 						Computed: true,
 						Optional: true,
 					},
-					"day": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(1, 31),
+					"day": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(1, 31),
 						},
 						Computed: true,
 						Optional: true,
@@ -247,9 +255,9 @@ func (r *resourceEndpointProtectionProfiles) Schema(ctx context.Context, req res
 						Computed: true,
 						Optional: true,
 					},
-					"day": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(1, 31),
+					"day": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(1, 31),
 						},
 						Computed: true,
 						Optional: true,
@@ -259,6 +267,9 @@ func (r *resourceEndpointProtectionProfiles) Schema(ctx context.Context, req res
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -284,6 +295,13 @@ func (r *resourceEndpointProtectionProfiles) Configure(ctx context.Context, req
 	r.resourceName = "fortisase_endpoint_protection_profiles"
 }
 
+func (r *resourceEndpointProtectionProfiles) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceEndpointProtectionProfiles) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("EndpointProtectionProfiles")
 	lock.Lock()
@@ -293,12 +311,30 @@ func (r *resourceEndpointProtectionProfiles) Create(ctx context.Context, req res
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
+	// Capture the object before updating it, it is restored when the resource is destroyed
+	var baseline_input_model forticlient.InputModel
+	baseline_input_model.Ctx = ctx
+	baseline_input_model.Mkey = data.PrimaryKey.ValueString()
+	baseline_input_model.URLParams = *(data.getURLObjectEndpointProtectionProfiles(ctx, "read", diags))
+	baseline, err := c.ReadEndpointProtectionProfiles(&baseline_input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&baseline_input_model, baseline),
+		)
+		return
+	}
+
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectEndpointProtectionProfiles(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointProtectionProfiles(ctx, "create", diags))
@@ -318,10 +354,11 @@ func (r *resourceEndpointProtectionProfiles) Create(ctx context.Context, req res
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointProtectionProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointProtectionProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointProtectionProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -335,6 +372,7 @@ func (r *resourceEndpointProtectionProfiles) Create(ctx context.Context, req res
 		return
 	}
 
+	diags.Append(saveBaseline(ctx, resp.Private, baseline)...)
 	diags.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -353,17 +391,22 @@ func (r *resourceEndpointProtectionProfiles) Update(ctx context.Context, req res
 
 	var data resourceEndpointProtectionProfilesModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointProtectionProfiles(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointProtectionProfiles(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -379,10 +422,11 @@ func (r *resourceEndpointProtectionProfiles) Update(ctx context.Context, req res
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointProtectionProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointProtectionProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointProtectionProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -400,7 +444,48 @@ func (r *resourceEndpointProtectionProfiles) Update(ctx context.Context, req res
 }
 
 func (r *resourceEndpointProtectionProfiles) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No delete operation for this resource
+	lock := r.fortiClient.GetResourceLock("EndpointProtectionProfiles")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+	var data resourceEndpointProtectionProfilesModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(req.State.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	// The object cannot be deleted, restore the baseline captured on create if any
+	if !isRestoreOnDestroy(data.RestoreOnDestroy) {
+		return
+	}
+	baseline := loadBaseline(ctx, req.Private, diags)
+	if diags.HasError() || baseline == nil {
+		return
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = data.ID.ValueString()
+	input_model.BodyParams = baseline
+	input_model.URLParams = *(data.getURLObjectEndpointProtectionProfiles(ctx, "delete", diags))
+
+	if diags.HasError() {
+		return
+	}
+
+	output, err := c.UpdateEndpointProtectionProfiles(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
 }
 
 func (r *resourceEndpointProtectionProfiles) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -413,11 +498,14 @@ func (r *resourceEndpointProtectionProfiles) Read(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointProtectionProfiles(ctx, "read", diags))
 
@@ -440,6 +528,7 @@ func (r *resourceEndpointProtectionProfiles) Read(ctx context.Context, req resou
 
 func (r *resourceEndpointProtectionProfiles) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
 }
 
 func (m *resourceEndpointProtectionProfilesModel) refreshEndpointProtectionProfiles(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
@@ -448,6 +537,8 @@ func (m *resourceEndpointProtectionProfilesModel) refreshEndpointProtectionProfi
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["antivirus"]; ok {
 		m.Antivirus = parseStringValue(v)
 	}
@@ -653,16 +744,16 @@ type resourceEndpointProtectionProfilesExclusionsModel struct {
 }
 
 type resourceEndpointProtectionProfilesScheduledScanModel struct {
-	Time   types.String  `tfsdk:"time"`
-	Repeat types.String  `tfsdk:"repeat"`
-	Day    types.Float64 `tfsdk:"day"`
+	Time   types.String `tfsdk:"time"`
+	Repeat types.String `tfsdk:"repeat"`
+	Day    types.Int64  `tfsdk:"day"`
 }
 
 type resourceEndpointProtectionProfilesScheduledAntivirusScanModel struct {
-	ScanType types.String  `tfsdk:"scan_type"`
-	Time     types.String  `tfsdk:"time"`
-	Repeat   types.String  `tfsdk:"repeat"`
-	Day      types.Float64 `tfsdk:"day"`
+	ScanType types.String `tfsdk:"scan_type"`
+	Time     types.String `tfsdk:"time"`
+	Repeat   types.String `tfsdk:"repeat"`
+	Day      types.Int64  `tfsdk:"day"`
 }
 
 func (m *resourceEndpointProtectionProfilesRulesModel) flattenEndpointProtectionProfilesRules(ctx context.Context, input interface{}, diags *diag.Diagnostics) *resourceEndpointProtectionProfilesRulesModel {
@@ -768,7 +859,7 @@ func (m *resourceEndpointProtectionProfilesScheduledScanModel) flattenEndpointPr
 	}
 
 	if v, ok := o["day"]; ok {
-		m.Day = parseFloat64Value(v)
+		m.Day = parseInt64Value(v)
 	}
 
 	return m
@@ -795,7 +886,7 @@ func (m *resourceEndpointProtectionProfilesScheduledAntivirusScanModel) flattenE
 	}
 
 	if v, ok := o["day"]; ok {
-		m.Day = parseFloat64Value(v)
+		m.Day = parseInt64Value(v)
 	}
 
 	return m
@@ -870,7 +961,7 @@ func (data *resourceEndpointProtectionProfilesScheduledScanModel) expandEndpoint
 	}
 
 	if !data.Day.IsNull() {
-		result["day"] = data.Day.ValueFloat64()
+		result["day"] = data.Day.ValueInt64()
 	}
 
 	return result
@@ -891,7 +982,7 @@ func (data *resourceEndpointProtectionProfilesScheduledAntivirusScanModel) expan
 	}
 
 	if !data.Day.IsNull() {
-		result["day"] = data.Day.ValueFloat64()
+		result["day"] = data.Day.ValueInt64()
 	}
 
 	return result
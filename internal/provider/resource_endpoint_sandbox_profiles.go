@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointSandboxProfiles{}
+var _ resource.ResourceWithUpgradeState = &resourceEndpointSandboxProfiles{}
 
 func newResourceEndpointSandboxProfiles() resource.Resource {
 	return &resourceEndpointSandboxProfiles{}
@@ -33,8 +35,8 @@ type resourceEndpointSandboxProfiles struct {
 type resourceEndpointSandboxProfilesModel struct {
 	ID                            types.String                                               `tfsdk:"id"`
 	SandboxMode                   types.String                                               `tfsdk:"sandbox_mode"`
-	NotificationType              types.Float64                                              `tfsdk:"notification_type"`
-	TimeoutAwaitingSandboxResults types.Float64                                              `tfsdk:"timeout_awaiting_sandbox_results"`
+	NotificationType              types.Int64                                                `tfsdk:"notification_type"`
+	TimeoutAwaitingSandboxResults types.Int64                                                `tfsdk:"timeout_awaiting_sandbox_results"`
 	FileSubmissionOptions         *resourceEndpointSandboxProfilesFileSubmissionOptionsModel `tfsdk:"file_submission_options"`
 	DetectionVerdictLevel         types.String                                               `tfsdk:"detection_verdict_level"`
 	Exceptions                    *resourceEndpointSandboxProfilesExceptionsModel            `tfsdk:"exceptions"`
@@ -44,6 +46,9 @@ type resourceEndpointSandboxProfilesModel struct {
 	Username                      types.String                                               `tfsdk:"username"`
 	Password                      types.String                                               `tfsdk:"password"`
 	PrimaryKey                    types.String                                               `tfsdk:"primary_key"`
+	RestoreOnDestroy              types.Bool                                                 `tfsdk:"restore_on_destroy"`
+	RawJson                       types.String                                               `tfsdk:"raw_json"`
+	Timeouts                      timeouts.Value                                             `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointSandboxProfiles) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +57,7 @@ func (r *resourceEndpointSandboxProfiles) Metadata(ctx context.Context, req reso
 
 func (r *resourceEndpointSandboxProfiles) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -67,14 +73,17 @@ func (r *resourceEndpointSandboxProfiles) Schema(ctx context.Context, req resour
 				Computed: true,
 				Optional: true,
 			},
-			"notification_type": schema.Float64Attribute{
+			"notification_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				MarkdownDescription: "Integer representing how notifications should be handled on FortiSandbox file submission. 0 - display notification balloon when malware is detected in a submission. 1 - display a popup for all file submissions.",
 				Computed:            true,
 				Optional:            true,
 			},
-			"timeout_awaiting_sandbox_results": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(2147483647),
+			"timeout_awaiting_sandbox_results": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2147483647),
 				},
 				Computed: true,
 				Optional: true,
@@ -115,9 +124,12 @@ func (r *resourceEndpointSandboxProfiles) Schema(ctx context.Context, req resour
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
+			"restore_on_destroy": restoreOnDestroyAttribute(),
+			"raw_json":           rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
 				Required:            true,
@@ -183,6 +195,9 @@ func (r *resourceEndpointSandboxProfiles) Schema(ctx context.Context, req resour
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -208,6 +223,13 @@ func (r *resourceEndpointSandboxProfiles) Configure(ctx context.Context, req res
 	r.resourceName = "fortisase_endpoint_sandbox_profiles"
 }
 
+func (r *resourceEndpointSandboxProfiles) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceEndpointSandboxProfiles) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("EndpointSandboxProfiles")
 	lock.Lock()
@@ -217,12 +239,30 @@ func (r *resourceEndpointSandboxProfiles) Create(ctx context.Context, req resour
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
+	// Capture the object before updating it, it is restored when the resource is destroyed
+	var baseline_input_model forticlient.InputModel
+	baseline_input_model.Ctx = ctx
+	baseline_input_model.Mkey = data.PrimaryKey.ValueString()
+	baseline_input_model.URLParams = *(data.getURLObjectEndpointSandboxProfiles(ctx, "read", diags))
+	baseline, err := c.ReadEndpointSandboxProfiles(&baseline_input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&baseline_input_model, baseline),
+		)
+		return
+	}
+
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectEndpointSandboxProfiles(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointSandboxProfiles(ctx, "create", diags))
@@ -242,10 +282,11 @@ func (r *resourceEndpointSandboxProfiles) Create(ctx context.Context, req resour
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointSandboxProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointSandboxProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointSandboxProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -259,6 +300,7 @@ func (r *resourceEndpointSandboxProfiles) Create(ctx context.Context, req resour
 		return
 	}
 
+	diags.Append(saveBaseline(ctx, resp.Private, baseline)...)
 	diags.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -277,17 +319,22 @@ func (r *resourceEndpointSandboxProfiles) Update(ctx context.Context, req resour
 
 	var data resourceEndpointSandboxProfilesModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointSandboxProfiles(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointSandboxProfiles(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -303,10 +350,11 @@ func (r *resourceEndpointSandboxProfiles) Update(ctx context.Context, req resour
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointSandboxProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointSandboxProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointSandboxProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -324,7 +372,48 @@ func (r *resourceEndpointSandboxProfiles) Update(ctx context.Context, req resour
 }
 
 func (r *resourceEndpointSandboxProfiles) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No delete operation for this resource
+	lock := r.fortiClient.GetResourceLock("EndpointSandboxProfiles")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+	var data resourceEndpointSandboxProfilesModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(req.State.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	// The object cannot be deleted, restore the baseline captured on create if any
+	if !isRestoreOnDestroy(data.RestoreOnDestroy) {
+		return
+	}
+	baseline := loadBaseline(ctx, req.Private, diags)
+	if diags.HasError() || baseline == nil {
+		return
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = data.ID.ValueString()
+	input_model.BodyParams = baseline
+	input_model.URLParams = *(data.getURLObjectEndpointSandboxProfiles(ctx, "delete", diags))
+
+	if diags.HasError() {
+		return
+	}
+
+	output, err := c.UpdateEndpointSandboxProfiles(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
 }
 
 func (r *resourceEndpointSandboxProfiles) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -337,11 +426,14 @@ func (r *resourceEndpointSandboxProfiles) Read(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointSandboxProfiles(ctx, "read", diags))
 
@@ -364,6 +456,7 @@ func (r *resourceEndpointSandboxProfiles) Read(ctx context.Context, req resource
 
 func (r *resourceEndpointSandboxProfiles) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
 }
 
 func (m *resourceEndpointSandboxProfilesModel) refreshEndpointSandboxProfiles(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
@@ -372,16 +465,18 @@ func (m *resourceEndpointSandboxProfilesModel) refreshEndpointSandboxProfiles(ct
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["sandboxMode"]; ok {
 		m.SandboxMode = parseStringValue(v)
 	}
 
 	if v, ok := o["notificationType"]; ok {
-		m.NotificationType = parseFloat64Value(v)
+		m.NotificationType = parseInt64Value(v)
 	}
 
 	if v, ok := o["timeoutAwaitingSandboxResults"]; ok {
-		m.TimeoutAwaitingSandboxResults = parseFloat64Value(v)
+		m.TimeoutAwaitingSandboxResults = parseInt64Value(v)
 	}
 
 	if v, ok := o["fileSubmissionOptions"]; ok {
@@ -426,11 +521,11 @@ func (data *resourceEndpointSandboxProfilesModel) getCreateObjectEndpointSandbox
 	}
 
 	if !data.NotificationType.IsNull() {
-		result["notificationType"] = data.NotificationType.ValueFloat64()
+		result["notificationType"] = data.NotificationType.ValueInt64()
 	}
 
 	if !data.TimeoutAwaitingSandboxResults.IsNull() {
-		result["timeoutAwaitingSandboxResults"] = data.TimeoutAwaitingSandboxResults.ValueFloat64()
+		result["timeoutAwaitingSandboxResults"] = data.TimeoutAwaitingSandboxResults.ValueInt64()
 	}
 
 	if data.FileSubmissionOptions != nil && !isZeroStruct(*data.FileSubmissionOptions) {
@@ -475,11 +570,11 @@ func (data *resourceEndpointSandboxProfilesModel) getUpdateObjectEndpointSandbox
 	}
 
 	if !data.NotificationType.IsNull() {
-		result["notificationType"] = data.NotificationType.ValueFloat64()
+		result["notificationType"] = data.NotificationType.ValueInt64()
 	}
 
 	if !data.TimeoutAwaitingSandboxResults.IsNull() {
-		result["timeoutAwaitingSandboxResults"] = data.TimeoutAwaitingSandboxResults.ValueFloat64()
+		result["timeoutAwaitingSandboxResults"] = data.TimeoutAwaitingSandboxResults.ValueInt64()
 	}
 
 	if data.FileSubmissionOptions != nil {
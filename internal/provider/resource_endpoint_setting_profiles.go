@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,14 +31,19 @@ type resourceEndpointSettingProfiles struct {
 
 // resourceEndpointSettingProfilesModel describes the resource data model.
 type resourceEndpointSettingProfilesModel struct {
-	ID                    types.String `tfsdk:"id"`
-	AllowConfigBackup     types.String `tfsdk:"allow_config_backup"`
-	ShowTagFortiClient    types.String `tfsdk:"show_tag_forti_client"`
-	ShowNotifications     types.String `tfsdk:"show_notifications"`
-	NotifyVpnIssue        types.String `tfsdk:"notify_vpn_issue"`
-	UsersCanDisconnect    types.String `tfsdk:"users_can_disconnect"`
-	EmsDisconnectPassword types.String `tfsdk:"ems_disconnect_password"`
-	PrimaryKey            types.String `tfsdk:"primary_key"`
+	ID                             types.String   `tfsdk:"id"`
+	AllowConfigBackup              types.String   `tfsdk:"allow_config_backup"`
+	ShowTagFortiClient             types.String   `tfsdk:"show_tag_forti_client"`
+	ShowNotifications              types.String   `tfsdk:"show_notifications"`
+	NotifyVpnIssue                 types.String   `tfsdk:"notify_vpn_issue"`
+	UsersCanDisconnect             types.String   `tfsdk:"users_can_disconnect"`
+	EmsDisconnectPassword          types.String   `tfsdk:"ems_disconnect_password"`
+	EmsDisconnectPasswordWo        types.String   `tfsdk:"ems_disconnect_password_wo"`
+	EmsDisconnectPasswordWoVersion types.Int64    `tfsdk:"ems_disconnect_password_wo_version"`
+	PrimaryKey                     types.String   `tfsdk:"primary_key"`
+	RestoreOnDestroy               types.Bool     `tfsdk:"restore_on_destroy"`
+	RawJson                        types.String   `tfsdk:"raw_json"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointSettingProfiles) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -90,9 +96,26 @@ func (r *resourceEndpointSettingProfiles) Schema(ctx context.Context, req resour
 				Optional: true,
 			},
 			"ems_disconnect_password": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
+			"ems_disconnect_password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `ems_disconnect_password`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ems_disconnect_password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("ems_disconnect_password_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"ems_disconnect_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `ems_disconnect_password_wo`. The value of `ems_disconnect_password_wo` is sent on every create and update, change the version to send a new value when no other attribute changes.",
+				Optional:            true,
+			},
+			"restore_on_destroy": restoreOnDestroyAttribute(),
+			"raw_json":           rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
 				Required:            true,
@@ -101,6 +124,9 @@ func (r *resourceEndpointSettingProfiles) Schema(ctx context.Context, req resour
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -135,12 +161,30 @@ func (r *resourceEndpointSettingProfiles) Create(ctx context.Context, req resour
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
+	// Capture the object before updating it, it is restored when the resource is destroyed
+	var baseline_input_model forticlient.InputModel
+	baseline_input_model.Ctx = ctx
+	baseline_input_model.Mkey = data.PrimaryKey.ValueString()
+	baseline_input_model.URLParams = *(data.getURLObjectEndpointSettingProfiles(ctx, "read", diags))
+	baseline, err := c.ReadEndpointSettingProfiles(&baseline_input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&baseline_input_model, baseline),
+		)
+		return
+	}
+
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectEndpointSettingProfiles(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointSettingProfiles(ctx, "create", diags))
@@ -160,10 +204,11 @@ func (r *resourceEndpointSettingProfiles) Create(ctx context.Context, req resour
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointSettingProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointSettingProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointSettingProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -177,6 +222,7 @@ func (r *resourceEndpointSettingProfiles) Create(ctx context.Context, req resour
 		return
 	}
 
+	diags.Append(saveBaseline(ctx, resp.Private, baseline)...)
 	diags.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -195,17 +241,22 @@ func (r *resourceEndpointSettingProfiles) Update(ctx context.Context, req resour
 
 	var data resourceEndpointSettingProfilesModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointSettingProfiles(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointSettingProfiles(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -221,10 +272,11 @@ func (r *resourceEndpointSettingProfiles) Update(ctx context.Context, req resour
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointSettingProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointSettingProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointSettingProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -242,7 +294,48 @@ func (r *resourceEndpointSettingProfiles) Update(ctx context.Context, req resour
 }
 
 func (r *resourceEndpointSettingProfiles) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No delete operation for this resource
+	lock := r.fortiClient.GetResourceLock("EndpointSettingProfiles")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+	var data resourceEndpointSettingProfilesModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(req.State.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	// The object cannot be deleted, restore the baseline captured on create if any
+	if !isRestoreOnDestroy(data.RestoreOnDestroy) {
+		return
+	}
+	baseline := loadBaseline(ctx, req.Private, diags)
+	if diags.HasError() || baseline == nil {
+		return
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = data.ID.ValueString()
+	input_model.BodyParams = baseline
+	input_model.URLParams = *(data.getURLObjectEndpointSettingProfiles(ctx, "delete", diags))
+
+	if diags.HasError() {
+		return
+	}
+
+	output, err := c.UpdateEndpointSettingProfiles(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
 }
 
 func (r *resourceEndpointSettingProfiles) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -255,11 +348,14 @@ func (r *resourceEndpointSettingProfiles) Read(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointSettingProfiles(ctx, "read", diags))
 
@@ -282,6 +378,7 @@ func (r *resourceEndpointSettingProfiles) Read(ctx context.Context, req resource
 
 func (r *resourceEndpointSettingProfiles) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
 }
 
 func (m *resourceEndpointSettingProfilesModel) refreshEndpointSettingProfiles(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
@@ -290,6 +387,8 @@ func (m *resourceEndpointSettingProfilesModel) refreshEndpointSettingProfiles(ct
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["allowConfigBackup"]; ok {
 		m.AllowConfigBackup = parseStringValue(v)
 	}
@@ -343,6 +442,10 @@ func (data *resourceEndpointSettingProfilesModel) getCreateObjectEndpointSetting
 		result["emsDisconnectPassword"] = data.EmsDisconnectPassword.ValueString()
 	}
 
+	if !data.EmsDisconnectPasswordWo.IsNull() {
+		result["emsDisconnectPassword"] = data.EmsDisconnectPasswordWo.ValueString()
+	}
+
 	return &result
 }
 
@@ -372,6 +475,10 @@ func (data *resourceEndpointSettingProfilesModel) getUpdateObjectEndpointSetting
 		result["emsDisconnectPassword"] = data.EmsDisconnectPassword.ValueString()
 	}
 
+	if !data.EmsDisconnectPasswordWo.IsNull() {
+		result["emsDisconnectPassword"] = data.EmsDisconnectPasswordWo.ValueString()
+	}
+
 	return &result
 }
 
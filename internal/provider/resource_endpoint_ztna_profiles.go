@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointZtnaProfiles{}
+var _ resource.ResourceWithUpgradeState = &resourceEndpointZtnaProfiles{}
 
 func newResourceEndpointZtnaProfiles() resource.Resource {
 	return &resourceEndpointZtnaProfiles{}
@@ -35,6 +38,8 @@ type resourceEndpointZtnaProfilesModel struct {
 	ConnectionRules      []resourceEndpointZtnaProfilesConnectionRulesModel `tfsdk:"connection_rules"`
 	EntraId              *resourceEndpointZtnaProfilesEntraIdModel          `tfsdk:"entra_id"`
 	PrimaryKey           types.String                                       `tfsdk:"primary_key"`
+	RawJson              types.String                                       `tfsdk:"raw_json"`
+	Timeouts             timeouts.Value                                     `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointZtnaProfiles) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,6 +48,7 @@ func (r *resourceEndpointZtnaProfiles) Metadata(ctx context.Context, req resourc
 
 func (r *resourceEndpointZtnaProfiles) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -58,6 +64,7 @@ func (r *resourceEndpointZtnaProfiles) Schema(ctx context.Context, req resource.
 				Computed: true,
 				Optional: true,
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "The primary key of the object. Can be found in the response from the get request.",
 				Required:            true,
@@ -68,7 +75,10 @@ func (r *resourceEndpointZtnaProfiles) Schema(ctx context.Context, req resource.
 			"connection_rules": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.Float64Attribute{
+						"id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -106,7 +116,10 @@ func (r *resourceEndpointZtnaProfiles) Schema(ctx context.Context, req resource.
 										Computed: true,
 										Optional: true,
 									},
-									"private_app_count": schema.Float64Attribute{
+									"private_app_count": schema.Int64Attribute{
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
 										Computed: true,
 										Optional: true,
 									},
@@ -146,6 +159,9 @@ func (r *resourceEndpointZtnaProfiles) Schema(ctx context.Context, req resource.
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -171,6 +187,13 @@ func (r *resourceEndpointZtnaProfiles) Configure(ctx context.Context, req resour
 	r.resourceName = "fortisase_endpoint_ztna_profiles"
 }
 
+func (r *resourceEndpointZtnaProfiles) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceEndpointZtnaProfiles) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("EndpointZtnaProfiles")
 	lock.Lock()
@@ -183,9 +206,12 @@ func (r *resourceEndpointZtnaProfiles) Create(ctx context.Context, req resource.
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectEndpointZtnaProfiles(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaProfiles(ctx, "create", diags))
@@ -205,10 +231,11 @@ func (r *resourceEndpointZtnaProfiles) Create(ctx context.Context, req resource.
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointZtnaProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointZtnaProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointZtnaProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -243,14 +270,18 @@ func (r *resourceEndpointZtnaProfiles) Update(ctx context.Context, req resource.
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointZtnaProfiles(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaProfiles(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -266,10 +297,11 @@ func (r *resourceEndpointZtnaProfiles) Update(ctx context.Context, req resource.
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointZtnaProfiles(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointZtnaProfiles(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointZtnaProfiles, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -300,11 +332,14 @@ func (r *resourceEndpointZtnaProfiles) Read(ctx context.Context, req resource.Re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaProfiles(ctx, "read", diags))
 
@@ -335,6 +370,8 @@ func (m *resourceEndpointZtnaProfilesModel) refreshEndpointZtnaProfiles(ctx cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["allowAutomaticSignOn"]; ok {
 		m.AllowAutomaticSignOn = parseStringValue(v)
 	}
@@ -394,7 +431,7 @@ func (data *resourceEndpointZtnaProfilesModel) getURLObjectEndpointZtnaProfiles(
 }
 
 type resourceEndpointZtnaProfilesConnectionRulesModel struct {
-	Id         types.Float64                                              `tfsdk:"id"`
+	Id         types.Int64                                                `tfsdk:"id"`
 	Address    types.String                                               `tfsdk:"address"`
 	Uid        types.String                                               `tfsdk:"uid"`
 	Gateways   []resourceEndpointZtnaProfilesConnectionRulesGatewaysModel `tfsdk:"gateways"`
@@ -405,10 +442,10 @@ type resourceEndpointZtnaProfilesConnectionRulesModel struct {
 }
 
 type resourceEndpointZtnaProfilesConnectionRulesGatewaysModel struct {
-	Alias           types.String  `tfsdk:"alias"`
-	PrivateAppCount types.Float64 `tfsdk:"private_app_count"`
-	Vip             types.String  `tfsdk:"vip"`
-	Redirect        types.String  `tfsdk:"redirect"`
+	Alias           types.String `tfsdk:"alias"`
+	PrivateAppCount types.Int64  `tfsdk:"private_app_count"`
+	Vip             types.String `tfsdk:"vip"`
+	Redirect        types.String `tfsdk:"redirect"`
 }
 
 type resourceEndpointZtnaProfilesEntraIdModel struct {
@@ -425,7 +462,7 @@ func (m *resourceEndpointZtnaProfilesConnectionRulesModel) flattenEndpointZtnaPr
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["address"]; ok {
@@ -496,7 +533,7 @@ func (m *resourceEndpointZtnaProfilesConnectionRulesGatewaysModel) flattenEndpoi
 	}
 
 	if v, ok := o["private_app_count"]; ok {
-		m.PrivateAppCount = parseFloat64Value(v)
+		m.PrivateAppCount = parseInt64Value(v)
 	}
 
 	if v, ok := o["vip"]; ok {
@@ -556,7 +593,7 @@ func (m *resourceEndpointZtnaProfilesEntraIdModel) flattenEndpointZtnaProfilesEn
 func (data *resourceEndpointZtnaProfilesConnectionRulesModel) expandEndpointZtnaProfilesConnectionRules(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Id.IsNull() {
-		result["id"] = data.Id.ValueFloat64()
+		result["id"] = data.Id.ValueInt64()
 	}
 
 	if !data.Address.IsNull() {
@@ -603,7 +640,7 @@ func (data *resourceEndpointZtnaProfilesConnectionRulesGatewaysModel) expandEndp
 	}
 
 	if !data.PrivateAppCount.IsNull() {
-		result["private_app_count"] = data.PrivateAppCount.ValueFloat64()
+		result["private_app_count"] = data.PrivateAppCount.ValueInt64()
 	}
 
 	if !data.Vip.IsNull() {
@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceEndpointZtnaRules{}
+var _ resource.ResourceWithModifyPlan = &resourceEndpointZtnaRules{}
+var _ resource.ResourceWithUpgradeState = &resourceEndpointZtnaRules{}
 
 func newResourceEndpointZtnaRules() resource.Resource {
 	return &resourceEndpointZtnaRules{}
@@ -31,13 +34,16 @@ type resourceEndpointZtnaRules struct {
 
 // resourceEndpointZtnaRulesModel describes the resource data model.
 type resourceEndpointZtnaRulesModel struct {
-	ID         types.String                          `tfsdk:"id"`
-	PrimaryKey types.String                          `tfsdk:"primary_key"`
-	Status     types.String                          `tfsdk:"status"`
-	Tag        *resourceEndpointZtnaRulesTagModel    `tfsdk:"tag"`
-	Comments   types.String                          `tfsdk:"comments"`
-	Rules      []resourceEndpointZtnaRulesRulesModel `tfsdk:"rules"`
-	Logic      *resourceEndpointZtnaRulesLogicModel  `tfsdk:"logic"`
+	ID            types.String                          `tfsdk:"id"`
+	PrimaryKey    types.String                          `tfsdk:"primary_key"`
+	Status        types.String                          `tfsdk:"status"`
+	Tag           *resourceEndpointZtnaRulesTagModel    `tfsdk:"tag"`
+	Comments      types.String                          `tfsdk:"comments"`
+	Rules         []resourceEndpointZtnaRulesRulesModel `tfsdk:"rules"`
+	Logic         *resourceEndpointZtnaRulesLogicModel  `tfsdk:"logic"`
+	AdoptExisting types.Bool                            `tfsdk:"adopt_existing"`
+	RawJson       types.String                          `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value                        `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointZtnaRules) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,6 +52,7 @@ func (r *resourceEndpointZtnaRules) Metadata(ctx context.Context, req resource.M
 
 func (r *resourceEndpointZtnaRules) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -54,6 +61,8 @@ func (r *resourceEndpointZtnaRules) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
@@ -95,9 +104,9 @@ func (r *resourceEndpointZtnaRules) Schema(ctx context.Context, req resource.Sch
 				MarkdownDescription: "The property 'logic' is required when 'rules' are modified; otherwise, 'logic' will be set to a default value.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.AtLeast(1),
+						"id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
 							},
 							Computed: true,
 							Optional: true,
@@ -166,9 +175,9 @@ func (r *resourceEndpointZtnaRules) Schema(ctx context.Context, req resource.Sch
 							Computed: true,
 							Optional: true,
 						},
-						"check_updates_within_days": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 3653),
+						"check_updates_within_days": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 3653),
 							},
 							Computed: true,
 							Optional: true,
@@ -248,6 +257,9 @@ func (r *resourceEndpointZtnaRules) Schema(ctx context.Context, req resource.Sch
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -273,6 +285,17 @@ func (r *resourceEndpointZtnaRules) Configure(ctx context.Context, req resource.
 	r.resourceName = "fortisase_endpoint_ztna_rules"
 }
 
+func (r *resourceEndpointZtnaRules) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceEndpointZtnaRules) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceEndpointZtnaRules) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("EndpointZtnaRules")
 	lock.Lock()
@@ -285,9 +308,12 @@ func (r *resourceEndpointZtnaRules) Create(ctx context.Context, req resource.Cre
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointZtnaRules(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaRules(ctx, "create", diags))
 
@@ -295,6 +321,17 @@ func (r *resourceEndpointZtnaRules) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	output, err := c.CreateEndpointZtnaRules(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectEndpointZtnaRules(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectEndpointZtnaRules(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadEndpointZtnaRules, c.UpdateEndpointZtnaRules, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -306,10 +343,11 @@ func (r *resourceEndpointZtnaRules) Create(ctx context.Context, req resource.Cre
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointZtnaRules(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointZtnaRules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointZtnaRules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -344,14 +382,18 @@ func (r *resourceEndpointZtnaRules) Update(ctx context.Context, req resource.Upd
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointZtnaRules(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaRules(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -367,10 +409,11 @@ func (r *resourceEndpointZtnaRules) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointZtnaRules(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointZtnaRules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointZtnaRules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -400,11 +443,14 @@ func (r *resourceEndpointZtnaRules) Delete(ctx context.Context, req resource.Del
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaRules(ctx, "delete", diags))
 
@@ -428,11 +474,14 @@ func (r *resourceEndpointZtnaRules) Read(ctx context.Context, req resource.ReadR
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaRules(ctx, "read", diags))
 
@@ -463,6 +512,8 @@ func (m *resourceEndpointZtnaRulesModel) refreshEndpointZtnaRules(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["status"]; ok {
 		m.Status = parseStringValue(v)
 	}
@@ -557,7 +608,7 @@ type resourceEndpointZtnaRulesTagModel struct {
 }
 
 type resourceEndpointZtnaRulesRulesModel struct {
-	Id                      types.Float64                                 `tfsdk:"id"`
+	Id                      types.Int64                                   `tfsdk:"id"`
 	Os                      types.String                                  `tfsdk:"os"`
 	Type                    types.String                                  `tfsdk:"type"`
 	Service                 types.String                                  `tfsdk:"service"`
@@ -568,7 +619,7 @@ type resourceEndpointZtnaRulesRulesModel struct {
 	Path                    types.String                                  `tfsdk:"path"`
 	Negated                 types.Bool                                    `tfsdk:"negated"`
 	EnableLatestUpdateCheck types.Bool                                    `tfsdk:"enable_latest_update_check"`
-	CheckUpdatesWithinDays  types.Float64                                 `tfsdk:"check_updates_within_days"`
+	CheckUpdatesWithinDays  types.Int64                                   `tfsdk:"check_updates_within_days"`
 	Comparator              types.String                                  `tfsdk:"comparator"`
 	Condition               *resourceEndpointZtnaRulesRulesConditionModel `tfsdk:"condition"`
 	Content                 types.String                                  `tfsdk:"content"`
@@ -617,7 +668,7 @@ func (m *resourceEndpointZtnaRulesRulesModel) flattenEndpointZtnaRulesRules(ctx
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["os"]; ok {
@@ -661,7 +712,7 @@ func (m *resourceEndpointZtnaRulesRulesModel) flattenEndpointZtnaRulesRules(ctx
 	}
 
 	if v, ok := o["checkUpdatesWithinDays"]; ok {
-		m.CheckUpdatesWithinDays = parseFloat64Value(v)
+		m.CheckUpdatesWithinDays = parseInt64Value(v)
 	}
 
 	if v, ok := o["comparator"]; ok {
@@ -757,7 +808,7 @@ func (data *resourceEndpointZtnaRulesTagModel) expandEndpointZtnaRulesTag(ctx co
 func (data *resourceEndpointZtnaRulesRulesModel) expandEndpointZtnaRulesRules(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Id.IsNull() {
-		result["id"] = data.Id.ValueFloat64()
+		result["id"] = data.Id.ValueInt64()
 	}
 
 	if !data.Os.IsNull() {
@@ -801,7 +852,7 @@ func (data *resourceEndpointZtnaRulesRulesModel) expandEndpointZtnaRulesRules(ct
 	}
 
 	if !data.CheckUpdatesWithinDays.IsNull() {
-		result["checkUpdatesWithinDays"] = data.CheckUpdatesWithinDays.ValueFloat64()
+		result["checkUpdatesWithinDays"] = data.CheckUpdatesWithinDays.ValueInt64()
 	}
 
 	if !data.Comparator.IsNull() {
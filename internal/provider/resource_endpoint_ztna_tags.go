@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,9 +31,12 @@ type resourceEndpointZtnaTags struct {
 
 // resourceEndpointZtnaTagsModel describes the resource data model.
 type resourceEndpointZtnaTagsModel struct {
-	ID         types.String `tfsdk:"id"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	Name       types.String `tfsdk:"name"`
+	ID          types.String   `tfsdk:"id"`
+	PrimaryKey  types.String   `tfsdk:"primary_key"`
+	Name        types.String   `tfsdk:"name"`
+	ForceDetach types.Bool     `tfsdk:"force_detach"`
+	RawJson     types.String   `tfsdk:"raw_json"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointZtnaTags) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,6 +53,8 @@ func (r *resourceEndpointZtnaTags) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_detach": forceDetachAttribute(),
+			"raw_json":     rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 58),
@@ -69,6 +75,9 @@ func (r *resourceEndpointZtnaTags) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutUpdate),
+		},
 	}
 }
 
@@ -106,9 +115,12 @@ func (r *resourceEndpointZtnaTags) Create(ctx context.Context, req resource.Crea
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointZtnaTags(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaTags(ctx, "create", diags))
 
@@ -127,10 +139,11 @@ func (r *resourceEndpointZtnaTags) Create(ctx context.Context, req resource.Crea
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectEndpointZtnaTags(ctx, "read", diags))
 
-	read_output, err := c.ReadEndpointZtnaTags(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadEndpointZtnaTags, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -168,14 +181,21 @@ func (r *resourceEndpointZtnaTags) Delete(ctx context.Context, req resource.Dele
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaTags(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "endpoint/ztna-tags", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteEndpointZtnaTags(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -196,11 +216,14 @@ func (r *resourceEndpointZtnaTags) Read(ctx context.Context, req resource.ReadRe
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectEndpointZtnaTags(ctx, "read", diags))
 
@@ -231,6 +254,8 @@ func (m *resourceEndpointZtnaTagsModel) refreshEndpointZtnaTags(ctx context.Cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["name"]; ok {
 		m.Name = parseStringValue(v)
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,8 +30,9 @@ type resourceEndpointsAccessProxyDisconnect2Edl struct {
 
 // resourceEndpointsAccessProxyDisconnect2EdlModel describes the resource data model.
 type resourceEndpointsAccessProxyDisconnect2EdlModel struct {
-	ID     types.String `tfsdk:"id"`
-	SnList types.Set    `tfsdk:"sn_list"`
+	ID       types.String   `tfsdk:"id"`
+	SnList   types.Set      `tfsdk:"sn_list"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointsAccessProxyDisconnect2Edl) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,6 +41,7 @@ func (r *resourceEndpointsAccessProxyDisconnect2Edl) Metadata(ctx context.Contex
 
 func (r *resourceEndpointsAccessProxyDisconnect2Edl) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		DeprecationMessage: "Use action fortisase_endpoints_access_proxy_disconnect instead. The resource only runs the operation when it is created or its arguments change.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -56,6 +59,9 @@ func (r *resourceEndpointsAccessProxyDisconnect2Edl) Schema(ctx context.Context,
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutRead, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -90,9 +96,12 @@ func (r *resourceEndpointsAccessProxyDisconnect2Edl) Create(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointsAccessProxyDisconnect(ctx, diags))
 
 	if diags.HasError() {
@@ -128,12 +137,15 @@ func (r *resourceEndpointsAccessProxyDisconnect2Edl) Update(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointsAccessProxyDisconnect(ctx, state, diags))
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,6 +30,7 @@ type resourceEndpointsDisableManagement struct {
 type resourceEndpointsDisableManagementModel struct {
 	ID        types.String                                       `tfsdk:"id"`
 	Endpoints []resourceEndpointsDisableManagementEndpointsModel `tfsdk:"endpoints"`
+	Timeouts  timeouts.Value                                     `tfsdk:"timeouts"`
 }
 
 func (r *resourceEndpointsDisableManagement) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,6 +39,7 @@ func (r *resourceEndpointsDisableManagement) Metadata(ctx context.Context, req r
 
 func (r *resourceEndpointsDisableManagement) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		DeprecationMessage: "Use action fortisase_endpoints_disable_management instead. The resource only runs the operation when it is created or its arguments change.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -62,6 +65,9 @@ func (r *resourceEndpointsDisableManagement) Schema(ctx context.Context, req res
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutRead, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -96,9 +102,12 @@ func (r *resourceEndpointsDisableManagement) Create(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectEndpointsDisableManagement(ctx, diags))
 
 	if diags.HasError() {
@@ -134,12 +143,15 @@ func (r *resourceEndpointsDisableManagement) Update(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectEndpointsDisableManagement(ctx, state, diags))
 
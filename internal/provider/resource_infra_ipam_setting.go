@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,9 +32,12 @@ type resourceInfraIpamSetting struct {
 
 // resourceInfraIpamSettingModel describes the resource data model.
 type resourceInfraIpamSettingModel struct {
-	ID         types.String                         `tfsdk:"id"`
-	PrimaryKey types.String                         `tfsdk:"primary_key"`
-	Pools      []resourceInfraIpamSettingPoolsModel `tfsdk:"pools"`
+	ID               types.String                         `tfsdk:"id"`
+	PrimaryKey       types.String                         `tfsdk:"primary_key"`
+	RestoreOnDestroy types.Bool                           `tfsdk:"restore_on_destroy"`
+	Pools            []resourceInfraIpamSettingPoolsModel `tfsdk:"pools"`
+	RawJson          types.String                         `tfsdk:"raw_json"`
+	Timeouts         timeouts.Value                       `tfsdk:"timeouts"`
 }
 
 func (r *resourceInfraIpamSetting) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +54,8 @@ func (r *resourceInfraIpamSetting) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"restore_on_destroy": restoreOnDestroyAttribute(),
+			"raw_json":           rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -66,15 +72,17 @@ func (r *resourceInfraIpamSetting) Schema(ctx context.Context, req resource.Sche
 							Optional: true,
 						},
 						"subnet": schema.StringAttribute{
-							Computed: true,
-							Optional: true,
+							CustomType: subnetType{},
+							Computed:   true,
+							Optional:   true,
 						},
 						"excluded_subnets": schema.ListNestedAttribute{
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
 									"subnet": schema.StringAttribute{
-										Computed: true,
-										Optional: true,
+										CustomType: subnetType{},
+										Computed:   true,
+										Optional:   true,
 									},
 								},
 							},
@@ -87,6 +95,9 @@ func (r *resourceInfraIpamSetting) Schema(ctx context.Context, req resource.Sche
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -118,12 +129,29 @@ func (r *resourceInfraIpamSetting) Create(ctx context.Context, req resource.Crea
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
+	// Capture the object before updating it, it is restored when the resource is destroyed
+	var baseline_input_model forticlient.InputModel
+	baseline_input_model.Ctx = ctx
+	baseline_input_model.Mkey = data.PrimaryKey.ValueString()
+	baseline, err := c.ReadInfraIpamSetting(&baseline_input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&baseline_input_model, baseline),
+		)
+		return
+	}
+
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectInfraIpamSetting(ctx, diags))
 
@@ -141,9 +169,10 @@ func (r *resourceInfraIpamSetting) Create(ctx context.Context, req resource.Crea
 
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
-	read_output, err := c.ReadInfraIpamSetting(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadInfraIpamSetting, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -158,6 +187,7 @@ func (r *resourceInfraIpamSetting) Create(ctx context.Context, req resource.Crea
 	}
 	data.ID = types.StringValue(fmt.Sprintf("%v", read_output["primaryKey"]))
 
+	diags.Append(saveBaseline(ctx, resp.Private, baseline)...)
 	diags.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -173,17 +203,22 @@ func (r *resourceInfraIpamSetting) Update(ctx context.Context, req resource.Upda
 
 	var data resourceInfraIpamSettingModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("restore_on_destroy"), &data.RestoreOnDestroy)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectInfraIpamSetting(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 
 	if diags.HasError() {
 		return
@@ -198,9 +233,10 @@ func (r *resourceInfraIpamSetting) Update(ctx context.Context, req resource.Upda
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
-	read_output, err := c.ReadInfraIpamSetting(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadInfraIpamSetting, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -218,7 +254,40 @@ func (r *resourceInfraIpamSetting) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *resourceInfraIpamSetting) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No delete operation for this resource
+	diags := &resp.Diagnostics
+	var data resourceInfraIpamSettingModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(req.State.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	// The object cannot be deleted, restore the baseline captured on create if any
+	if !isRestoreOnDestroy(data.RestoreOnDestroy) {
+		return
+	}
+	baseline := loadBaseline(ctx, req.Private, diags)
+	if diags.HasError() || baseline == nil {
+		return
+	}
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = data.ID.ValueString()
+	input_model.BodyParams = baseline
+
+	output, err := c.UpdateInfraIpamSetting(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
 }
 
 func (r *resourceInfraIpamSetting) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -231,11 +300,14 @@ func (r *resourceInfraIpamSetting) Read(ctx context.Context, req resource.ReadRe
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 
 	read_output, err := c.ReadInfraIpamSetting(&input_model)
@@ -257,6 +329,7 @@ func (r *resourceInfraIpamSetting) Read(ctx context.Context, req resource.ReadRe
 
 func (r *resourceInfraIpamSetting) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), true)...)
 }
 
 func (m *resourceInfraIpamSettingModel) refreshInfraIpamSetting(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
@@ -265,6 +338,8 @@ func (m *resourceInfraIpamSettingModel) refreshInfraIpamSetting(ctx context.Cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["primaryKey"]; ok {
 		m.PrimaryKey = parseStringValue(v)
 	}
@@ -302,12 +377,12 @@ func (data *resourceInfraIpamSettingModel) getUpdateObjectInfraIpamSetting(ctx c
 
 type resourceInfraIpamSettingPoolsModel struct {
 	Name            types.String                                        `tfsdk:"name"`
-	Subnet          types.String                                        `tfsdk:"subnet"`
+	Subnet          subnetValue                                         `tfsdk:"subnet"`
 	ExcludedSubnets []resourceInfraIpamSettingPoolsExcludedSubnetsModel `tfsdk:"excluded_subnets"`
 }
 
 type resourceInfraIpamSettingPoolsExcludedSubnetsModel struct {
-	Subnet types.String `tfsdk:"subnet"`
+	Subnet subnetValue `tfsdk:"subnet"`
 }
 
 func (m *resourceInfraIpamSettingPoolsModel) flattenInfraIpamSettingPools(ctx context.Context, input interface{}, diags *diag.Diagnostics) *resourceInfraIpamSettingPoolsModel {
@@ -323,7 +398,7 @@ func (m *resourceInfraIpamSettingPoolsModel) flattenInfraIpamSettingPools(ctx co
 	}
 
 	if v, ok := o["subnet"]; ok {
-		m.Subnet = parseStringValue(v)
+		m.Subnet = subnetValue{parseStringValue(v)}
 	}
 
 	if v, ok := o["excludedSubnets"]; ok {
@@ -366,7 +441,7 @@ func (m *resourceInfraIpamSettingPoolsExcludedSubnetsModel) flattenInfraIpamSett
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["subnet"]; ok {
-		m.Subnet = parseStringValue(v)
+		m.Subnet = subnetValue{parseStringValue(v)}
 	}
 
 	return m
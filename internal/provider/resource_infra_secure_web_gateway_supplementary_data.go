@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +21,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceInfraSecureWebGatewaySupplementaryData{}
+var _ resource.ResourceWithUpgradeState = &resourceInfraSecureWebGatewaySupplementaryData{}
 
 func newResourceInfraSecureWebGatewaySupplementaryData() resource.Resource {
 	return &resourceInfraSecureWebGatewaySupplementaryData{}
@@ -31,10 +34,12 @@ type resourceInfraSecureWebGatewaySupplementaryData struct {
 
 // resourceInfraSecureWebGatewaySupplementaryDataModel describes the resource data model.
 type resourceInfraSecureWebGatewaySupplementaryDataModel struct {
-	ID                   types.String  `tfsdk:"id"`
-	PrimaryKey           types.String  `tfsdk:"primary_key"`
-	SessionDurationHours types.Float64 `tfsdk:"session_duration_hours"`
-	EndSessionAfterMins  types.Float64 `tfsdk:"end_session_after_mins"`
+	ID                   types.String   `tfsdk:"id"`
+	PrimaryKey           types.String   `tfsdk:"primary_key"`
+	SessionDurationHours types.Int64    `tfsdk:"session_duration_hours"`
+	EndSessionAfterMins  types.Int64    `tfsdk:"end_session_after_mins"`
+	RawJson              types.String   `tfsdk:"raw_json"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceInfraSecureWebGatewaySupplementaryData) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,6 +48,7 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Metadata(ctx context.Co
 
 func (r *resourceInfraSecureWebGatewaySupplementaryData) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -51,6 +57,7 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Schema(ctx context.Cont
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -59,15 +66,24 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Schema(ctx context.Cont
 				Computed: true,
 				Optional: true,
 			},
-			"session_duration_hours": schema.Float64Attribute{
+			"session_duration_hours": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"end_session_after_mins": schema.Float64Attribute{
+			"end_session_after_mins": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -93,6 +109,13 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Configure(ctx context.C
 	r.resourceName = "fortisase_infra_secure_web_gateway_supplementary_data"
 }
 
+func (r *resourceInfraSecureWebGatewaySupplementaryData) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceInfraSecureWebGatewaySupplementaryData) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("InfraSecureWebGatewaySupplementaryData")
 	lock.Lock()
@@ -105,9 +128,12 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Create(ctx context.Cont
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectInfraSecureWebGatewaySupplementaryData(ctx, diags))
 
@@ -126,9 +152,10 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Create(ctx context.Cont
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
-	read_output, err := c.ReadInfraSecureWebGatewaySupplementaryData(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadInfraSecureWebGatewaySupplementaryData, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -163,14 +190,18 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Update(ctx context.Cont
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectInfraSecureWebGatewaySupplementaryData(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 
 	if diags.HasError() {
 		return
@@ -185,9 +216,10 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Update(ctx context.Cont
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
-	read_output, err := c.ReadInfraSecureWebGatewaySupplementaryData(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadInfraSecureWebGatewaySupplementaryData, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -218,11 +250,14 @@ func (r *resourceInfraSecureWebGatewaySupplementaryData) Read(ctx context.Contex
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 
 	read_output, err := c.ReadInfraSecureWebGatewaySupplementaryData(&input_model)
@@ -252,16 +287,18 @@ func (m *resourceInfraSecureWebGatewaySupplementaryDataModel) refreshInfraSecure
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["primaryKey"]; ok {
 		m.PrimaryKey = parseStringValue(v)
 	}
 
 	if v, ok := o["sessionDurationHours"]; ok {
-		m.SessionDurationHours = parseFloat64Value(v)
+		m.SessionDurationHours = parseInt64Value(v)
 	}
 
 	if v, ok := o["endSessionAfterMins"]; ok {
-		m.EndSessionAfterMins = parseFloat64Value(v)
+		m.EndSessionAfterMins = parseInt64Value(v)
 	}
 
 	return diags
@@ -274,11 +311,11 @@ func (data *resourceInfraSecureWebGatewaySupplementaryDataModel) getCreateObject
 	}
 
 	if !data.SessionDurationHours.IsNull() {
-		result["sessionDurationHours"] = data.SessionDurationHours.ValueFloat64()
+		result["sessionDurationHours"] = data.SessionDurationHours.ValueInt64()
 	}
 
 	if !data.EndSessionAfterMins.IsNull() {
-		result["endSessionAfterMins"] = data.EndSessionAfterMins.ValueFloat64()
+		result["endSessionAfterMins"] = data.EndSessionAfterMins.ValueInt64()
 	}
 
 	return &result
@@ -291,11 +328,11 @@ func (data *resourceInfraSecureWebGatewaySupplementaryDataModel) getUpdateObject
 	}
 
 	if !data.SessionDurationHours.IsNull() {
-		result["sessionDurationHours"] = data.SessionDurationHours.ValueFloat64()
+		result["sessionDurationHours"] = data.SessionDurationHours.ValueInt64()
 	}
 
 	if !data.EndSessionAfterMins.IsNull() {
-		result["endSessionAfterMins"] = data.EndSessionAfterMins.ValueFloat64()
+		result["endSessionAfterMins"] = data.EndSessionAfterMins.ValueInt64()
 	}
 
 	return &result
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +20,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceInfraSsids{}
+var _ resource.ResourceWithModifyPlan = &resourceInfraSsids{}
+var _ resource.ResourceWithConfigValidators = &resourceInfraSsids{}
+var _ resource.ResourceWithUpgradeState = &resourceInfraSsids{}
 
 func newResourceInfraSsids() resource.Resource {
 	return &resourceInfraSsids{}
@@ -30,17 +35,24 @@ type resourceInfraSsids struct {
 
 // resourceInfraSsidsModel describes the resource data model.
 type resourceInfraSsidsModel struct {
-	ID             types.String                            `tfsdk:"id"`
-	PrimaryKey     types.String                            `tfsdk:"primary_key"`
-	WifiSsid       types.String                            `tfsdk:"wifi_ssid"`
-	BroadcastSsid  types.String                            `tfsdk:"broadcast_ssid"`
-	ClientLimit    types.Float64                           `tfsdk:"client_limit"`
-	SecurityMode   types.String                            `tfsdk:"security_mode"`
-	CaptivePortal  types.Bool                              `tfsdk:"captive_portal"`
-	SecurityGroups []resourceInfraSsidsSecurityGroupsModel `tfsdk:"security_groups"`
-	PreSharedKey   types.String                            `tfsdk:"pre_shared_key"`
-	RadiusServer   *resourceInfraSsidsRadiusServerModel    `tfsdk:"radius_server"`
-	UserGroups     []resourceInfraSsidsUserGroupsModel     `tfsdk:"user_groups"`
+	ID                    types.String                            `tfsdk:"id"`
+	PrimaryKey            types.String                            `tfsdk:"primary_key"`
+	WifiSsid              types.String                            `tfsdk:"wifi_ssid"`
+	BroadcastSsid         types.String                            `tfsdk:"broadcast_ssid"`
+	ClientLimit           types.Int64                             `tfsdk:"client_limit"`
+	SecurityMode          types.String                            `tfsdk:"security_mode"`
+	CaptivePortal         types.Bool                              `tfsdk:"captive_portal"`
+	SecurityGroups        []resourceInfraSsidsSecurityGroupsModel `tfsdk:"security_groups"`
+	PreSharedKey          types.String                            `tfsdk:"pre_shared_key"`
+	PreSharedKeyWo        types.String                            `tfsdk:"pre_shared_key_wo"`
+	PreSharedKeyWoVersion types.Int64                             `tfsdk:"pre_shared_key_wo_version"`
+	RadiusServer          *resourceInfraSsidsRadiusServerModel    `tfsdk:"radius_server"`
+	UserGroups            []resourceInfraSsidsUserGroupsModel     `tfsdk:"user_groups"`
+	DeletionProtection    types.Bool                              `tfsdk:"deletion_protection"`
+	AdoptExisting         types.Bool                              `tfsdk:"adopt_existing"`
+	ForceDetach           types.Bool                              `tfsdk:"force_detach"`
+	RawJson               types.String                            `tfsdk:"raw_json"`
+	Timeouts              timeouts.Value                          `tfsdk:"timeouts"`
 }
 
 func (r *resourceInfraSsids) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,7 +61,9 @@ func (r *resourceInfraSsids) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *resourceInfraSsids) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 2,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -57,6 +71,9 @@ func (r *resourceInfraSsids) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 10),
@@ -74,7 +91,10 @@ func (r *resourceInfraSsids) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed: true,
 				Optional: true,
 			},
-			"client_limit": schema.Float64Attribute{
+			"client_limit": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -90,10 +110,25 @@ func (r *resourceInfraSsids) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional: true,
 			},
 			"pre_shared_key": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
-			"security_groups": schema.ListNestedAttribute{
+			"pre_shared_key_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `pre_shared_key`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("pre_shared_key")),
+					stringvalidator.AlsoRequires(path.MatchRoot("pre_shared_key_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"pre_shared_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `pre_shared_key_wo`. The value of `pre_shared_key_wo` is sent on every create and update, change the version to send a new value when no other attribute changes.",
+				Optional:            true,
+			},
+			"security_groups": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -129,7 +164,7 @@ func (r *resourceInfraSsids) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed: true,
 				Optional: true,
 			},
-			"user_groups": schema.ListNestedAttribute{
+			"user_groups": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"datasource": schema.StringAttribute{
@@ -145,6 +180,42 @@ func (r *resourceInfraSsids) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceInfraSsids) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validateDiscriminator(path.MatchRoot("security_mode"), map[string]discriminatorVariant{
+			"wpa2-only-personal": {
+				required:    [][]string{{"pre_shared_key", "pre_shared_key_wo"}},
+				conflicting: []string{"radius_server"},
+			},
+			"wpa2-only-personal+captive-portal": {
+				required:    [][]string{{"pre_shared_key", "pre_shared_key_wo"}},
+				conflicting: []string{"radius_server"},
+			},
+			"wpa3-sae": {
+				required:    [][]string{{"pre_shared_key", "pre_shared_key_wo"}},
+				conflicting: []string{"radius_server"},
+			},
+			"wpa2-only-enterprise": {
+				required:    [][]string{{"radius_server", "user_groups"}},
+				conflicting: []string{"pre_shared_key", "pre_shared_key_wo"},
+			},
+			"wpa3-only-enterprise": {
+				required:    [][]string{{"radius_server", "user_groups"}},
+				conflicting: []string{"pre_shared_key", "pre_shared_key_wo"},
+			},
+			"open": {
+				conflicting: []string{"pre_shared_key", "pre_shared_key_wo", "radius_server"},
+			},
+			"captive-portal": {
+				conflicting: []string{"pre_shared_key", "pre_shared_key_wo", "radius_server"},
+			},
+		}),
 	}
 }
 
@@ -170,6 +241,20 @@ func (r *resourceInfraSsids) Configure(ctx context.Context, req resource.Configu
 	r.resourceName = "fortisase_infra_ssids"
 }
 
+func (r *resourceInfraSsids) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
+func (r *resourceInfraSsids) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+		// 1.2.0 changed the unordered lists security_groups and user_groups to sets.
+		keepState(),
+	)
+}
+
 func (r *resourceInfraSsids) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("InfraSsids")
 	lock.Lock()
@@ -179,12 +264,16 @@ func (r *resourceInfraSsids) Create(ctx context.Context, req resource.CreateRequ
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectInfraSsids(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectInfraSsids(ctx, "create", diags))
 
@@ -192,6 +281,17 @@ func (r *resourceInfraSsids) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 	output, err := c.CreateInfraSsids(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectInfraSsids(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectInfraSsids(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadInfraSsids, c.UpdateInfraSsids, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -203,10 +303,11 @@ func (r *resourceInfraSsids) Create(ctx context.Context, req resource.CreateRequ
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectInfraSsids(ctx, "read", diags))
 
-	read_output, err := c.ReadInfraSsids(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadInfraSsids, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -238,17 +339,22 @@ func (r *resourceInfraSsids) Update(ctx context.Context, req resource.UpdateRequ
 
 	var data resourceInfraSsidsModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectInfraSsids(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectInfraSsids(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -264,10 +370,11 @@ func (r *resourceInfraSsids) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectInfraSsids(ctx, "read", diags))
 
-	read_output, err := c.ReadInfraSsids(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadInfraSsids, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -297,14 +404,25 @@ func (r *resourceInfraSsids) Delete(ctx context.Context, req resource.DeleteRequ
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectInfraSsids(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "infra/ssids", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteInfraSsids(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -325,11 +443,14 @@ func (r *resourceInfraSsids) Read(ctx context.Context, req resource.ReadRequest,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectInfraSsids(ctx, "read", diags))
 
@@ -360,6 +481,8 @@ func (m *resourceInfraSsidsModel) refreshInfraSsids(ctx context.Context, o map[s
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["wifiSsid"]; ok {
 		m.WifiSsid = parseStringValue(v)
 	}
@@ -369,7 +492,7 @@ func (m *resourceInfraSsidsModel) refreshInfraSsids(ctx context.Context, o map[s
 	}
 
 	if v, ok := o["clientLimit"]; ok {
-		m.ClientLimit = parseFloat64Value(v)
+		m.ClientLimit = parseInt64Value(v)
 	}
 
 	if v, ok := o["securityMode"]; ok {
@@ -414,7 +537,7 @@ func (data *resourceInfraSsidsModel) getCreateObjectInfraSsids(ctx context.Conte
 	}
 
 	if !data.ClientLimit.IsNull() {
-		result["clientLimit"] = data.ClientLimit.ValueFloat64()
+		result["clientLimit"] = data.ClientLimit.ValueInt64()
 	}
 
 	if !data.SecurityMode.IsNull() {
@@ -433,6 +556,10 @@ func (data *resourceInfraSsidsModel) getCreateObjectInfraSsids(ctx context.Conte
 		result["preSharedKey"] = data.PreSharedKey.ValueString()
 	}
 
+	if !data.PreSharedKeyWo.IsNull() {
+		result["preSharedKey"] = data.PreSharedKeyWo.ValueString()
+	}
+
 	if data.RadiusServer != nil && !isZeroStruct(*data.RadiusServer) {
 		result["radiusServer"] = data.RadiusServer.expandInfraSsidsRadiusServer(ctx, diags)
 	}
@@ -459,7 +586,7 @@ func (data *resourceInfraSsidsModel) getUpdateObjectInfraSsids(ctx context.Conte
 	}
 
 	if !data.ClientLimit.IsNull() {
-		result["clientLimit"] = data.ClientLimit.ValueFloat64()
+		result["clientLimit"] = data.ClientLimit.ValueInt64()
 	}
 
 	if !data.SecurityMode.IsNull() {
@@ -486,6 +613,10 @@ func (data *resourceInfraSsidsModel) getUpdateObjectInfraSsids(ctx context.Conte
 		result["userGroups"] = data.expandInfraSsidsUserGroupsList(ctx, data.UserGroups, diags)
 	}
 
+	if !data.PreSharedKeyWo.IsNull() {
+		result["preSharedKey"] = data.PreSharedKeyWo.ValueString()
+	}
+
 	return &result
 }
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -38,6 +39,9 @@ type resourceNetworkDnsRulesModel struct {
 	Domains        types.Set                                             `tfsdk:"domains"`
 	PopDnsOverride map[string]resourceNetworkDnsRulesPopDnsOverrideModel `tfsdk:"pop_dns_override"`
 	ForPrivate     types.Bool                                            `tfsdk:"for_private"`
+	AdoptExisting  types.Bool                                            `tfsdk:"adopt_existing"`
+	RawJson        types.String                                          `tfsdk:"raw_json"`
+	Timeouts       timeouts.Value                                        `tfsdk:"timeouts"`
 }
 
 func (r *resourceNetworkDnsRules) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -54,6 +58,8 @@ func (r *resourceNetworkDnsRules) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(30),
@@ -101,6 +107,9 @@ func (r *resourceNetworkDnsRules) Schema(ctx context.Context, req resource.Schem
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -138,9 +147,12 @@ func (r *resourceNetworkDnsRules) Create(ctx context.Context, req resource.Creat
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectNetworkDnsRules(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectNetworkDnsRules(ctx, "create", diags))
 
@@ -148,6 +160,17 @@ func (r *resourceNetworkDnsRules) Create(ctx context.Context, req resource.Creat
 		return
 	}
 	output, err := c.CreateNetworkDnsRules(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectNetworkDnsRules(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectNetworkDnsRules(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadNetworkDnsRules, c.UpdateNetworkDnsRules, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -159,10 +182,11 @@ func (r *resourceNetworkDnsRules) Create(ctx context.Context, req resource.Creat
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectNetworkDnsRules(ctx, "read", diags))
 
-	read_output, err := c.ReadNetworkDnsRules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadNetworkDnsRules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -197,14 +221,18 @@ func (r *resourceNetworkDnsRules) Update(ctx context.Context, req resource.Updat
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectNetworkDnsRules(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectNetworkDnsRules(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -220,10 +248,11 @@ func (r *resourceNetworkDnsRules) Update(ctx context.Context, req resource.Updat
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectNetworkDnsRules(ctx, "read", diags))
 
-	read_output, err := c.ReadNetworkDnsRules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadNetworkDnsRules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -253,11 +282,14 @@ func (r *resourceNetworkDnsRules) Delete(ctx context.Context, req resource.Delet
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectNetworkDnsRules(ctx, "delete", diags))
 
@@ -281,11 +313,14 @@ func (r *resourceNetworkDnsRules) Read(ctx context.Context, req resource.ReadReq
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectNetworkDnsRules(ctx, "read", diags))
 
@@ -316,6 +351,8 @@ func (m *resourceNetworkDnsRulesModel) refreshNetworkDnsRules(ctx context.Contex
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["primaryDns"]; ok {
 		m.PrimaryDns = parseStringValue(v)
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceNetworkHostGroups{}
+var _ resource.ResourceWithModifyPlan = &resourceNetworkHostGroups{}
+var _ resource.ResourceWithUpgradeState = &resourceNetworkHostGroups{}
 
 func newResourceNetworkHostGroups() resource.Resource {
 	return &resourceNetworkHostGroups{}
@@ -30,9 +33,13 @@ type resourceNetworkHostGroups struct {
 
 // resourceNetworkHostGroupsModel describes the resource data model.
 type resourceNetworkHostGroupsModel struct {
-	ID         types.String                            `tfsdk:"id"`
-	PrimaryKey types.String                            `tfsdk:"primary_key"`
-	Members    []resourceNetworkHostGroupsMembersModel `tfsdk:"members"`
+	ID            types.String                            `tfsdk:"id"`
+	PrimaryKey    types.String                            `tfsdk:"primary_key"`
+	Members       []resourceNetworkHostGroupsMembersModel `tfsdk:"members"`
+	AdoptExisting types.Bool                              `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool                              `tfsdk:"force_detach"`
+	RawJson       types.String                            `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value                          `tfsdk:"timeouts"`
 }
 
 func (r *resourceNetworkHostGroups) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +48,7 @@ func (r *resourceNetworkHostGroups) Metadata(ctx context.Context, req resource.M
 
 func (r *resourceNetworkHostGroups) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -49,13 +57,16 @@ func (r *resourceNetworkHostGroups) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
 				},
 				Required: true,
 			},
-			"members": schema.ListNestedAttribute{
+			"members": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -75,6 +86,9 @@ func (r *resourceNetworkHostGroups) Schema(ctx context.Context, req resource.Sch
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -100,6 +114,17 @@ func (r *resourceNetworkHostGroups) Configure(ctx context.Context, req resource.
 	r.resourceName = "fortisase_network_host_groups"
 }
 
+func (r *resourceNetworkHostGroups) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceNetworkHostGroups) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered list members to a set.
+		keepState(),
+	)
+}
+
 func (r *resourceNetworkHostGroups) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("NetworkHostGroups")
 	lock.Lock()
@@ -112,9 +137,12 @@ func (r *resourceNetworkHostGroups) Create(ctx context.Context, req resource.Cre
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectNetworkHostGroups(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectNetworkHostGroups(ctx, "create", diags))
 
@@ -122,6 +150,17 @@ func (r *resourceNetworkHostGroups) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	output, err := c.CreateNetworkHostGroups(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectNetworkHostGroups(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectNetworkHostGroups(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadNetworkHostGroups, c.UpdateNetworkHostGroups, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -133,10 +172,11 @@ func (r *resourceNetworkHostGroups) Create(ctx context.Context, req resource.Cre
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectNetworkHostGroups(ctx, "read", diags))
 
-	read_output, err := c.ReadNetworkHostGroups(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadNetworkHostGroups, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -171,14 +211,18 @@ func (r *resourceNetworkHostGroups) Update(ctx context.Context, req resource.Upd
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectNetworkHostGroups(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectNetworkHostGroups(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -194,10 +238,11 @@ func (r *resourceNetworkHostGroups) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectNetworkHostGroups(ctx, "read", diags))
 
-	read_output, err := c.ReadNetworkHostGroups(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadNetworkHostGroups, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -227,14 +272,21 @@ func (r *resourceNetworkHostGroups) Delete(ctx context.Context, req resource.Del
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectNetworkHostGroups(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "network/host-groups", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteNetworkHostGroups(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -255,11 +307,14 @@ func (r *resourceNetworkHostGroups) Read(ctx context.Context, req resource.ReadR
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectNetworkHostGroups(ctx, "read", diags))
 
@@ -290,6 +345,8 @@ func (m *resourceNetworkHostGroupsModel) refreshNetworkHostGroups(ctx context.Co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["members"]; ok {
 		m.Members = m.flattenNetworkHostGroupsMembersList(ctx, v, &diags)
 	}
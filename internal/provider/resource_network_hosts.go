@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceNetworkHosts{}
+var _ resource.ResourceWithConfigValidators = &resourceNetworkHosts{}
 
 func newResourceNetworkHosts() resource.Resource {
 	return &resourceNetworkHosts{}
@@ -30,15 +32,19 @@ type resourceNetworkHosts struct {
 
 // resourceNetworkHostsModel describes the resource data model.
 type resourceNetworkHostsModel struct {
-	ID         types.String `tfsdk:"id"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	Type       types.String `tfsdk:"type"`
-	Location   types.String `tfsdk:"location"`
-	Subnet     types.String `tfsdk:"subnet"`
-	StartIp    types.String `tfsdk:"start_ip"`
-	EndIp      types.String `tfsdk:"end_ip"`
-	Fqdn       types.String `tfsdk:"fqdn"`
-	CountryId  types.String `tfsdk:"country_id"`
+	ID            types.String   `tfsdk:"id"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	Type          types.String   `tfsdk:"type"`
+	Location      types.String   `tfsdk:"location"`
+	Subnet        subnetValue    `tfsdk:"subnet"`
+	StartIp       ipAddressValue `tfsdk:"start_ip"`
+	EndIp         ipAddressValue `tfsdk:"end_ip"`
+	Fqdn          fqdnValue      `tfsdk:"fqdn"`
+	CountryId     types.String   `tfsdk:"country_id"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool     `tfsdk:"force_detach"`
+	RawJson       types.String   `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceNetworkHosts) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,6 +61,9 @@ func (r *resourceNetworkHosts) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -76,18 +85,22 @@ func (r *resourceNetworkHosts) Schema(ctx context.Context, req resource.SchemaRe
 				Optional: true,
 			},
 			"subnet": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				CustomType: subnetType{},
+				Computed:   true,
+				Optional:   true,
 			},
 			"start_ip": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				CustomType: ipAddressType{},
+				Computed:   true,
+				Optional:   true,
 			},
 			"end_ip": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				CustomType: ipAddressType{},
+				Computed:   true,
+				Optional:   true,
 			},
 			"fqdn": schema.StringAttribute{
+				CustomType: fqdnType{},
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 255),
 				},
@@ -102,6 +115,32 @@ func (r *resourceNetworkHosts) Schema(ctx context.Context, req resource.SchemaRe
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceNetworkHosts) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validateDiscriminator(path.MatchRoot("type"), map[string]discriminatorVariant{
+			"ipmask": {
+				required:    [][]string{{"subnet"}},
+				conflicting: []string{"start_ip", "end_ip", "fqdn", "country_id"},
+			},
+			"iprange": {
+				required:    [][]string{{"start_ip"}, {"end_ip"}},
+				conflicting: []string{"subnet", "fqdn", "country_id"},
+			},
+			"fqdn": {
+				required:    [][]string{{"fqdn"}},
+				conflicting: []string{"subnet", "start_ip", "end_ip", "country_id"},
+			},
+			"geography": {
+				required:    [][]string{{"country_id"}},
+				conflicting: []string{"subnet", "start_ip", "end_ip", "fqdn"},
+			},
+		}),
 	}
 }
 
@@ -139,9 +178,12 @@ func (r *resourceNetworkHosts) Create(ctx context.Context, req resource.CreateRe
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectNetworkHosts(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectNetworkHosts(ctx, "create", diags))
 
@@ -149,6 +191,17 @@ func (r *resourceNetworkHosts) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 	output, err := c.CreateNetworkHosts(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectNetworkHosts(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectNetworkHosts(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadNetworkHosts, c.UpdateNetworkHosts, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -160,10 +213,11 @@ func (r *resourceNetworkHosts) Create(ctx context.Context, req resource.CreateRe
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectNetworkHosts(ctx, "read", diags))
 
-	read_output, err := c.ReadNetworkHosts(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadNetworkHosts, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -198,14 +252,18 @@ func (r *resourceNetworkHosts) Update(ctx context.Context, req resource.UpdateRe
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectNetworkHosts(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectNetworkHosts(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -221,10 +279,11 @@ func (r *resourceNetworkHosts) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectNetworkHosts(ctx, "read", diags))
 
-	read_output, err := c.ReadNetworkHosts(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadNetworkHosts, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -254,14 +313,21 @@ func (r *resourceNetworkHosts) Delete(ctx context.Context, req resource.DeleteRe
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectNetworkHosts(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "network/hosts", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteNetworkHosts(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -282,11 +348,14 @@ func (r *resourceNetworkHosts) Read(ctx context.Context, req resource.ReadReques
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectNetworkHosts(ctx, "read", diags))
 
@@ -317,6 +386,8 @@ func (m *resourceNetworkHostsModel) refreshNetworkHosts(ctx context.Context, o m
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["type"]; ok {
 		m.Type = parseStringValue(v)
 	}
@@ -326,19 +397,19 @@ func (m *resourceNetworkHostsModel) refreshNetworkHosts(ctx context.Context, o m
 	}
 
 	if v, ok := o["subnet"]; ok {
-		m.Subnet = parseStringValue(v)
+		m.Subnet = subnetValue{parseStringValue(v)}
 	}
 
 	if v, ok := o["startIp"]; ok {
-		m.StartIp = parseStringValue(v)
+		m.StartIp = ipAddressValue{parseStringValue(v)}
 	}
 
 	if v, ok := o["endIp"]; ok {
-		m.EndIp = parseStringValue(v)
+		m.EndIp = ipAddressValue{parseStringValue(v)}
 	}
 
 	if v, ok := o["fqdn"]; ok {
-		m.Fqdn = parseStringValue(v)
+		m.Fqdn = fqdnValue{parseStringValue(v)}
 	}
 
 	if v, ok := o["countryId"]; ok {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -31,13 +32,15 @@ type resourceNetworkImplicitDnsRules struct {
 
 // resourceNetworkImplicitDnsRulesModel describes the resource data model.
 type resourceNetworkImplicitDnsRulesModel struct {
-	ID         types.String `tfsdk:"id"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	DnsServer  types.String `tfsdk:"dns_server"`
-	DnsServer1 types.String `tfsdk:"dns_server1"`
-	DnsServer2 types.String `tfsdk:"dns_server2"`
-	Protocols  types.Set    `tfsdk:"protocols"`
-	ForPrivate types.Bool   `tfsdk:"for_private"`
+	ID         types.String   `tfsdk:"id"`
+	PrimaryKey types.String   `tfsdk:"primary_key"`
+	DnsServer  types.String   `tfsdk:"dns_server"`
+	DnsServer1 types.String   `tfsdk:"dns_server1"`
+	DnsServer2 types.String   `tfsdk:"dns_server2"`
+	Protocols  types.Set      `tfsdk:"protocols"`
+	ForPrivate types.Bool     `tfsdk:"for_private"`
+	RawJson    types.String   `tfsdk:"raw_json"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceNetworkImplicitDnsRules) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -54,6 +57,7 @@ func (r *resourceNetworkImplicitDnsRules) Schema(ctx context.Context, req resour
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("vpn", "other", "implicit_all"),
@@ -88,6 +92,9 @@ func (r *resourceNetworkImplicitDnsRules) Schema(ctx context.Context, req resour
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -125,9 +132,12 @@ func (r *resourceNetworkImplicitDnsRules) Create(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectNetworkImplicitDnsRules(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectNetworkImplicitDnsRules(ctx, "create", diags))
@@ -147,10 +157,11 @@ func (r *resourceNetworkImplicitDnsRules) Create(ctx context.Context, req resour
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectNetworkImplicitDnsRules(ctx, "read", diags))
 
-	read_output, err := c.ReadNetworkImplicitDnsRules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadNetworkImplicitDnsRules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -185,14 +196,18 @@ func (r *resourceNetworkImplicitDnsRules) Update(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectNetworkImplicitDnsRules(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectNetworkImplicitDnsRules(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -208,10 +223,11 @@ func (r *resourceNetworkImplicitDnsRules) Update(ctx context.Context, req resour
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectNetworkImplicitDnsRules(ctx, "read", diags))
 
-	read_output, err := c.ReadNetworkImplicitDnsRules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadNetworkImplicitDnsRules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -242,11 +258,14 @@ func (r *resourceNetworkImplicitDnsRules) Read(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectNetworkImplicitDnsRules(ctx, "read", diags))
 
@@ -277,6 +296,8 @@ func (m *resourceNetworkImplicitDnsRulesModel) refreshNetworkImplicitDnsRules(ct
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["dnsServer"]; ok {
 		m.DnsServer = parseStringValue(v)
 	}
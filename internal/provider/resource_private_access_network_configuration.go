@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,14 +32,16 @@ type resourcePrivateAccessNetworkConfiguration struct {
 
 // resourcePrivateAccessNetworkConfigurationModel describes the resource data model.
 type resourcePrivateAccessNetworkConfigurationModel struct {
-	ID                 types.String `tfsdk:"id"`
-	BgpRouterIdsSubnet types.String `tfsdk:"bgp_router_ids_subnet"`
-	AsNumber           types.String `tfsdk:"as_number"`
-	RecursiveNextHop   types.Bool   `tfsdk:"recursive_next_hop"`
-	SdwanRuleEnable    types.Bool   `tfsdk:"sdwan_rule_enable"`
-	SdwanHealthCheckVm types.String `tfsdk:"sdwan_health_check_vm"`
-	ConfigState        types.String `tfsdk:"config_state"`
-	BgpDesign          types.String `tfsdk:"bgp_design"`
+	ID                 types.String   `tfsdk:"id"`
+	BgpRouterIdsSubnet subnetValue    `tfsdk:"bgp_router_ids_subnet"`
+	AsNumber           types.String   `tfsdk:"as_number"`
+	RecursiveNextHop   types.Bool     `tfsdk:"recursive_next_hop"`
+	SdwanRuleEnable    types.Bool     `tfsdk:"sdwan_rule_enable"`
+	SdwanHealthCheckVm types.String   `tfsdk:"sdwan_health_check_vm"`
+	ConfigState        types.String   `tfsdk:"config_state"`
+	BgpDesign          types.String   `tfsdk:"bgp_design"`
+	RawJson            types.String   `tfsdk:"raw_json"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourcePrivateAccessNetworkConfiguration) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +51,7 @@ func (r *resourcePrivateAccessNetworkConfiguration) Metadata(ctx context.Context
 func (r *resourcePrivateAccessNetworkConfiguration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"raw_json": rawJSONAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -56,6 +60,7 @@ func (r *resourcePrivateAccessNetworkConfiguration) Schema(ctx context.Context,
 				},
 			},
 			"bgp_router_ids_subnet": schema.StringAttribute{
+				CustomType:          subnetType{},
 				MarkdownDescription: "Available/unused subnet that can be used to assign loopback interface IP addresses used for BGP router IDs parameter on the FortiSASE security PoPs. /28 is the minimum subnet size.",
 				Computed:            true,
 				Optional:            true,
@@ -96,6 +101,9 @@ func (r *resourcePrivateAccessNetworkConfiguration) Schema(ctx context.Context,
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -130,9 +138,12 @@ func (r *resourcePrivateAccessNetworkConfiguration) Create(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectPrivateAccessNetworkConfiguration(ctx, diags))
 
 	if diags.HasError() {
@@ -150,11 +161,12 @@ func (r *resourcePrivateAccessNetworkConfiguration) Create(ctx context.Context,
 	mkey := "PrivateAccessNetworkConfiguration"
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
 	read_output := make(map[string]interface{})
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	pending := true
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadPrivateAccessNetworkConfiguration(&read_input_model)
 		if err != nil {
 			diags.AddError(
@@ -168,6 +180,7 @@ func (r *resourcePrivateAccessNetworkConfiguration) Create(ctx context.Context,
 				continue
 			}
 		}
+		pending = false
 		break
 	}
 
@@ -177,6 +190,13 @@ func (r *resourcePrivateAccessNetworkConfiguration) Create(ctx context.Context,
 	}
 
 	diags.Append(resp.State.Set(ctx, &data)...)
+	if pending {
+		// The state is kept, so the resource is tainted and replaced by the next apply
+		diags.AddError(
+			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, ctx.Err()),
+			pollTimeoutDetail("config_state", read_output["config_state"]),
+		)
+	}
 }
 
 func (r *resourcePrivateAccessNetworkConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -194,14 +214,18 @@ func (r *resourcePrivateAccessNetworkConfiguration) Update(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectPrivateAccessNetworkConfiguration(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 
 	if diags.HasError() {
 		return
@@ -216,11 +240,12 @@ func (r *resourcePrivateAccessNetworkConfiguration) Update(ctx context.Context,
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
 	read_output := make(map[string]interface{})
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	pending := true
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadPrivateAccessNetworkConfiguration(&read_input_model)
 		if err != nil {
 			diags.AddError(
@@ -234,6 +259,7 @@ func (r *resourcePrivateAccessNetworkConfiguration) Update(ctx context.Context,
 				continue
 			}
 		}
+		pending = false
 		break
 	}
 
@@ -243,6 +269,12 @@ func (r *resourcePrivateAccessNetworkConfiguration) Update(ctx context.Context,
 	}
 
 	diags.Append(resp.State.Set(ctx, &data)...)
+	if pending {
+		diags.AddError(
+			fmt.Sprintf("Error to update resource %s: %v", r.resourceName, ctx.Err()),
+			pollTimeoutDetail("config_state", read_output["config_state"]),
+		)
+	}
 }
 
 func (r *resourcePrivateAccessNetworkConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -255,11 +287,14 @@ func (r *resourcePrivateAccessNetworkConfiguration) Delete(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 
 	output, err := c.DeletePrivateAccessNetworkConfiguration(&input_model)
@@ -271,8 +306,7 @@ func (r *resourcePrivateAccessNetworkConfiguration) Delete(ctx context.Context,
 		return
 	}
 	read_output := make(map[string]interface{})
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadPrivateAccessNetworkConfiguration(&input_model)
 		if err != nil || len(read_output) == 0 {
 			// Delete success
@@ -280,8 +314,8 @@ func (r *resourcePrivateAccessNetworkConfiguration) Delete(ctx context.Context,
 		}
 	}
 	diags.AddError(
-		fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
-		fmt.Sprintf("The resource still exists %s: %v", r.resourceName, read_output),
+		fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, ctx.Err()),
+		fmt.Sprintf("The timeout expired while the resource still exists: %v. Increase the timeout in the timeouts block.", read_output),
 	)
 }
 
@@ -295,11 +329,14 @@ func (r *resourcePrivateAccessNetworkConfiguration) Read(ctx context.Context, re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 
 	read_output, err := c.ReadPrivateAccessNetworkConfiguration(&input_model)
@@ -329,8 +366,10 @@ func (m *resourcePrivateAccessNetworkConfigurationModel) refreshPrivateAccessNet
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["bgp_router_ids_subnet"]; ok {
-		m.BgpRouterIdsSubnet = parseStringValue(v)
+		m.BgpRouterIdsSubnet = subnetValue{parseStringValue(v)}
 	}
 
 	if v, ok := o["as_number"]; ok {
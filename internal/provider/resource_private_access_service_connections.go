@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -20,6 +21,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourcePrivateAccessServiceConnections{}
+var _ resource.ResourceWithModifyPlan = &resourcePrivateAccessServiceConnections{}
+var _ resource.ResourceWithUpgradeState = &resourcePrivateAccessServiceConnections{}
 
 func newResourcePrivateAccessServiceConnections() resource.Resource {
 	return &resourcePrivateAccessServiceConnections{}
@@ -32,28 +35,33 @@ type resourcePrivateAccessServiceConnections struct {
 
 // resourcePrivateAccessServiceConnectionsModel describes the resource data model.
 type resourcePrivateAccessServiceConnectionsModel struct {
-	ID                  types.String                                              `tfsdk:"id"`
-	Alias               types.String                                              `tfsdk:"alias"`
-	BgpPeerIp           types.String                                              `tfsdk:"bgp_peer_ip"`
-	IpsecRemoteGw       types.String                                              `tfsdk:"ipsec_remote_gw"`
-	OverlayNetworkId    types.String                                              `tfsdk:"overlay_network_id"`
-	RouteMapTag         types.String                                              `tfsdk:"route_map_tag"`
-	Auth                types.String                                              `tfsdk:"auth"`
-	IpsecPreSharedKey   types.String                                              `tfsdk:"ipsec_pre_shared_key"`
-	IpsecCertName       types.String                                              `tfsdk:"ipsec_cert_name"`
-	IpsecIkeVersion     types.String                                              `tfsdk:"ipsec_ike_version"`
-	IpsecPeerName       types.String                                              `tfsdk:"ipsec_peer_name"`
-	BackupLinks         []resourcePrivateAccessServiceConnectionsBackupLinksModel `tfsdk:"backup_links"`
-	Ftntid              types.String                                              `tfsdk:"ftntid"`
-	Type                types.String                                              `tfsdk:"type"`
-	ConfigState         types.String                                              `tfsdk:"config_state"`
-	SeqNum              types.Float64                                             `tfsdk:"seq_num"`
-	FailedMessage       types.String                                              `tfsdk:"failed_message"`
-	Config              *resourcePrivateAccessServiceConnectionsConfigModel       `tfsdk:"config"`
-	CommonConfig        *resourcePrivateAccessServiceConnectionsCommonConfigModel `tfsdk:"common_config"`
-	IpAssigned          []resourcePrivateAccessServiceConnectionsIpAssignedModel  `tfsdk:"ip_assigned"`
-	RegionCost          types.Map                                                 `tfsdk:"region_cost"`
-	ServiceConnectionId types.String                                              `tfsdk:"service_connection_id"`
+	ID                         types.String                                              `tfsdk:"id"`
+	Alias                      types.String                                              `tfsdk:"alias"`
+	BgpPeerIp                  types.String                                              `tfsdk:"bgp_peer_ip"`
+	IpsecRemoteGw              types.String                                              `tfsdk:"ipsec_remote_gw"`
+	OverlayNetworkId           types.String                                              `tfsdk:"overlay_network_id"`
+	RouteMapTag                types.String                                              `tfsdk:"route_map_tag"`
+	Auth                       types.String                                              `tfsdk:"auth"`
+	IpsecPreSharedKey          types.String                                              `tfsdk:"ipsec_pre_shared_key"`
+	IpsecPreSharedKeyWo        types.String                                              `tfsdk:"ipsec_pre_shared_key_wo"`
+	IpsecPreSharedKeyWoVersion types.Int64                                               `tfsdk:"ipsec_pre_shared_key_wo_version"`
+	IpsecCertName              types.String                                              `tfsdk:"ipsec_cert_name"`
+	IpsecIkeVersion            types.String                                              `tfsdk:"ipsec_ike_version"`
+	IpsecPeerName              types.String                                              `tfsdk:"ipsec_peer_name"`
+	BackupLinks                []resourcePrivateAccessServiceConnectionsBackupLinksModel `tfsdk:"backup_links"`
+	Ftntid                     types.String                                              `tfsdk:"ftntid"`
+	Type                       types.String                                              `tfsdk:"type"`
+	ConfigState                types.String                                              `tfsdk:"config_state"`
+	SeqNum                     types.Int64                                               `tfsdk:"seq_num"`
+	FailedMessage              types.String                                              `tfsdk:"failed_message"`
+	Config                     *resourcePrivateAccessServiceConnectionsConfigModel       `tfsdk:"config"`
+	CommonConfig               *resourcePrivateAccessServiceConnectionsCommonConfigModel `tfsdk:"common_config"`
+	IpAssigned                 []resourcePrivateAccessServiceConnectionsIpAssignedModel  `tfsdk:"ip_assigned"`
+	RegionCost                 types.Map                                                 `tfsdk:"region_cost"`
+	ServiceConnectionId        types.String                                              `tfsdk:"service_connection_id"`
+	DeletionProtection         types.Bool                                                `tfsdk:"deletion_protection"`
+	RawJson                    types.String                                              `tfsdk:"raw_json"`
+	Timeouts                   timeouts.Value                                            `tfsdk:"timeouts"`
 }
 
 func (r *resourcePrivateAccessServiceConnections) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,7 +70,10 @@ func (r *resourcePrivateAccessServiceConnections) Metadata(ctx context.Context,
 
 func (r *resourcePrivateAccessServiceConnections) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
+			"raw_json":            rawJSONAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -99,6 +110,21 @@ func (r *resourcePrivateAccessServiceConnections) Schema(ctx context.Context, re
 			},
 			"ipsec_pre_shared_key": schema.StringAttribute{
 				MarkdownDescription: "IPSEC auth by pre shared key.",
+				Sensitive:           true,
+				Optional:            true,
+			},
+			"ipsec_pre_shared_key_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `ipsec_pre_shared_key`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ipsec_pre_shared_key")),
+					stringvalidator.AlsoRequires(path.MatchRoot("ipsec_pre_shared_key_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"ipsec_pre_shared_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `ipsec_pre_shared_key_wo`. The value of `ipsec_pre_shared_key_wo` is sent on every create and update, change the version to send a new value when no other attribute changes.",
 				Optional:            true,
 			},
 			"ipsec_cert_name": schema.StringAttribute{
@@ -135,7 +161,7 @@ func (r *resourcePrivateAccessServiceConnections) Schema(ctx context.Context, re
 				MarkdownDescription: "Configuration state of service connection.\nSupported values: success, failed, creating, updating, deleting.",
 				Computed:            true,
 			},
-			"seq_num": schema.Float64Attribute{
+			"seq_num": schema.Int64Attribute{
 				MarkdownDescription: "sequential unique number for service connection",
 				Computed:            true,
 			},
@@ -198,6 +224,7 @@ func (r *resourcePrivateAccessServiceConnections) Schema(ctx context.Context, re
 						},
 						"ipsec_pre_shared_key": schema.StringAttribute{
 							MarkdownDescription: "IPSEC auth by pre shared key.",
+							Sensitive:           true,
 							Computed:            true,
 							Optional:            true,
 						},
@@ -403,6 +430,9 @@ func (r *resourcePrivateAccessServiceConnections) Schema(ctx context.Context, re
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -428,6 +458,17 @@ func (r *resourcePrivateAccessServiceConnections) Configure(ctx context.Context,
 	r.resourceName = "fortisase_private_access_service_connections"
 }
 
+func (r *resourcePrivateAccessServiceConnections) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
+func (r *resourcePrivateAccessServiceConnections) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourcePrivateAccessServiceConnections) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("PrivateAccessServiceConnections")
 	lock.Lock()
@@ -437,12 +478,16 @@ func (r *resourcePrivateAccessServiceConnections) Create(ctx context.Context, re
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectPrivateAccessServiceConnections(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectPrivateAccessServiceConnections(ctx, "create", diags))
 
@@ -461,12 +506,13 @@ func (r *resourcePrivateAccessServiceConnections) Create(ctx context.Context, re
 	mkey := fmt.Sprintf("%v", output["id"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectPrivateAccessServiceConnections(ctx, "read", diags))
 
 	read_output := make(map[string]interface{})
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	pending := true
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadPrivateAccessServiceConnections(&read_input_model)
 		if err != nil {
 			diags.AddError(
@@ -500,12 +546,14 @@ func (r *resourcePrivateAccessServiceConnections) Create(ctx context.Context, re
 					"The configuration state is failed.",
 					fmt.Sprintf("The resource has been created successfully, but its configuration state is failed. Please check the configuration. Error message: %s", failedMessage),
 				)
+				pending = false
 				break
 			}
 			if v != "success" {
 				continue
 			}
 		}
+		pending = false
 		break
 	}
 
@@ -515,6 +563,13 @@ func (r *resourcePrivateAccessServiceConnections) Create(ctx context.Context, re
 	}
 
 	diags.Append(resp.State.Set(ctx, &data)...)
+	if pending {
+		// The state is kept, so the resource is tainted and replaced by the next apply
+		diags.AddError(
+			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, ctx.Err()),
+			pollTimeoutDetail("config_state", read_output["config_state"]),
+		)
+	}
 }
 
 func (r *resourcePrivateAccessServiceConnections) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -532,17 +587,22 @@ func (r *resourcePrivateAccessServiceConnections) Update(ctx context.Context, re
 
 	var data resourcePrivateAccessServiceConnectionsModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectPrivateAccessServiceConnections(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectPrivateAccessServiceConnections(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -558,12 +618,13 @@ func (r *resourcePrivateAccessServiceConnections) Update(ctx context.Context, re
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectPrivateAccessServiceConnections(ctx, "read", diags))
 
 	read_output := make(map[string]interface{})
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	pending := true
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadPrivateAccessServiceConnections(&read_input_model)
 		if err != nil {
 			diags.AddError(
@@ -596,12 +657,14 @@ func (r *resourcePrivateAccessServiceConnections) Update(ctx context.Context, re
 					"The configuration state is failed.",
 					fmt.Sprintf("The resource was updated successfully, but its configuration state is failed. Please check the configuration. Error message: %s", failedMessage),
 				)
+				pending = false
 				break
 			}
 			if v != "success" {
 				continue
 			}
 		}
+		pending = false
 		break
 	}
 
@@ -611,6 +674,12 @@ func (r *resourcePrivateAccessServiceConnections) Update(ctx context.Context, re
 	}
 
 	diags.Append(resp.State.Set(ctx, &data)...)
+	if pending {
+		diags.AddError(
+			fmt.Sprintf("Error to update resource %s: %v", r.resourceName, ctx.Err()),
+			pollTimeoutDetail("config_state", read_output["config_state"]),
+		)
+	}
 }
 
 func (r *resourcePrivateAccessServiceConnections) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -626,11 +695,18 @@ func (r *resourcePrivateAccessServiceConnections) Delete(ctx context.Context, re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectPrivateAccessServiceConnections(ctx, "delete", diags))
 
@@ -643,8 +719,7 @@ func (r *resourcePrivateAccessServiceConnections) Delete(ctx context.Context, re
 		return
 	}
 	read_output := make(map[string]interface{})
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	for waitTimeout(ctx, 10*time.Second) {
 		read_output, err = c.ReadPrivateAccessServiceConnections(&input_model)
 		if err != nil || len(read_output) == 0 {
 			// Delete success
@@ -652,8 +727,8 @@ func (r *resourcePrivateAccessServiceConnections) Delete(ctx context.Context, re
 		}
 	}
 	diags.AddError(
-		fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
-		fmt.Sprintf("The resource still exists %s: %v", r.resourceName, read_output),
+		fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, ctx.Err()),
+		fmt.Sprintf("The timeout expired while the resource still exists: %v. Increase the timeout in the timeouts block.", read_output),
 	)
 }
 
@@ -667,11 +742,14 @@ func (r *resourcePrivateAccessServiceConnections) Read(ctx context.Context, req
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectPrivateAccessServiceConnections(ctx, "read", diags))
 
@@ -702,6 +780,8 @@ func (m *resourcePrivateAccessServiceConnectionsModel) refreshPrivateAccessServi
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
 		m.Ftntid = parseStringValue(v)
 	}
@@ -715,7 +795,7 @@ func (m *resourcePrivateAccessServiceConnectionsModel) refreshPrivateAccessServi
 	}
 
 	if v, ok := o["seq_num"]; ok {
-		m.SeqNum = parseFloat64Value(v)
+		m.SeqNum = parseInt64Value(v)
 	}
 
 	if v, ok := o["failed_message"]; ok {
@@ -767,6 +847,10 @@ func (data *resourcePrivateAccessServiceConnectionsModel) getCreateObjectPrivate
 		result["ipsec_pre_shared_key"] = data.IpsecPreSharedKey.ValueString()
 	}
 
+	if !data.IpsecPreSharedKeyWo.IsNull() {
+		result["ipsec_pre_shared_key"] = data.IpsecPreSharedKeyWo.ValueString()
+	}
+
 	if !data.IpsecCertName.IsNull() {
 		result["ipsec_cert_name"] = data.IpsecCertName.ValueString()
 	}
@@ -836,6 +920,10 @@ func (data *resourcePrivateAccessServiceConnectionsModel) getUpdateObjectPrivate
 		result["ipsec_peer_name"] = data.IpsecPeerName.ValueString()
 	}
 
+	if !data.IpsecPreSharedKeyWo.IsNull() {
+		result["ipsec_pre_shared_key"] = data.IpsecPreSharedKeyWo.ValueString()
+	}
+
 	return &result
 }
 
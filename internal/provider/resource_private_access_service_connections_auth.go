@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,12 +30,13 @@ type resourcePrivateAccessServiceConnectionsAuth2Edl struct {
 
 // resourcePrivateAccessServiceConnectionsAuth2EdlModel describes the resource data model.
 type resourcePrivateAccessServiceConnectionsAuth2EdlModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Auth                types.String `tfsdk:"auth"`
-	IpsecPreSharedKey   types.String `tfsdk:"ipsec_pre_shared_key"`
-	IpsecPeerName       types.String `tfsdk:"ipsec_peer_name"`
-	IpsecCertName       types.String `tfsdk:"ipsec_cert_name"`
-	ServiceConnectionId types.String `tfsdk:"service_connection_id"`
+	ID                  types.String   `tfsdk:"id"`
+	Auth                types.String   `tfsdk:"auth"`
+	IpsecPreSharedKey   types.String   `tfsdk:"ipsec_pre_shared_key"`
+	IpsecPeerName       types.String   `tfsdk:"ipsec_peer_name"`
+	IpsecCertName       types.String   `tfsdk:"ipsec_cert_name"`
+	ServiceConnectionId types.String   `tfsdk:"service_connection_id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourcePrivateAccessServiceConnectionsAuth2Edl) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,6 +63,7 @@ func (r *resourcePrivateAccessServiceConnectionsAuth2Edl) Schema(ctx context.Con
 			},
 			"ipsec_pre_shared_key": schema.StringAttribute{
 				MarkdownDescription: "IPSEC auth by pre shared key.",
+				Sensitive:           true,
 				Computed:            true,
 				Optional:            true,
 			},
@@ -80,6 +83,9 @@ func (r *resourcePrivateAccessServiceConnectionsAuth2Edl) Schema(ctx context.Con
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutRead, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -114,9 +120,12 @@ func (r *resourcePrivateAccessServiceConnectionsAuth2Edl) Create(ctx context.Con
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectPrivateAccessServiceConnectionsAuth(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectPrivateAccessServiceConnectionsAuth(ctx, "create", diags))
 
@@ -153,14 +162,18 @@ func (r *resourcePrivateAccessServiceConnectionsAuth2Edl) Update(ctx context.Con
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectPrivateAccessServiceConnectionsAuth(ctx, state, diags))
+	// The object is never read back, there are no unmanaged fields to keep
 	input_model.URLParams = *(data.getURLObjectPrivateAccessServiceConnectionsAuth(ctx, "update", diags))
 
 	if diags.HasError() {
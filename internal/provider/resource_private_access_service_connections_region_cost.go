@@ -8,6 +8,7 @@ import (
 	"time"
 
 	forticlient "github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,8 +30,9 @@ type resourcePrivateAccessServiceConnectionsRegionCost2Edl struct {
 
 // resourcePrivateAccessServiceConnectionsRegionCost2EdlModel describes the resource data model.
 type resourcePrivateAccessServiceConnectionsRegionCost2EdlModel struct {
-	ID      types.String `tfsdk:"id"`
-	Entries types.Map    `tfsdk:"entries"`
+	ID       types.String   `tfsdk:"id"`
+	Entries  types.Map      `tfsdk:"entries"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -54,6 +56,9 @@ func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Schema(ctx conte
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutRead, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -91,9 +96,12 @@ func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Create(ctx conte
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectPrivateAccessServiceConnectionsRegionCost(ctx, diags))
 
 	if diags.HasError() {
@@ -127,10 +135,11 @@ func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Create(ctx conte
 	}
 
 	var pendingItems []string
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	pending := true
+	for waitTimeout(ctx, 10*time.Second) {
 		for _, item := range targetItems {
 			var read_input_model forticlient.InputModel
+			read_input_model.Ctx = ctx
 			read_input_model.Mkey = item
 			read_input_model.URLParams = map[string]interface{}{
 				"service-connection-id": item,
@@ -153,11 +162,19 @@ func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Create(ctx conte
 		}
 		targetItems = pendingItems
 		if len(pendingItems) == 0 {
+			pending = false
 			break
 		}
 		pendingItems = []string{}
 	}
 	diags.Append(resp.State.Set(ctx, &data)...)
+	if pending {
+		// The state is kept, so the resource is tainted and replaced by the next apply
+		diags.AddError(
+			fmt.Sprintf("Error to create resource: %v", ctx.Err()),
+			pollTimeoutDetail("config_state of the service connections "+strings.Join(targetItems, ", "), "pending"),
+		)
+	}
 }
 
 func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -179,14 +196,18 @@ func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Update(ctx conte
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectPrivateAccessServiceConnectionsRegionCost(ctx, state, diags))
+	// The object is never read back, there are no unmanaged fields to keep
 
 	if diags.HasError() {
 		return
@@ -217,10 +238,11 @@ func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Update(ctx conte
 	}
 
 	var pendingItems []string
-	for i := 0; i < 20; i++ {
-		time.Sleep(10 * time.Second)
+	pending := true
+	for waitTimeout(ctx, 10*time.Second) {
 		for _, item := range targetItems {
 			var read_input_model forticlient.InputModel
+			read_input_model.Ctx = ctx
 			read_input_model.Mkey = item
 			read_input_model.URLParams = map[string]interface{}{
 				"service-connection-id": item,
@@ -243,11 +265,18 @@ func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Update(ctx conte
 		}
 		targetItems = pendingItems
 		if len(pendingItems) == 0 {
+			pending = false
 			break
 		}
 		pendingItems = []string{}
 	}
 	diags.Append(resp.State.Set(ctx, &data)...)
+	if pending {
+		diags.AddError(
+			fmt.Sprintf("Error to update resource: %v", ctx.Err()),
+			pollTimeoutDetail("config_state of the service connections "+strings.Join(targetItems, ", "), "pending"),
+		)
+	}
 }
 
 func (r *resourcePrivateAccessServiceConnectionsRegionCost2Edl) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
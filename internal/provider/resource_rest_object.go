@@ -0,0 +1,573 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &resourceRestObject{}
+var _ resource.ResourceWithImportState = &resourceRestObject{}
+var _ resource.ResourceWithValidateConfig = &resourceRestObject{}
+
+func newResourceRestObject() resource.Resource {
+	return &resourceRestObject{}
+}
+
+type resourceRestObject struct {
+	fortiClient  *FortiClient
+	resourceName string
+}
+
+// resourceRestObjectModel describes the resource data model.
+type resourceRestObjectModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Path          types.String   `tfsdk:"path"`
+	ObjectPath    types.String   `tfsdk:"object_path"`
+	PathParams    types.Map      `tfsdk:"path_params"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	Body          jsonValue      `tfsdk:"body"`
+	CreateMethod  types.String   `tfsdk:"create_method"`
+	UpdateMethod  types.String   `tfsdk:"update_method"`
+	PartialUpdate types.Bool     `tfsdk:"partial_update"`
+	DeleteMethod  types.String   `tfsdk:"delete_method"`
+	DriftPaths    types.List     `tfsdk:"drift_paths"`
+	Response      types.String   `tfsdk:"response"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *resourceRestObject) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rest_object"
+}
+
+func (r *resourceRestObject) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an object of an endpoint of the FortiSASE API that has no dedicated resource yet. The requests go through the same authentication, retries and rate limiting as the other resources.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path the object is created at, e.g. `/resource-api/v2/network/hosts`. Placeholders such as `{direction}` are replaced with `path_params`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the object, where `{primaryKey}` is replaced with the primary key of the object. Defaults to `<path>/{primaryKey}`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path_params": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The values of the placeholders of `path` and `object_path`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_key": schema.StringAttribute{
+				MarkdownDescription: "The primary key of the object. Defaults to the `primaryKey` of the create response, or of `body`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				CustomType:          jsonType{},
+				MarkdownDescription: "The JSON object sent to create and update the object. The comparison ignores the formatting and the order of the keys.",
+				Required:            true,
+			},
+			"create_method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method creating the object, `POST` or `PUT` for singletons. Defaults to `POST`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("POST"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("POST", "PUT"),
+				},
+			},
+			"update_method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method updating the object, `PUT`, `PATCH` or `POST`. Defaults to `PUT`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("PUT"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("PUT", "PATCH", "POST"),
+				},
+			},
+			"partial_update": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send only the top-level keys of `body` that changed since the last apply, along with `primaryKey` and `type`, on update. Requires `update_method` `PATCH`, set it only for the endpoints documented to merge the body of a PATCH into the object. Defaults to `false`, the whole `body` is sent.",
+				Optional:            true,
+			},
+			"delete_method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method deleting the object, `DELETE`, or `NONE` for the objects that cannot be deleted. Defaults to `DELETE`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("DELETE"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("DELETE", "NONE"),
+				},
+			},
+			"drift_paths": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The paths of the values of `body` compared with the object read from FortiSASE to detect drift, e.g. `subnet` or `members.0.primaryKey`. Paths that are missing from `body` are ignored.",
+				Optional:            true,
+			},
+			"response": schema.StringAttribute{
+				MarkdownDescription: "The object as read from FortiSASE, in JSON. It may hold secrets, such as pre-shared keys.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceRestObject) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*FortiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FortiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.fortiClient = client
+	r.resourceName = "fortisase_rest_object"
+}
+
+func (r *resourceRestObject) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resourceRestObjectModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.PartialUpdate.ValueBool() || data.UpdateMethod.IsUnknown() {
+		return
+	}
+
+	if data.UpdateMethod.ValueString() != "PATCH" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("partial_update"),
+			"Invalid Attribute Combination",
+			"partial_update requires update_method \"PATCH\": the endpoints updated with a PUT or a POST replace the object, the keys that are not sent would be reset.",
+		)
+	}
+}
+
+func (r *resourceRestObject) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceRestObjectModel
+	diags := &resp.Diagnostics
+
+	// Read Terraform plan data into the model
+	diags.Append(req.Plan.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.HTTPMethod = data.CreateMethod.ValueString()
+	input_model.URL = data.Path.ValueString()
+	input_model.URLParams = data.getURLObjectRestObject(ctx, diags)
+	input_model.BodyParams = decodeRestBody(data.Body, diags)
+	if !data.PrimaryKey.IsUnknown() && !data.PrimaryKey.IsNull() {
+		input_model.URLParams["primaryKey"] = data.PrimaryKey.ValueString()
+	}
+
+	if diags.HasError() {
+		return
+	}
+	output, err := c.Request(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+
+	mkey := data.PrimaryKey.ValueString()
+	if data.PrimaryKey.IsUnknown() || mkey == "" {
+		mkey = fortiStringValue(output["primaryKey"])
+	}
+	if mkey == "" {
+		mkey = fortiStringValue(input_model.BodyParams["primaryKey"])
+	}
+	if mkey == "" {
+		diags.AddError(
+			fmt.Sprintf("Error to create resource %s", r.resourceName),
+			"The primary key of the object is neither in the response nor in body. Set primary_key.",
+		)
+		return
+	}
+	data.ID = types.StringValue(mkey)
+	data.PrimaryKey = types.StringValue(mkey)
+
+	diags.Append(r.read(ctx, &data, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRestObject) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	diags := &resp.Diagnostics
+	var data resourceRestObjectModel
+	var state resourceRestObjectModel
+	diags.Append(req.Plan.Get(ctx, &data)...)
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.HTTPMethod = data.UpdateMethod.ValueString()
+	input_model.URL = data.objectPath()
+	input_model.URLParams = data.getURLObjectRestObject(ctx, diags)
+	input_model.BodyParams = decodeRestBody(data.Body, diags)
+	if data.PartialUpdate.ValueBool() && input_model.HTTPMethod == "PATCH" && !diags.HasError() {
+		input_model.BodyParams = partialUpdateBody(input_model.BodyParams, decodeRestBody(state.Body, diags))
+	}
+
+	if diags.HasError() {
+		return
+	}
+	output, err := c.Request(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to update resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+
+	diags.Append(r.read(ctx, &data, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRestObject) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	diags := &resp.Diagnostics
+	var data resourceRestObjectModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(req.State.Get(ctx, &data)...)
+
+	if diags.HasError() || data.DeleteMethod.ValueString() == "NONE" {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.HTTPMethod = "DELETE"
+	input_model.URL = data.objectPath()
+	input_model.URLParams = data.getURLObjectRestObject(ctx, diags)
+
+	if diags.HasError() {
+		return
+	}
+	output, err := c.Request(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, output),
+		)
+		return
+	}
+}
+
+func (r *resourceRestObject) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	diags := &resp.Diagnostics
+	var data resourceRestObjectModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(req.State.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
+
+	diags.Append(r.read(ctx, &data, true)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ImportState imports an object from "<path>/<primary_key>", e.g. "/resource-api/v2/network/hosts/host1".
+// The whole object becomes the body, the paths with placeholders cannot be imported.
+func (r *resourceRestObject) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	i := strings.LastIndex(req.ID, "/")
+	if i <= 0 || i == len(req.ID)-1 || strings.Contains(req.ID, "{") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <path>/<primary_key>, e.g. /resource-api/v2/network/hosts/host1. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID[i+1:])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("primary_key"), req.ID[i+1:])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("create_method"), "POST")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("update_method"), "PUT")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_method"), "DELETE")...)
+}
+
+// read reads the object back into data: the response and, with drift, the values of body at drift_paths.
+// The body of an imported object is the whole object.
+func (r *resourceRestObject) read(ctx context.Context, data *resourceRestObjectModel, drift bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.HTTPMethod = "GET"
+	input_model.URL = data.objectPath()
+	input_model.URLParams = data.getURLObjectRestObject(ctx, &diags)
+
+	if diags.HasError() {
+		return diags
+	}
+	read_output, err := c.Request(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, read_output),
+		)
+		return diags
+	}
+
+	response, err := json.Marshal(read_output)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err), "")
+		return diags
+	}
+	data.Response = types.StringValue(string(response))
+
+	if data.Body.IsNull() {
+		data.Body = jsonValue{StringValue: types.StringValue(string(response))}
+		return diags
+	}
+
+	var driftPaths []string
+	diags.Append(data.DriftPaths.ElementsAs(ctx, &driftPaths, false)...)
+	if diags.HasError() || !drift || len(driftPaths) == 0 {
+		return diags
+	}
+
+	body := decodeRestBody(data.Body, &diags)
+	if diags.HasError() {
+		return diags
+	}
+	var remote interface{}
+	decoder := json.NewDecoder(bytes.NewReader(response))
+	decoder.UseNumber()
+	if err := decoder.Decode(&remote); err != nil {
+		diags.AddError(fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err), "")
+		return diags
+	}
+
+	drifted := false
+	for _, p := range driftPaths {
+		local, ok := jsonPathGet(body, p)
+		if !ok {
+			continue
+		}
+		v, ok := jsonPathGet(remote, p)
+		if !ok || reflect.DeepEqual(normalizeJSON(local), normalizeJSON(v)) {
+			continue
+		}
+		jsonPathSet(body, p, v)
+		drifted = true
+	}
+	if !drifted {
+		return diags
+	}
+
+	value, err := json.Marshal(body)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err), "")
+		return diags
+	}
+	data.Body = jsonValue{StringValue: types.StringValue(string(value))}
+	return diags
+}
+
+// objectPath returns the path template of the object.
+func (data *resourceRestObjectModel) objectPath() string {
+	if v := data.ObjectPath.ValueString(); v != "" {
+		return v
+	}
+	return strings.TrimSuffix(data.Path.ValueString(), "/") + "/{primaryKey}"
+}
+
+func (data *resourceRestObjectModel) getURLObjectRestObject(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
+	result := make(map[string]interface{})
+	params := make(map[string]string)
+	diags.Append(data.PathParams.ElementsAs(ctx, &params, false)...)
+	for k, v := range params {
+		result[k] = v
+	}
+	if !data.ID.IsUnknown() && !data.ID.IsNull() {
+		result["primaryKey"] = data.ID.ValueString()
+	}
+	return result
+}
+
+// decodeRestBody decodes a JSON object, numbers are kept as is.
+func decodeRestBody(v jsonValue, diags *diag.Diagnostics) map[string]interface{} {
+	var result map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(v.ValueString()))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil || result == nil {
+		diags.AddAttributeError(path.Root("body"), "Invalid JSON Object", fmt.Sprintf("The value must be a JSON object: %v", err))
+		return nil
+	}
+	return result
+}
+
+// updateRequiredKeys are sent on every partial update of a fortisase_rest_object: the primary key identifies the object
+// and the type selects the attributes that apply to it, e.g. for hosts and services.
+var updateRequiredKeys = []string{"primaryKey", "type"}
+
+// partialUpdateBody returns the top-level keys of body that differ from prior, the body of the last apply, and the required keys.
+// A changed nested object or list is sent whole. The body must be sent with a PATCH, to an endpoint documented to merge it
+// into the object: the FortiSASE endpoints that take a PUT replace the object, so the keys that are not sent would be reset.
+func partialUpdateBody(body map[string]interface{}, prior map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range body {
+		if p, ok := prior[k]; ok && reflect.DeepEqual(v, p) {
+			continue
+		}
+		result[k] = v
+	}
+	for _, k := range updateRequiredKeys {
+		if v, ok := body[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// normalizeJSON converts the numbers of v to float64 so that values decoded differently can be compared.
+func normalizeJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			result[k] = normalizeJSON(e)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			result[i] = normalizeJSON(e)
+		}
+		return result
+	}
+	return v
+}
+
+// jsonPathGet returns the value at p in v, p is a dot separated path where numbers index lists, e.g. "members.0.primaryKey".
+func jsonPathGet(v interface{}, p string) (interface{}, bool) {
+	for _, key := range strings.Split(p, ".") {
+		switch o := v.(type) {
+		case map[string]interface{}:
+			e, ok := o[key]
+			if !ok {
+				return nil, false
+			}
+			v = e
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(o) {
+				return nil, false
+			}
+			v = o[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// jsonPathSet replaces the value at p in v, which must exist.
+func jsonPathSet(v interface{}, p string, value interface{}) {
+	keys := strings.Split(p, ".")
+	parent, ok := jsonPathGet(v, strings.Join(keys[:len(keys)-1], "."))
+	if len(keys) == 1 {
+		parent, ok = v, true
+	}
+	if !ok {
+		return
+	}
+	last := keys[len(keys)-1]
+	switch o := parent.(type) {
+	case map[string]interface{}:
+		o[last] = value
+	case []interface{}:
+		if i, err := strconv.Atoi(last); err == nil && i >= 0 && i < len(o) {
+			o[i] = value
+		}
+	}
+}
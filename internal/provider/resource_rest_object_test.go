@@ -0,0 +1,452 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestJSONPathGet(t *testing.T) {
+	v := map[string]interface{}{
+		"subnet": "10.0.0.0/24",
+		"members": []interface{}{
+			map[string]interface{}{"primaryKey": "a"},
+			map[string]interface{}{"primaryKey": "b"},
+		},
+		"comments": nil,
+	}
+
+	cases := []struct {
+		name   string
+		path   string
+		want   interface{}
+		wantOk bool
+	}{
+		{name: "key", path: "subnet", want: "10.0.0.0/24", wantOk: true},
+		{name: "list index", path: "members.1.primaryKey", want: "b", wantOk: true},
+		{name: "list element", path: "members.0", want: map[string]interface{}{"primaryKey": "a"}, wantOk: true},
+		{name: "null value", path: "comments", want: nil, wantOk: true},
+		{name: "missing key", path: "fqdn"},
+		{name: "missing nested key", path: "members.0.datasource"},
+		{name: "index out of range", path: "members.2.primaryKey"},
+		{name: "negative index", path: "members.-1"},
+		{name: "key on a list", path: "members.primaryKey"},
+		{name: "key on a string", path: "subnet.mask"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := jsonPathGet(v, tc.path)
+			if ok != tc.wantOk || !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("jsonPathGet(%q) = %v, %v, want %v, %v", tc.path, got, ok, tc.want, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestJSONPathSet(t *testing.T) {
+	cases := []struct {
+		name  string
+		path  string
+		value interface{}
+		want  string
+	}{
+		{name: "key", path: "subnet", value: "10.0.1.0/24", want: `{"members":[{"primaryKey":"a"},{"primaryKey":"b"}],"subnet":"10.0.1.0/24"}`},
+		{name: "list index", path: "members.1.primaryKey", value: "c", want: `{"members":[{"primaryKey":"a"},{"primaryKey":"c"}],"subnet":"10.0.0.0/24"}`},
+		{name: "list element", path: "members.0", value: "a", want: `{"members":["a",{"primaryKey":"b"}],"subnet":"10.0.0.0/24"}`},
+		{name: "missing parent", path: "rules.0.action", value: "deny", want: `{"members":[{"primaryKey":"a"},{"primaryKey":"b"}],"subnet":"10.0.0.0/24"}`},
+		{name: "index out of range", path: "members.2", value: "c", want: `{"members":[{"primaryKey":"a"},{"primaryKey":"b"}],"subnet":"10.0.0.0/24"}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := map[string]interface{}{
+				"subnet": "10.0.0.0/24",
+				"members": []interface{}{
+					map[string]interface{}{"primaryKey": "a"},
+					map[string]interface{}{"primaryKey": "b"},
+				},
+			}
+			jsonPathSet(v, tc.path, tc.value)
+			got, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("jsonPathSet(%q) = %s, want %s", tc.path, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeJSON(t *testing.T) {
+	cases := []struct {
+		name  string
+		a     interface{}
+		b     interface{}
+		equal bool
+	}{
+		{name: "number and float", a: json.Number("1"), b: float64(1), equal: true},
+		{name: "number formats", a: json.Number("1.0"), b: json.Number("1e0"), equal: true},
+		{name: "different numbers", a: json.Number("1"), b: json.Number("2")},
+		{name: "number and string", a: json.Number("1"), b: "1"},
+		{
+			name:  "nested numbers",
+			a:     map[string]interface{}{"ports": []interface{}{json.Number("80"), json.Number("443")}},
+			b:     map[string]interface{}{"ports": []interface{}{float64(80), json.Number("443.0")}},
+			equal: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := reflect.DeepEqual(normalizeJSON(tc.a), normalizeJSON(tc.b)); got != tc.equal {
+				t.Errorf("normalizeJSON(%v) == normalizeJSON(%v) is %v, want %v", tc.a, tc.b, got, tc.equal)
+			}
+		})
+	}
+}
+
+func TestDecodeRestBody(t *testing.T) {
+	cases := []struct {
+		name    string
+		body    string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "object",
+			body: `{"primaryKey":"host","port":443,"ratio":0.5,"members":[{"primaryKey":"a"}]}`,
+			want: map[string]interface{}{
+				"primaryKey": "host",
+				"port":       json.Number("443"),
+				"ratio":      json.Number("0.5"),
+				"members":    []interface{}{map[string]interface{}{"primaryKey": "a"}},
+			},
+		},
+		{name: "large number", body: `{"id":12345678901234567890}`, want: map[string]interface{}{"id": json.Number("12345678901234567890")}},
+		{name: "list", body: `[{"primaryKey":"host"}]`, wantErr: true},
+		{name: "null", body: `null`, wantErr: true},
+		{name: "invalid", body: `{"primaryKey":`, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := decodeRestBody(jsonValue{StringValue: types.StringValue(tc.body)}, &diags)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("decodeRestBody(%s) diagnostics = %v, want error %v", tc.body, diags, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("decodeRestBody(%s) = %#v, want %#v", tc.body, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPartialUpdateBody(t *testing.T) {
+	prior := map[string]interface{}{
+		"primaryKey": "host",
+		"type":       "ipmask",
+		"comments":   "old",
+		"members":    []interface{}{map[string]interface{}{"primaryKey": "a"}},
+	}
+
+	cases := []struct {
+		name string
+		body map[string]interface{}
+		want map[string]interface{}
+	}{
+		{
+			name: "changed keys and required keys",
+			body: map[string]interface{}{
+				"primaryKey": "host",
+				"type":       "ipmask",
+				"comments":   "new",
+				"members":    []interface{}{map[string]interface{}{"primaryKey": "a"}, map[string]interface{}{"primaryKey": "b"}},
+			},
+			want: map[string]interface{}{
+				"primaryKey": "host",
+				"type":       "ipmask",
+				"comments":   "new",
+				"members":    []interface{}{map[string]interface{}{"primaryKey": "a"}, map[string]interface{}{"primaryKey": "b"}},
+			},
+		},
+		{
+			name: "unchanged",
+			body: map[string]interface{}{"primaryKey": "host", "comments": "old"},
+			want: map[string]interface{}{"primaryKey": "host"},
+		},
+		{
+			name: "key missing from prior",
+			body: map[string]interface{}{"primaryKey": "host", "comments": "old", "fqdn": "example.com"},
+			want: map[string]interface{}{"primaryKey": "host", "fqdn": "example.com"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := partialUpdateBody(tc.body, prior)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("partialUpdateBody() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// restObjectTestModel returns the state of a fortisase_rest_object of path "/resource-api/v2/network/hosts" with body.
+func restObjectTestModel(ctx context.Context, body string) resourceRestObjectModel {
+	return resourceRestObjectModel{
+		ID:            types.StringValue("host"),
+		Path:          types.StringValue("/resource-api/v2/network/hosts"),
+		ObjectPath:    types.StringNull(),
+		PathParams:    types.MapNull(types.StringType),
+		PrimaryKey:    types.StringValue("host"),
+		Body:          jsonValue{StringValue: types.StringValue(body)},
+		CreateMethod:  types.StringValue("POST"),
+		UpdateMethod:  types.StringValue("PUT"),
+		PartialUpdate: types.BoolNull(),
+		DeleteMethod:  types.StringValue("DELETE"),
+		DriftPaths:    types.ListNull(types.StringType),
+		Response:      types.StringNull(),
+		Timeouts:      timeoutsNull(ctx),
+	}
+}
+
+// restObjectTestState returns the state of the resource r holding data.
+func restObjectTestState(t *testing.T, r resource.Resource, data resourceRestObjectModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("state diagnostics: %v", diags)
+	}
+	return state
+}
+
+func TestRestObjectValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name          string
+		updateMethod  string
+		partialUpdate bool
+		wantErr       bool
+	}{
+		{name: "partial PATCH", updateMethod: "PATCH", partialUpdate: true},
+		{name: "partial PUT", updateMethod: "PUT", partialUpdate: true, wantErr: true},
+		{name: "partial POST", updateMethod: "POST", partialUpdate: true, wantErr: true},
+		{name: "full PUT", updateMethod: "PUT"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newResourceRestObject()
+			data := restObjectTestModel(ctx, `{"primaryKey":"host"}`)
+			data.UpdateMethod = types.StringValue(tc.updateMethod)
+			data.PartialUpdate = types.BoolValue(tc.partialUpdate)
+			state := restObjectTestState(t, r, data)
+
+			var resp resource.ValidateConfigResponse
+			r.(resource.ResourceWithValidateConfig).ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, &resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("ValidateConfig() diagnostics = %v, want error %v", resp.Diagnostics, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestRestObjectUpdate(t *testing.T) {
+	ctx := context.Background()
+	prior := `{"primaryKey":"host","type":"ipmask","subnet":"10.0.0.0/24","comments":"old","port":443}`
+	body := `{"primaryKey":"host","type":"ipmask","subnet":"10.0.0.0/24","comments":"new","port":443}`
+
+	cases := []struct {
+		name          string
+		updateMethod  string
+		partialUpdate bool
+		want          map[string]interface{}
+	}{
+		{
+			name:          "partial PATCH",
+			updateMethod:  "PATCH",
+			partialUpdate: true,
+			want:          map[string]interface{}{"primaryKey": "host", "type": "ipmask", "comments": "new"},
+		},
+		{
+			name:         "full PATCH",
+			updateMethod: "PATCH",
+			want:         map[string]interface{}{"primaryKey": "host", "type": "ipmask", "subnet": "10.0.0.0/24", "comments": "new", "port": float64(443)},
+		},
+		{
+			name:         "full PUT",
+			updateMethod: "PUT",
+			want:         map[string]interface{}{"primaryKey": "host", "type": "ipmask", "subnet": "10.0.0.0/24", "comments": "new", "port": float64(443)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var method string
+			var sent map[string]interface{}
+			client := newTestFortiClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/resource-api/v2/network/hosts/host" {
+					t.Errorf("request %s %s, want the object path", r.Method, r.URL.Path)
+				}
+				if r.Method == "GET" {
+					writeTestResponse(w, 200, map[string]interface{}{"primaryKey": "host"})
+					return
+				}
+				mu.Lock()
+				method = r.Method
+				json.NewDecoder(r.Body).Decode(&sent)
+				mu.Unlock()
+				writeTestResponse(w, 200, nil)
+			}))
+
+			r := &resourceRestObject{fortiClient: client, resourceName: "fortisase_rest_object"}
+			stateData := restObjectTestModel(ctx, prior)
+			stateData.UpdateMethod = types.StringValue(tc.updateMethod)
+			stateData.PartialUpdate = types.BoolValue(tc.partialUpdate)
+			planData := stateData
+			planData.Body = jsonValue{StringValue: types.StringValue(body)}
+			state := restObjectTestState(t, r, stateData)
+			plan := restObjectTestState(t, r, planData)
+
+			resp := resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State: state,
+			}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() diagnostics: %v", resp.Diagnostics)
+			}
+			if method != tc.updateMethod || !reflect.DeepEqual(sent, tc.want) {
+				t.Errorf("Update() sent %s %v, want %s %v", method, sent, tc.updateMethod, tc.want)
+			}
+		})
+	}
+}
+
+func TestRestCallRead(t *testing.T) {
+	ctx := context.Background()
+	hosts := []interface{}{map[string]interface{}{"primaryKey": "a"}, map[string]interface{}{"primaryKey": "b"}}
+
+	cases := []struct {
+		name       string
+		path       string
+		pathParams map[string]string
+		method     string
+		body       string
+		wantMethod string
+		wantPath   string
+		wantBody   map[string]interface{}
+		wantID     string
+		response   interface{}
+		want       string
+	}{
+		{
+			name:       "object",
+			path:       "/resource-api/v2/network/hosts/{primaryKey}",
+			pathParams: map[string]string{"primaryKey": "a"},
+			wantMethod: "GET",
+			wantPath:   "/resource-api/v2/network/hosts/a",
+			wantID:     "/resource-api/v2/network/hosts/a",
+			response:   map[string]interface{}{"primaryKey": "a"},
+			want:       `{"primaryKey":"a"}`,
+		},
+		{
+			name:       "list",
+			path:       "/resource-api/v2/network/hosts",
+			wantMethod: "GET",
+			wantPath:   "/resource-api/v2/network/hosts",
+			wantID:     "/resource-api/v2/network/hosts",
+			response:   hosts,
+			want:       `[{"primaryKey":"a"},{"primaryKey":"b"}]`,
+		},
+		{
+			name:       "body",
+			path:       "/resource-api/v2/monitor/sessions",
+			method:     "POST",
+			body:       `{"limit":10}`,
+			wantMethod: "POST",
+			wantPath:   "/resource-api/v2/monitor/sessions",
+			wantBody:   map[string]interface{}{"limit": float64(10)},
+			wantID:     "/resource-api/v2/monitor/sessions",
+			response:   map[string]interface{}{"total": 2},
+			want:       `{"total":2}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var method, requestPath string
+			var sent map[string]interface{}
+			client := newTestFortiClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				method, requestPath = r.Method, r.URL.Path
+				json.NewDecoder(r.Body).Decode(&sent)
+				mu.Unlock()
+				writeTestResponse(w, 200, tc.response)
+			}))
+
+			d := &datasourceRestCall{fortiClient: client, resourceName: "fortisase_rest_call"}
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			data := datasourceRestCallModel{
+				ID:         types.StringNull(),
+				Path:       types.StringValue(tc.path),
+				PathParams: types.MapNull(types.StringType),
+				Method:     types.StringNull(),
+				Body:       jsonValue{StringValue: types.StringNull()},
+				Response:   types.StringNull(),
+			}
+			if tc.pathParams != nil {
+				data.PathParams, _ = types.MapValueFrom(ctx, types.StringType, tc.pathParams)
+			}
+			if tc.method != "" {
+				data.Method = types.StringValue(tc.method)
+			}
+			if tc.body != "" {
+				data.Body = jsonValue{StringValue: types.StringValue(tc.body)}
+			}
+			if diags := config.Set(ctx, &data); diags.HasError() {
+				t.Fatalf("config diagnostics: %v", diags)
+			}
+
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics: %v", resp.Diagnostics)
+			}
+			if method != tc.wantMethod || requestPath != tc.wantPath || !reflect.DeepEqual(sent, tc.wantBody) {
+				t.Errorf("Read() sent %s %s %v, want %s %s %v", method, requestPath, sent, tc.wantMethod, tc.wantPath, tc.wantBody)
+			}
+
+			var got datasourceRestCallModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("state diagnostics: %v", diags)
+			}
+			if got.ID.ValueString() != tc.wantID || got.Response.ValueString() != tc.want {
+				t.Errorf("Read() id, response = %v, %v, want %v, %v", got.ID, got.Response, tc.wantID, tc.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -41,6 +42,8 @@ type resourceSecurityAntivirusProfileModel struct {
 	Cifs       types.String                              `tfsdk:"cifs"`
 	Cdr        *resourceSecurityAntivirusProfileCdrModel `tfsdk:"cdr"`
 	Direction  types.String                              `tfsdk:"direction"`
+	RawJson    types.String                              `tfsdk:"raw_json"`
+	Timeouts   timeouts.Value                            `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityAntivirusProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,6 +60,7 @@ func (r *resourceSecurityAntivirusProfile) Schema(ctx context.Context, req resou
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -135,6 +139,9 @@ func (r *resourceSecurityAntivirusProfile) Schema(ctx context.Context, req resou
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -172,9 +179,12 @@ func (r *resourceSecurityAntivirusProfile) Create(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityAntivirusProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityAntivirusProfile(ctx, "create", diags))
@@ -194,10 +204,11 @@ func (r *resourceSecurityAntivirusProfile) Create(ctx context.Context, req resou
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityAntivirusProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityAntivirusProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityAntivirusProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -232,14 +243,18 @@ func (r *resourceSecurityAntivirusProfile) Update(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityAntivirusProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityAntivirusProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -255,10 +270,11 @@ func (r *resourceSecurityAntivirusProfile) Update(ctx context.Context, req resou
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityAntivirusProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityAntivirusProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityAntivirusProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -289,11 +305,14 @@ func (r *resourceSecurityAntivirusProfile) Read(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityAntivirusProfile(ctx, "read", diags))
 
@@ -324,6 +343,8 @@ func (m *resourceSecurityAntivirusProfileModel) refreshSecurityAntivirusProfile(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["http"]; ok {
 		m.Http = parseStringValue(v)
 	}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityAppCustomSignatures{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityAppCustomSignatures{}
 
 func newResourceSecurityAppCustomSignatures() resource.Resource {
 	return &resourceSecurityAppCustomSignatures{}
@@ -30,19 +33,23 @@ type resourceSecurityAppCustomSignatures struct {
 
 // resourceSecurityAppCustomSignaturesModel describes the resource data model.
 type resourceSecurityAppCustomSignaturesModel struct {
-	ID         types.String  `tfsdk:"id"`
-	PrimaryKey types.String  `tfsdk:"primary_key"`
-	Signature  types.String  `tfsdk:"signature"`
-	Comment    types.String  `tfsdk:"comment"`
-	Ftntid     types.Float64 `tfsdk:"ftntid"`
-	Tag        types.String  `tfsdk:"tag"`
-	Name       types.String  `tfsdk:"name"`
-	Category   types.Float64 `tfsdk:"category"`
-	Protocol   types.String  `tfsdk:"protocol"`
-	Technology types.String  `tfsdk:"technology"`
-	Behavior   types.String  `tfsdk:"behavior"`
-	Vendor     types.String  `tfsdk:"vendor"`
-	IconClass  types.String  `tfsdk:"icon_class"`
+	ID            types.String   `tfsdk:"id"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	Signature     types.String   `tfsdk:"signature"`
+	Comment       types.String   `tfsdk:"comment"`
+	Ftntid        types.Int64    `tfsdk:"ftntid"`
+	Tag           types.String   `tfsdk:"tag"`
+	Name          types.String   `tfsdk:"name"`
+	Category      types.Int64    `tfsdk:"category"`
+	Protocol      types.String   `tfsdk:"protocol"`
+	Technology    types.String   `tfsdk:"technology"`
+	Behavior      types.String   `tfsdk:"behavior"`
+	Vendor        types.String   `tfsdk:"vendor"`
+	IconClass     types.String   `tfsdk:"icon_class"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool     `tfsdk:"force_detach"`
+	RawJson       types.String   `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityAppCustomSignatures) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +58,7 @@ func (r *resourceSecurityAppCustomSignatures) Metadata(ctx context.Context, req
 
 func (r *resourceSecurityAppCustomSignatures) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -59,6 +67,9 @@ func (r *resourceSecurityAppCustomSignatures) Schema(ctx context.Context, req re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 63),
@@ -79,7 +90,10 @@ func (r *resourceSecurityAppCustomSignatures) Schema(ctx context.Context, req re
 				Computed: true,
 				Optional: true,
 			},
-			"ftntid": schema.Float64Attribute{
+			"ftntid": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -94,7 +108,10 @@ func (r *resourceSecurityAppCustomSignatures) Schema(ctx context.Context, req re
 				Computed: true,
 				Optional: true,
 			},
-			"category": schema.Float64Attribute{
+			"category": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -119,6 +136,9 @@ func (r *resourceSecurityAppCustomSignatures) Schema(ctx context.Context, req re
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -144,6 +164,13 @@ func (r *resourceSecurityAppCustomSignatures) Configure(ctx context.Context, req
 	r.resourceName = "fortisase_security_app_custom_signatures"
 }
 
+func (r *resourceSecurityAppCustomSignatures) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityAppCustomSignatures) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityAppCustomSignatures")
 	lock.Lock()
@@ -156,9 +183,12 @@ func (r *resourceSecurityAppCustomSignatures) Create(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityAppCustomSignatures(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityAppCustomSignatures(ctx, "create", diags))
 
@@ -166,6 +196,17 @@ func (r *resourceSecurityAppCustomSignatures) Create(ctx context.Context, req re
 		return
 	}
 	output, err := c.CreateSecurityAppCustomSignatures(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityAppCustomSignatures(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityAppCustomSignatures(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityAppCustomSignatures, c.UpdateSecurityAppCustomSignatures, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -177,10 +218,11 @@ func (r *resourceSecurityAppCustomSignatures) Create(ctx context.Context, req re
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityAppCustomSignatures(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityAppCustomSignatures(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityAppCustomSignatures, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -215,14 +257,18 @@ func (r *resourceSecurityAppCustomSignatures) Update(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityAppCustomSignatures(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityAppCustomSignatures(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -238,10 +284,11 @@ func (r *resourceSecurityAppCustomSignatures) Update(ctx context.Context, req re
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityAppCustomSignatures(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityAppCustomSignatures(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityAppCustomSignatures, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -271,14 +318,21 @@ func (r *resourceSecurityAppCustomSignatures) Delete(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityAppCustomSignatures(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/app-custom-signatures", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityAppCustomSignatures(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -299,11 +353,14 @@ func (r *resourceSecurityAppCustomSignatures) Read(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityAppCustomSignatures(ctx, "read", diags))
 
@@ -334,12 +391,14 @@ func (m *resourceSecurityAppCustomSignaturesModel) refreshSecurityAppCustomSigna
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["comment"]; ok {
 		m.Comment = parseStringValue(v)
 	}
 
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["tag"]; ok {
@@ -351,7 +410,7 @@ func (m *resourceSecurityAppCustomSignaturesModel) refreshSecurityAppCustomSigna
 	}
 
 	if v, ok := o["category"]; ok {
-		m.Category = parseFloat64Value(v)
+		m.Category = parseInt64Value(v)
 	}
 
 	if v, ok := o["protocol"]; ok {
@@ -392,7 +451,7 @@ func (data *resourceSecurityAppCustomSignaturesModel) getCreateObjectSecurityApp
 	}
 
 	if !data.Ftntid.IsNull() {
-		result["id"] = data.Ftntid.ValueFloat64()
+		result["id"] = data.Ftntid.ValueInt64()
 	}
 
 	if !data.Tag.IsNull() {
@@ -404,7 +463,7 @@ func (data *resourceSecurityAppCustomSignaturesModel) getCreateObjectSecurityApp
 	}
 
 	if !data.Category.IsNull() {
-		result["category"] = data.Category.ValueFloat64()
+		result["category"] = data.Category.ValueInt64()
 	}
 
 	if !data.Protocol.IsNull() {
@@ -445,7 +504,7 @@ func (data *resourceSecurityAppCustomSignaturesModel) getUpdateObjectSecurityApp
 	}
 
 	if !data.Ftntid.IsNull() {
-		result["id"] = data.Ftntid.ValueFloat64()
+		result["id"] = data.Ftntid.ValueInt64()
 	}
 
 	if !data.Tag.IsNull() {
@@ -457,7 +516,7 @@ func (data *resourceSecurityAppCustomSignaturesModel) getUpdateObjectSecurityApp
 	}
 
 	if !data.Category.IsNull() {
-		result["category"] = data.Category.ValueFloat64()
+		result["category"] = data.Category.ValueInt64()
 	}
 
 	if !data.Protocol.IsNull() {
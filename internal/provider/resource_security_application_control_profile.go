@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,6 +21,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityApplicationControlProfile{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityApplicationControlProfile{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityApplicationControlProfile{}
 
 func newResourceSecurityApplicationControlProfile() resource.Resource {
 	return &resourceSecurityApplicationControlProfile{}
@@ -42,6 +45,8 @@ type resourceSecurityApplicationControlProfileModel struct {
 	NetworkProtocols                []resourceSecurityApplicationControlProfileNetworkProtocolsModel            `tfsdk:"network_protocols"`
 	BlockNonDefaultPortApplications types.String                                                                `tfsdk:"block_non_default_port_applications"`
 	Direction                       types.String                                                                `tfsdk:"direction"`
+	RawJson                         types.String                                                                `tfsdk:"raw_json"`
+	Timeouts                        timeouts.Value                                                              `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityApplicationControlProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +55,7 @@ func (r *resourceSecurityApplicationControlProfile) Metadata(ctx context.Context
 
 func (r *resourceSecurityApplicationControlProfile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -58,6 +64,7 @@ func (r *resourceSecurityApplicationControlProfile) Schema(ctx context.Context,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -227,9 +234,9 @@ func (r *resourceSecurityApplicationControlProfile) Schema(ctx context.Context,
 						"risk": schema.ListNestedAttribute{
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
-									"id": schema.Float64Attribute{
-										Validators: []validator.Float64{
-											float64validator.AtMost(4),
+									"id": schema.Int64Attribute{
+										Validators: []validator.Int64{
+											int64validator.Between(0, 4),
 										},
 										MarkdownDescription: "Risk level with 0 being lowest and 4 being highest.\nValue at most 4.",
 										Computed:            true,
@@ -248,9 +255,9 @@ func (r *resourceSecurityApplicationControlProfile) Schema(ctx context.Context,
 			"network_protocols": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"port": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 65535),
+						"port": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
 							},
 							Computed: true,
 							Optional: true,
@@ -279,6 +286,9 @@ func (r *resourceSecurityApplicationControlProfile) Schema(ctx context.Context,
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -304,6 +314,17 @@ func (r *resourceSecurityApplicationControlProfile) Configure(ctx context.Contex
 	r.resourceName = "fortisase_security_application_control_profile"
 }
 
+func (r *resourceSecurityApplicationControlProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityApplicationControlProfile) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityApplicationControlProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityApplicationControlProfile")
 	lock.Lock()
@@ -316,9 +337,12 @@ func (r *resourceSecurityApplicationControlProfile) Create(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityApplicationControlProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityApplicationControlProfile(ctx, "create", diags))
@@ -338,10 +362,11 @@ func (r *resourceSecurityApplicationControlProfile) Create(ctx context.Context,
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityApplicationControlProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityApplicationControlProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityApplicationControlProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -376,14 +401,18 @@ func (r *resourceSecurityApplicationControlProfile) Update(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityApplicationControlProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityApplicationControlProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -399,10 +428,11 @@ func (r *resourceSecurityApplicationControlProfile) Update(ctx context.Context,
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityApplicationControlProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityApplicationControlProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityApplicationControlProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -433,11 +463,14 @@ func (r *resourceSecurityApplicationControlProfile) Read(ctx context.Context, re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityApplicationControlProfile(ctx, "read", diags))
 
@@ -468,6 +501,8 @@ func (m *resourceSecurityApplicationControlProfileModel) refreshSecurityApplicat
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["unknownApplicationAction"]; ok {
 		m.UnknownApplicationAction = parseStringValue(v)
 	}
@@ -614,13 +649,13 @@ type resourceSecurityApplicationControlProfileControlsCategoriesModel struct {
 }
 
 type resourceSecurityApplicationControlProfileControlsRiskModel struct {
-	Id types.Float64 `tfsdk:"id"`
+	Id types.Int64 `tfsdk:"id"`
 }
 
 type resourceSecurityApplicationControlProfileNetworkProtocolsModel struct {
-	Port     types.Float64 `tfsdk:"port"`
-	Action   types.String  `tfsdk:"action"`
-	Services types.Set     `tfsdk:"services"`
+	Port     types.Int64  `tfsdk:"port"`
+	Action   types.String `tfsdk:"action"`
+	Services types.Set    `tfsdk:"services"`
 }
 
 func (m *resourceSecurityApplicationControlProfileApplicationCategoryControlsModel) flattenSecurityApplicationControlProfileApplicationCategoryControls(ctx context.Context, input interface{}, diags *diag.Diagnostics) *resourceSecurityApplicationControlProfileApplicationCategoryControlsModel {
@@ -937,7 +972,7 @@ func (m *resourceSecurityApplicationControlProfileControlsRiskModel) flattenSecu
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	return m
@@ -976,7 +1011,7 @@ func (m *resourceSecurityApplicationControlProfileNetworkProtocolsModel) flatten
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["port"]; ok {
-		m.Port = parseFloat64Value(v)
+		m.Port = parseInt64Value(v)
 	}
 
 	if v, ok := o["action"]; ok {
@@ -1176,7 +1211,7 @@ func (s *resourceSecurityApplicationControlProfileControlsModel) expandSecurityA
 func (data *resourceSecurityApplicationControlProfileControlsRiskModel) expandSecurityApplicationControlProfileControlsRisk(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Id.IsNull() {
-		result["id"] = data.Id.ValueFloat64()
+		result["id"] = data.Id.ValueInt64()
 	}
 
 	return result
@@ -1193,7 +1228,7 @@ func (s *resourceSecurityApplicationControlProfileControlsModel) expandSecurityA
 func (data *resourceSecurityApplicationControlProfileNetworkProtocolsModel) expandSecurityApplicationControlProfileNetworkProtocols(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Port.IsNull() {
-		result["port"] = data.Port.ValueFloat64()
+		result["port"] = data.Port.ValueInt64()
 	}
 
 	if !data.Action.IsNull() {
@@ -5,12 +5,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +21,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityCertLocalCerts{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityCertLocalCerts{}
 
 func newResourceSecurityCertLocalCerts() resource.Resource {
 	return &resourceSecurityCertLocalCerts{}
@@ -31,22 +34,28 @@ type resourceSecurityCertLocalCerts struct {
 
 // resourceSecurityCertLocalCertsModel describes the resource data model.
 type resourceSecurityCertLocalCertsModel struct {
-	ID             types.String                                `tfsdk:"id"`
-	Ftntid         types.Float64                               `tfsdk:"ftntid"`
-	Name           types.String                                `tfsdk:"name"`
-	PrimaryKey     types.String                                `tfsdk:"primary_key"`
-	Type           types.String                                `tfsdk:"type"`
-	Source         types.String                                `tfsdk:"source"`
-	Issuer         *resourceSecurityCertLocalCertsIssuerModel  `tfsdk:"issuer"`
-	ValidFrom      types.String                                `tfsdk:"valid_from"`
-	ValidTo        types.String                                `tfsdk:"valid_to"`
-	SerialNumber   types.String                                `tfsdk:"serial_number"`
-	Usages         []resourceSecurityCertLocalCertsUsagesModel `tfsdk:"usages"`
-	Format         types.String                                `tfsdk:"format"`
-	CertName       types.String                                `tfsdk:"cert_name"`
-	Password       types.String                                `tfsdk:"password"`
-	FileContent    types.String                                `tfsdk:"file_content"`
-	KeyFileContent types.String                                `tfsdk:"key_file_content"`
+	ID                      types.String                                `tfsdk:"id"`
+	Ftntid                  types.Int64                                 `tfsdk:"ftntid"`
+	Name                    types.String                                `tfsdk:"name"`
+	PrimaryKey              types.String                                `tfsdk:"primary_key"`
+	Type                    types.String                                `tfsdk:"type"`
+	Source                  types.String                                `tfsdk:"source"`
+	Issuer                  *resourceSecurityCertLocalCertsIssuerModel  `tfsdk:"issuer"`
+	ValidFrom               types.String                                `tfsdk:"valid_from"`
+	ValidTo                 types.String                                `tfsdk:"valid_to"`
+	SerialNumber            types.String                                `tfsdk:"serial_number"`
+	Usages                  []resourceSecurityCertLocalCertsUsagesModel `tfsdk:"usages"`
+	Format                  types.String                                `tfsdk:"format"`
+	CertName                types.String                                `tfsdk:"cert_name"`
+	Password                types.String                                `tfsdk:"password"`
+	PasswordWo              types.String                                `tfsdk:"password_wo"`
+	PasswordWoVersion       types.Int64                                 `tfsdk:"password_wo_version"`
+	FileContent             types.String                                `tfsdk:"file_content"`
+	KeyFileContent          types.String                                `tfsdk:"key_file_content"`
+	KeyFileContentWo        types.String                                `tfsdk:"key_file_content_wo"`
+	KeyFileContentWoVersion types.Int64                                 `tfsdk:"key_file_content_wo_version"`
+	RawJson                 types.String                                `tfsdk:"raw_json"`
+	Timeouts                timeouts.Value                              `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityCertLocalCerts) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,6 +64,7 @@ func (r *resourceSecurityCertLocalCerts) Metadata(ctx context.Context, req resou
 
 func (r *resourceSecurityCertLocalCerts) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -63,12 +73,13 @@ func (r *resourceSecurityCertLocalCerts) Schema(ctx context.Context, req resourc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"ftntid": schema.Float64Attribute{
+			"ftntid": schema.Int64Attribute{
 				Computed: true,
 			},
 			"name": schema.StringAttribute{
 				Computed: true,
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Computed: true,
 			},
@@ -103,11 +114,29 @@ func (r *resourceSecurityCertLocalCerts) Schema(ctx context.Context, req resourc
 				},
 			},
 			"password": schema.StringAttribute{
-				Optional: true,
+				Sensitive: true,
+				Optional:  true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `password`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password_wo`. Change it to send a new value of `password_wo` to FortiSASE.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
 			"file_content": schema.StringAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.String{
@@ -115,11 +144,29 @@ func (r *resourceSecurityCertLocalCerts) Schema(ctx context.Context, req resourc
 				},
 			},
 			"key_file_content": schema.StringAttribute{
-				Optional: true,
+				Sensitive: true,
+				Optional:  true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"key_file_content_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `key_file_content`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("key_file_content")),
+					stringvalidator.AlsoRequires(path.MatchRoot("key_file_content_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"key_file_content_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `key_file_content_wo`. Change it to send a new value of `key_file_content_wo` to FortiSASE.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
 			"issuer": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"c": schema.StringAttribute{
@@ -184,11 +231,14 @@ func (r *resourceSecurityCertLocalCerts) Schema(ctx context.Context, req resourc
 								stringplanmodifier.RequiresReplace(),
 							},
 						},
-						"count": schema.Float64Attribute{
+						"count": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
-							PlanModifiers: []planmodifier.Float64{
-								float64planmodifier.RequiresReplace(),
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.RequiresReplace(),
 							},
 						},
 					},
@@ -196,6 +246,9 @@ func (r *resourceSecurityCertLocalCerts) Schema(ctx context.Context, req resourc
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutUpdate),
+		},
 	}
 }
 
@@ -221,6 +274,13 @@ func (r *resourceSecurityCertLocalCerts) Configure(ctx context.Context, req reso
 	r.resourceName = "fortisase_security_cert_local_certs"
 }
 
+func (r *resourceSecurityCertLocalCerts) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityCertLocalCerts) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resourceSecurityCertLocalCertsModel
 	diags := &resp.Diagnostics
@@ -230,9 +290,12 @@ func (r *resourceSecurityCertLocalCerts) Create(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityCertLocalCerts(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityCertLocalCerts(ctx, "create", diags))
 
@@ -251,10 +314,11 @@ func (r *resourceSecurityCertLocalCerts) Create(ctx context.Context, req resourc
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityCertLocalCerts(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityCertLocalCerts(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityCertLocalCerts, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -289,11 +353,14 @@ func (r *resourceSecurityCertLocalCerts) Delete(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityCertLocalCerts(ctx, "delete", diags))
 
@@ -317,11 +384,14 @@ func (r *resourceSecurityCertLocalCerts) Read(ctx context.Context, req resource.
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityCertLocalCerts(ctx, "read", diags))
 
@@ -352,8 +422,10 @@ func (m *resourceSecurityCertLocalCertsModel) refreshSecurityCertLocalCerts(ctx
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -410,6 +482,10 @@ func (data *resourceSecurityCertLocalCertsModel) getCreateObjectSecurityCertLoca
 		result["password"] = data.Password.ValueString()
 	}
 
+	if !data.PasswordWo.IsNull() {
+		result["password"] = data.PasswordWo.ValueString()
+	}
+
 	if !data.FileContent.IsNull() {
 		result["fileContent"] = data.FileContent.ValueString()
 	}
@@ -418,6 +494,10 @@ func (data *resourceSecurityCertLocalCertsModel) getCreateObjectSecurityCertLoca
 		result["keyFileContent"] = data.KeyFileContent.ValueString()
 	}
 
+	if !data.KeyFileContentWo.IsNull() {
+		result["keyFileContent"] = data.KeyFileContentWo.ValueString()
+	}
+
 	return &result
 }
 
@@ -447,8 +527,8 @@ type resourceSecurityCertLocalCertsIssuerModel struct {
 }
 
 type resourceSecurityCertLocalCertsUsagesModel struct {
-	Type  types.String  `tfsdk:"type"`
-	Count types.Float64 `tfsdk:"count"`
+	Type  types.String `tfsdk:"type"`
+	Count types.Int64  `tfsdk:"count"`
 }
 
 func (m *resourceSecurityCertLocalCertsIssuerModel) flattenSecurityCertLocalCertsIssuer(ctx context.Context, input interface{}, diags *diag.Diagnostics) *resourceSecurityCertLocalCertsIssuerModel {
@@ -503,7 +583,7 @@ func (m *resourceSecurityCertLocalCertsUsagesModel) flattenSecurityCertLocalCert
 	}
 
 	if v, ok := o["count"]; ok {
-		m.Count = parseFloat64Value(v)
+		m.Count = parseInt64Value(v)
 	}
 
 	return m
@@ -573,7 +653,7 @@ func (data *resourceSecurityCertLocalCertsUsagesModel) expandSecurityCertLocalCe
 	}
 
 	if !data.Count.IsNull() {
-		result["count"] = data.Count.ValueFloat64()
+		result["count"] = data.Count.ValueInt64()
 	}
 
 	return result
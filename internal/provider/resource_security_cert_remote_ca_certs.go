@@ -5,12 +5,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +21,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityCertRemoteCaCerts{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityCertRemoteCaCerts{}
 
 func newResourceSecurityCertRemoteCaCerts() resource.Resource {
 	return &resourceSecurityCertRemoteCaCerts{}
@@ -32,7 +35,7 @@ type resourceSecurityCertRemoteCaCerts struct {
 // resourceSecurityCertRemoteCaCertsModel describes the resource data model.
 type resourceSecurityCertRemoteCaCertsModel struct {
 	ID           types.String                                   `tfsdk:"id"`
-	Ftntid       types.Float64                                  `tfsdk:"ftntid"`
+	Ftntid       types.Int64                                    `tfsdk:"ftntid"`
 	Name         types.String                                   `tfsdk:"name"`
 	PrimaryKey   types.String                                   `tfsdk:"primary_key"`
 	Type         types.String                                   `tfsdk:"type"`
@@ -44,6 +47,8 @@ type resourceSecurityCertRemoteCaCertsModel struct {
 	Usages       []resourceSecurityCertRemoteCaCertsUsagesModel `tfsdk:"usages"`
 	CertName     types.String                                   `tfsdk:"cert_name"`
 	FileContent  types.String                                   `tfsdk:"file_content"`
+	RawJson      types.String                                   `tfsdk:"raw_json"`
+	Timeouts     timeouts.Value                                 `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityCertRemoteCaCerts) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +57,7 @@ func (r *resourceSecurityCertRemoteCaCerts) Metadata(ctx context.Context, req re
 
 func (r *resourceSecurityCertRemoteCaCerts) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -60,12 +66,13 @@ func (r *resourceSecurityCertRemoteCaCerts) Schema(ctx context.Context, req reso
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"ftntid": schema.Float64Attribute{
+			"ftntid": schema.Int64Attribute{
 				Computed: true,
 			},
 			"name": schema.StringAttribute{
 				Computed: true,
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Computed: true,
 			},
@@ -163,11 +170,14 @@ func (r *resourceSecurityCertRemoteCaCerts) Schema(ctx context.Context, req reso
 								stringplanmodifier.RequiresReplace(),
 							},
 						},
-						"count": schema.Float64Attribute{
+						"count": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
-							PlanModifiers: []planmodifier.Float64{
-								float64planmodifier.RequiresReplace(),
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.RequiresReplace(),
 							},
 						},
 					},
@@ -175,6 +185,9 @@ func (r *resourceSecurityCertRemoteCaCerts) Schema(ctx context.Context, req reso
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutUpdate),
+		},
 	}
 }
 
@@ -200,6 +213,13 @@ func (r *resourceSecurityCertRemoteCaCerts) Configure(ctx context.Context, req r
 	r.resourceName = "fortisase_security_cert_remote_ca_certs"
 }
 
+func (r *resourceSecurityCertRemoteCaCerts) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityCertRemoteCaCerts) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resourceSecurityCertRemoteCaCertsModel
 	diags := &resp.Diagnostics
@@ -209,9 +229,12 @@ func (r *resourceSecurityCertRemoteCaCerts) Create(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityCertRemoteCaCerts(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityCertRemoteCaCerts(ctx, "create", diags))
 
@@ -230,10 +253,11 @@ func (r *resourceSecurityCertRemoteCaCerts) Create(ctx context.Context, req reso
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityCertRemoteCaCerts(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityCertRemoteCaCerts(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityCertRemoteCaCerts, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -268,11 +292,14 @@ func (r *resourceSecurityCertRemoteCaCerts) Delete(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityCertRemoteCaCerts(ctx, "delete", diags))
 
@@ -296,11 +323,14 @@ func (r *resourceSecurityCertRemoteCaCerts) Read(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityCertRemoteCaCerts(ctx, "read", diags))
 
@@ -331,8 +361,10 @@ func (m *resourceSecurityCertRemoteCaCertsModel) refreshSecurityCertRemoteCaCert
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["id"]; ok {
-		m.Ftntid = parseFloat64Value(v)
+		m.Ftntid = parseInt64Value(v)
 	}
 
 	if v, ok := o["name"]; ok {
@@ -414,8 +446,8 @@ type resourceSecurityCertRemoteCaCertsIssuerModel struct {
 }
 
 type resourceSecurityCertRemoteCaCertsUsagesModel struct {
-	Type  types.String  `tfsdk:"type"`
-	Count types.Float64 `tfsdk:"count"`
+	Type  types.String `tfsdk:"type"`
+	Count types.Int64  `tfsdk:"count"`
 }
 
 func (m *resourceSecurityCertRemoteCaCertsIssuerModel) flattenSecurityCertRemoteCaCertsIssuer(ctx context.Context, input interface{}, diags *diag.Diagnostics) *resourceSecurityCertRemoteCaCertsIssuerModel {
@@ -470,7 +502,7 @@ func (m *resourceSecurityCertRemoteCaCertsUsagesModel) flattenSecurityCertRemote
 	}
 
 	if v, ok := o["count"]; ok {
-		m.Count = parseFloat64Value(v)
+		m.Count = parseInt64Value(v)
 	}
 
 	return m
@@ -540,7 +572,7 @@ func (data *resourceSecurityCertRemoteCaCertsUsagesModel) expandSecurityCertRemo
 	}
 
 	if !data.Count.IsNull() {
-		result["count"] = data.Count.ValueFloat64()
+		result["count"] = data.Count.ValueInt64()
 	}
 
 	return result
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityDlpDictionaries{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityDlpDictionaries{}
+var _ resource.ResourceWithConfigValidators = &resourceSecurityDlpDictionaries{}
 
 func newResourceSecurityDlpDictionaries() resource.Resource {
 	return &resourceSecurityDlpDictionaries{}
@@ -36,6 +39,10 @@ type resourceSecurityDlpDictionariesModel struct {
 	SensitivityLabelGuid types.String                                  `tfsdk:"sensitivity_label_guid"`
 	EntriesToEvaluate    types.String                                  `tfsdk:"entries_to_evaluate"`
 	Entries              []resourceSecurityDlpDictionariesEntriesModel `tfsdk:"entries"`
+	AdoptExisting        types.Bool                                    `tfsdk:"adopt_existing"`
+	ForceDetach          types.Bool                                    `tfsdk:"force_detach"`
+	RawJson              types.String                                  `tfsdk:"raw_json"`
+	Timeouts             timeouts.Value                                `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityDlpDictionaries) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +59,9 @@ func (r *resourceSecurityDlpDictionaries) Schema(ctx context.Context, req resour
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -124,6 +134,22 @@ func (r *resourceSecurityDlpDictionaries) Schema(ctx context.Context, req resour
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceSecurityDlpDictionaries) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validateDiscriminator(path.MatchRoot("dictionary_type"), map[string]discriminatorVariant{
+			"sensor": {
+				conflicting: []string{"sensitivity_label_guid"},
+			},
+			"mip-label": {
+				required: [][]string{{"sensitivity_label_guid"}},
+			},
+		}),
 	}
 }
 
@@ -149,6 +175,10 @@ func (r *resourceSecurityDlpDictionaries) Configure(ctx context.Context, req res
 	r.resourceName = "fortisase_security_dlp_dictionaries"
 }
 
+func (r *resourceSecurityDlpDictionaries) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
 func (r *resourceSecurityDlpDictionaries) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityDlpDictionaries")
 	lock.Lock()
@@ -161,9 +191,12 @@ func (r *resourceSecurityDlpDictionaries) Create(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityDlpDictionaries(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityDlpDictionaries(ctx, "create", diags))
 
@@ -171,6 +204,17 @@ func (r *resourceSecurityDlpDictionaries) Create(ctx context.Context, req resour
 		return
 	}
 	output, err := c.CreateSecurityDlpDictionaries(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityDlpDictionaries(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityDlpDictionaries(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityDlpDictionaries, c.UpdateSecurityDlpDictionaries, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -182,10 +226,11 @@ func (r *resourceSecurityDlpDictionaries) Create(ctx context.Context, req resour
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpDictionaries(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpDictionaries(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpDictionaries, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -220,14 +265,18 @@ func (r *resourceSecurityDlpDictionaries) Update(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityDlpDictionaries(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityDlpDictionaries(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -243,10 +292,11 @@ func (r *resourceSecurityDlpDictionaries) Update(ctx context.Context, req resour
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpDictionaries(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpDictionaries(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpDictionaries, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -276,14 +326,21 @@ func (r *resourceSecurityDlpDictionaries) Delete(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpDictionaries(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/dlp-dictionaries", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityDlpDictionaries(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -304,11 +361,14 @@ func (r *resourceSecurityDlpDictionaries) Read(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpDictionaries(ctx, "read", diags))
 
@@ -339,6 +399,8 @@ func (m *resourceSecurityDlpDictionariesModel) refreshSecurityDlpDictionaries(ct
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["dictionaryType"]; ok {
 		m.DictionaryType = parseStringValue(v)
 	}
@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityDlpExactDataMatches{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityDlpExactDataMatches{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityDlpExactDataMatches{}
 
 func newResourceSecurityDlpExactDataMatches() resource.Resource {
 	return &resourceSecurityDlpExactDataMatches{}
@@ -35,7 +38,11 @@ type resourceSecurityDlpExactDataMatchesModel struct {
 	PrimaryKey           types.String                                                  `tfsdk:"primary_key"`
 	ExternalResourceData *resourceSecurityDlpExactDataMatchesExternalResourceDataModel `tfsdk:"external_resource_data"`
 	Columns              []resourceSecurityDlpExactDataMatchesColumnsModel             `tfsdk:"columns"`
-	OptionalCount        types.Float64                                                 `tfsdk:"optional_count"`
+	OptionalCount        types.Int64                                                   `tfsdk:"optional_count"`
+	AdoptExisting        types.Bool                                                    `tfsdk:"adopt_existing"`
+	ForceDetach          types.Bool                                                    `tfsdk:"force_detach"`
+	RawJson              types.String                                                  `tfsdk:"raw_json"`
+	Timeouts             timeouts.Value                                                `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityDlpExactDataMatches) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,6 +51,7 @@ func (r *resourceSecurityDlpExactDataMatches) Metadata(ctx context.Context, req
 
 func (r *resourceSecurityDlpExactDataMatches) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -52,12 +60,15 @@ func (r *resourceSecurityDlpExactDataMatches) Schema(ctx context.Context, req re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
-			"optional_count": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(32),
+			"optional_count": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 32),
 				},
 				Computed: true,
 				Optional: true,
@@ -68,9 +79,9 @@ func (r *resourceSecurityDlpExactDataMatches) Schema(ctx context.Context, req re
 						Computed: true,
 						Optional: true,
 					},
-					"refresh_rate": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(1, 43200),
+					"refresh_rate": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(1, 43200),
 						},
 						Computed: true,
 						Optional: true,
@@ -101,9 +112,9 @@ func (r *resourceSecurityDlpExactDataMatches) Schema(ctx context.Context, req re
 			"columns": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"index": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 32),
+						"index": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 32),
 							},
 							Computed: true,
 							Optional: true,
@@ -135,6 +146,9 @@ func (r *resourceSecurityDlpExactDataMatches) Schema(ctx context.Context, req re
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -160,6 +174,17 @@ func (r *resourceSecurityDlpExactDataMatches) Configure(ctx context.Context, req
 	r.resourceName = "fortisase_security_dlp_exact_data_matches"
 }
 
+func (r *resourceSecurityDlpExactDataMatches) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityDlpExactDataMatches) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityDlpExactDataMatches) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityDlpExactDataMatches")
 	lock.Lock()
@@ -172,9 +197,12 @@ func (r *resourceSecurityDlpExactDataMatches) Create(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityDlpExactDataMatches(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityDlpExactDataMatches(ctx, "create", diags))
 
@@ -182,6 +210,17 @@ func (r *resourceSecurityDlpExactDataMatches) Create(ctx context.Context, req re
 		return
 	}
 	output, err := c.CreateSecurityDlpExactDataMatches(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityDlpExactDataMatches(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityDlpExactDataMatches(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityDlpExactDataMatches, c.UpdateSecurityDlpExactDataMatches, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -193,10 +232,11 @@ func (r *resourceSecurityDlpExactDataMatches) Create(ctx context.Context, req re
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpExactDataMatches(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpExactDataMatches(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpExactDataMatches, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -231,14 +271,18 @@ func (r *resourceSecurityDlpExactDataMatches) Update(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityDlpExactDataMatches(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityDlpExactDataMatches(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -254,10 +298,11 @@ func (r *resourceSecurityDlpExactDataMatches) Update(ctx context.Context, req re
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpExactDataMatches(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpExactDataMatches(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpExactDataMatches, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -287,14 +332,21 @@ func (r *resourceSecurityDlpExactDataMatches) Delete(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpExactDataMatches(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/dlp-exact-data-matches", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityDlpExactDataMatches(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -315,11 +367,14 @@ func (r *resourceSecurityDlpExactDataMatches) Read(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpExactDataMatches(ctx, "read", diags))
 
@@ -350,6 +405,8 @@ func (m *resourceSecurityDlpExactDataMatchesModel) refreshSecurityDlpExactDataMa
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["externalResourceData"]; ok {
 		m.ExternalResourceData = m.ExternalResourceData.flattenSecurityDlpExactDataMatchesExternalResourceData(ctx, v, &diags)
 	}
@@ -359,7 +416,7 @@ func (m *resourceSecurityDlpExactDataMatchesModel) refreshSecurityDlpExactDataMa
 	}
 
 	if v, ok := o["optionalCount"]; ok {
-		m.OptionalCount = parseFloat64Value(v)
+		m.OptionalCount = parseInt64Value(v)
 	}
 
 	return diags
@@ -378,7 +435,7 @@ func (data *resourceSecurityDlpExactDataMatchesModel) getCreateObjectSecurityDlp
 	result["columns"] = data.expandSecurityDlpExactDataMatchesColumnsList(ctx, data.Columns, diags)
 
 	if !data.OptionalCount.IsNull() {
-		result["optionalCount"] = data.OptionalCount.ValueFloat64()
+		result["optionalCount"] = data.OptionalCount.ValueInt64()
 	}
 
 	return &result
@@ -399,7 +456,7 @@ func (data *resourceSecurityDlpExactDataMatchesModel) getUpdateObjectSecurityDlp
 	}
 
 	if !data.OptionalCount.IsNull() {
-		result["optionalCount"] = data.OptionalCount.ValueFloat64()
+		result["optionalCount"] = data.OptionalCount.ValueInt64()
 	}
 
 	return &result
@@ -415,15 +472,15 @@ func (data *resourceSecurityDlpExactDataMatchesModel) getURLObjectSecurityDlpExa
 }
 
 type resourceSecurityDlpExactDataMatchesExternalResourceDataModel struct {
-	Resource     types.String  `tfsdk:"resource"`
-	RefreshRate  types.Float64 `tfsdk:"refresh_rate"`
-	Username     types.String  `tfsdk:"username"`
-	Password     types.String  `tfsdk:"password"`
-	UpdateMethod types.String  `tfsdk:"update_method"`
+	Resource     types.String `tfsdk:"resource"`
+	RefreshRate  types.Int64  `tfsdk:"refresh_rate"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	UpdateMethod types.String `tfsdk:"update_method"`
 }
 
 type resourceSecurityDlpExactDataMatchesColumnsModel struct {
-	Index    types.Float64                                        `tfsdk:"index"`
+	Index    types.Int64                                          `tfsdk:"index"`
 	Type     *resourceSecurityDlpExactDataMatchesColumnsTypeModel `tfsdk:"type"`
 	Optional types.Bool                                           `tfsdk:"optional"`
 }
@@ -446,7 +503,7 @@ func (m *resourceSecurityDlpExactDataMatchesExternalResourceDataModel) flattenSe
 	}
 
 	if v, ok := o["refreshRate"]; ok {
-		m.RefreshRate = parseFloat64Value(v)
+		m.RefreshRate = parseInt64Value(v)
 	}
 
 	if v, ok := o["username"]; ok {
@@ -469,7 +526,7 @@ func (m *resourceSecurityDlpExactDataMatchesColumnsModel) flattenSecurityDlpExac
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["index"]; ok {
-		m.Index = parseFloat64Value(v)
+		m.Index = parseInt64Value(v)
 	}
 
 	if v, ok := o["type"]; ok {
@@ -533,7 +590,7 @@ func (data *resourceSecurityDlpExactDataMatchesExternalResourceDataModel) expand
 	}
 
 	if !data.RefreshRate.IsNull() {
-		result["refreshRate"] = data.RefreshRate.ValueFloat64()
+		result["refreshRate"] = data.RefreshRate.ValueInt64()
 	}
 
 	if !data.Username.IsNull() {
@@ -554,7 +611,7 @@ func (data *resourceSecurityDlpExactDataMatchesExternalResourceDataModel) expand
 func (data *resourceSecurityDlpExactDataMatchesColumnsModel) expandSecurityDlpExactDataMatchesColumns(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Index.IsNull() {
-		result["index"] = data.Index.ValueFloat64()
+		result["index"] = data.Index.ValueInt64()
 	}
 
 	if data.Type != nil && !isZeroStruct(*data.Type) {
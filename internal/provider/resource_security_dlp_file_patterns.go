@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityDlpFilePatterns{}
+var _ resource.ResourceWithConfigValidators = &resourceSecurityDlpFilePatterns{}
 
 func newResourceSecurityDlpFilePatterns() resource.Resource {
 	return &resourceSecurityDlpFilePatterns{}
@@ -30,10 +32,14 @@ type resourceSecurityDlpFilePatterns struct {
 
 // resourceSecurityDlpFilePatternsModel describes the resource data model.
 type resourceSecurityDlpFilePatternsModel struct {
-	ID         types.String                                  `tfsdk:"id"`
-	PrimaryKey types.String                                  `tfsdk:"primary_key"`
-	Tag        types.String                                  `tfsdk:"tag"`
-	Entries    []resourceSecurityDlpFilePatternsEntriesModel `tfsdk:"entries"`
+	ID            types.String                                  `tfsdk:"id"`
+	PrimaryKey    types.String                                  `tfsdk:"primary_key"`
+	Tag           types.String                                  `tfsdk:"tag"`
+	Entries       []resourceSecurityDlpFilePatternsEntriesModel `tfsdk:"entries"`
+	AdoptExisting types.Bool                                    `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool                                    `tfsdk:"force_detach"`
+	RawJson       types.String                                  `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value                                `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityDlpFilePatterns) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +56,9 @@ func (r *resourceSecurityDlpFilePatterns) Schema(ctx context.Context, req resour
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Computed: true,
 			},
@@ -90,6 +99,24 @@ func (r *resourceSecurityDlpFilePatterns) Schema(ctx context.Context, req resour
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceSecurityDlpFilePatterns) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validateDiscriminator(path.MatchRoot("entries").AtAnyListIndex().AtName("filter_type"), map[string]discriminatorVariant{
+			"type": {
+				required:    [][]string{{"file_type"}},
+				conflicting: []string{"pattern"},
+			},
+			"pattern": {
+				required:    [][]string{{"pattern"}},
+				conflicting: []string{"file_type"},
+			},
+		}),
 	}
 }
 
@@ -127,9 +154,12 @@ func (r *resourceSecurityDlpFilePatterns) Create(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityDlpFilePatterns(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityDlpFilePatterns(ctx, "create", diags))
 
@@ -137,6 +167,17 @@ func (r *resourceSecurityDlpFilePatterns) Create(ctx context.Context, req resour
 		return
 	}
 	output, err := c.CreateSecurityDlpFilePatterns(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityDlpFilePatterns(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityDlpFilePatterns(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityDlpFilePatterns, c.UpdateSecurityDlpFilePatterns, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -148,10 +189,11 @@ func (r *resourceSecurityDlpFilePatterns) Create(ctx context.Context, req resour
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpFilePatterns(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpFilePatterns(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpFilePatterns, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -186,14 +228,18 @@ func (r *resourceSecurityDlpFilePatterns) Update(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityDlpFilePatterns(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityDlpFilePatterns(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -209,10 +255,11 @@ func (r *resourceSecurityDlpFilePatterns) Update(ctx context.Context, req resour
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpFilePatterns(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpFilePatterns(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpFilePatterns, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -242,14 +289,21 @@ func (r *resourceSecurityDlpFilePatterns) Delete(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpFilePatterns(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/dlp-file-patterns", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityDlpFilePatterns(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -270,11 +324,14 @@ func (r *resourceSecurityDlpFilePatterns) Read(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpFilePatterns(ctx, "read", diags))
 
@@ -305,6 +362,8 @@ func (m *resourceSecurityDlpFilePatternsModel) refreshSecurityDlpFilePatterns(ct
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["primaryKey"]; ok {
 		m.PrimaryKey = parseStringValue(v)
 	}
@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityDlpFingerprintDatabases{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityDlpFingerprintDatabases{}
 
 func newResourceSecurityDlpFingerprintDatabases() resource.Resource {
 	return &resourceSecurityDlpFingerprintDatabases{}
@@ -43,6 +45,10 @@ type resourceSecurityDlpFingerprintDatabasesModel struct {
 	KeepModified                  types.String                                                `tfsdk:"keep_modified"`
 	ScanOnCreation                types.String                                                `tfsdk:"scan_on_creation"`
 	Authentication                *resourceSecurityDlpFingerprintDatabasesAuthenticationModel `tfsdk:"authentication"`
+	AdoptExisting                 types.Bool                                                  `tfsdk:"adopt_existing"`
+	ForceDetach                   types.Bool                                                  `tfsdk:"force_detach"`
+	RawJson                       types.String                                                `tfsdk:"raw_json"`
+	Timeouts                      timeouts.Value                                              `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityDlpFingerprintDatabases) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +57,7 @@ func (r *resourceSecurityDlpFingerprintDatabases) Metadata(ctx context.Context,
 
 func (r *resourceSecurityDlpFingerprintDatabases) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -59,6 +66,9 @@ func (r *resourceSecurityDlpFingerprintDatabases) Schema(ctx context.Context, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -127,16 +137,16 @@ func (r *resourceSecurityDlpFingerprintDatabases) Schema(ctx context.Context, re
 						Computed: true,
 						Optional: true,
 					},
-					"sync_hour": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.AtMost(23),
+					"sync_hour": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(0, 23),
 						},
 						Computed: true,
 						Optional: true,
 					},
-					"sync_minute": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.AtMost(59),
+					"sync_minute": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(0, 59),
 						},
 						Computed: true,
 						Optional: true,
@@ -148,9 +158,9 @@ func (r *resourceSecurityDlpFingerprintDatabases) Schema(ctx context.Context, re
 						Computed: true,
 						Optional: true,
 					},
-					"sync_day_of_the_month": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(1, 31),
+					"sync_day_of_the_month": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(1, 31),
 						},
 						Computed: true,
 						Optional: true,
@@ -178,6 +188,9 @@ func (r *resourceSecurityDlpFingerprintDatabases) Schema(ctx context.Context, re
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -203,6 +216,13 @@ func (r *resourceSecurityDlpFingerprintDatabases) Configure(ctx context.Context,
 	r.resourceName = "fortisase_security_dlp_fingerprint_databases"
 }
 
+func (r *resourceSecurityDlpFingerprintDatabases) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityDlpFingerprintDatabases) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityDlpFingerprintDatabases")
 	lock.Lock()
@@ -215,9 +235,12 @@ func (r *resourceSecurityDlpFingerprintDatabases) Create(ctx context.Context, re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityDlpFingerprintDatabases(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityDlpFingerprintDatabases(ctx, "create", diags))
 
@@ -225,6 +248,17 @@ func (r *resourceSecurityDlpFingerprintDatabases) Create(ctx context.Context, re
 		return
 	}
 	output, err := c.CreateSecurityDlpFingerprintDatabases(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityDlpFingerprintDatabases(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityDlpFingerprintDatabases(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityDlpFingerprintDatabases, c.UpdateSecurityDlpFingerprintDatabases, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -236,10 +270,11 @@ func (r *resourceSecurityDlpFingerprintDatabases) Create(ctx context.Context, re
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpFingerprintDatabases(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpFingerprintDatabases(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpFingerprintDatabases, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -274,14 +309,18 @@ func (r *resourceSecurityDlpFingerprintDatabases) Update(ctx context.Context, re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityDlpFingerprintDatabases(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityDlpFingerprintDatabases(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -297,10 +336,11 @@ func (r *resourceSecurityDlpFingerprintDatabases) Update(ctx context.Context, re
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpFingerprintDatabases(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpFingerprintDatabases(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpFingerprintDatabases, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -330,14 +370,21 @@ func (r *resourceSecurityDlpFingerprintDatabases) Delete(ctx context.Context, re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpFingerprintDatabases(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/dlp-fingerprint-databases", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityDlpFingerprintDatabases(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -358,11 +405,14 @@ func (r *resourceSecurityDlpFingerprintDatabases) Read(ctx context.Context, req
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpFingerprintDatabases(ctx, "read", diags))
 
@@ -393,6 +443,8 @@ func (m *resourceSecurityDlpFingerprintDatabasesModel) refreshSecurityDlpFingerp
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["server"]; ok {
 		m.Server = parseStringValue(v)
 	}
@@ -544,11 +596,11 @@ func (data *resourceSecurityDlpFingerprintDatabasesModel) getURLObjectSecurityDl
 }
 
 type resourceSecurityDlpFingerprintDatabasesScheduleModel struct {
-	Period            types.String  `tfsdk:"period"`
-	SyncHour          types.Float64 `tfsdk:"sync_hour"`
-	SyncMinute        types.Float64 `tfsdk:"sync_minute"`
-	Weekday           types.String  `tfsdk:"weekday"`
-	SyncDayOfTheMonth types.Float64 `tfsdk:"sync_day_of_the_month"`
+	Period            types.String `tfsdk:"period"`
+	SyncHour          types.Int64  `tfsdk:"sync_hour"`
+	SyncMinute        types.Int64  `tfsdk:"sync_minute"`
+	Weekday           types.String `tfsdk:"weekday"`
+	SyncDayOfTheMonth types.Int64  `tfsdk:"sync_day_of_the_month"`
 }
 
 type resourceSecurityDlpFingerprintDatabasesAuthenticationModel struct {
@@ -569,11 +621,11 @@ func (m *resourceSecurityDlpFingerprintDatabasesScheduleModel) flattenSecurityDl
 	}
 
 	if v, ok := o["syncHour"]; ok {
-		m.SyncHour = parseFloat64Value(v)
+		m.SyncHour = parseInt64Value(v)
 	}
 
 	if v, ok := o["syncMinute"]; ok {
-		m.SyncMinute = parseFloat64Value(v)
+		m.SyncMinute = parseInt64Value(v)
 	}
 
 	if v, ok := o["weekday"]; ok {
@@ -581,7 +633,7 @@ func (m *resourceSecurityDlpFingerprintDatabasesScheduleModel) flattenSecurityDl
 	}
 
 	if v, ok := o["syncDayOfTheMonth"]; ok {
-		m.SyncDayOfTheMonth = parseFloat64Value(v)
+		m.SyncDayOfTheMonth = parseInt64Value(v)
 	}
 
 	return m
@@ -609,11 +661,11 @@ func (data *resourceSecurityDlpFingerprintDatabasesScheduleModel) expandSecurity
 	}
 
 	if !data.SyncHour.IsNull() {
-		result["syncHour"] = data.SyncHour.ValueFloat64()
+		result["syncHour"] = data.SyncHour.ValueInt64()
 	}
 
 	if !data.SyncMinute.IsNull() {
-		result["syncMinute"] = data.SyncMinute.ValueFloat64()
+		result["syncMinute"] = data.SyncMinute.ValueInt64()
 	}
 
 	if !data.Weekday.IsNull() {
@@ -621,7 +673,7 @@ func (data *resourceSecurityDlpFingerprintDatabasesScheduleModel) expandSecurity
 	}
 
 	if !data.SyncDayOfTheMonth.IsNull() {
-		result["syncDayOfTheMonth"] = data.SyncDayOfTheMonth.ValueFloat64()
+		result["syncDayOfTheMonth"] = data.SyncDayOfTheMonth.ValueInt64()
 	}
 
 	return result
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,6 +20,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityDlpProfile{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityDlpProfile{}
+var _ resource.ResourceWithConfigValidators = &resourceSecurityDlpProfile{}
 
 func newResourceSecurityDlpProfile() resource.Resource {
 	return &resourceSecurityDlpProfile{}
@@ -35,6 +38,8 @@ type resourceSecurityDlpProfileModel struct {
 	PrimaryKey types.String                              `tfsdk:"primary_key"`
 	DlpRules   []resourceSecurityDlpProfileDlpRulesModel `tfsdk:"dlp_rules"`
 	Direction  types.String                              `tfsdk:"direction"`
+	RawJson    types.String                              `tfsdk:"raw_json"`
+	Timeouts   timeouts.Value                            `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityDlpProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +56,7 @@ func (r *resourceSecurityDlpProfile) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -184,6 +190,44 @@ func (r *resourceSecurityDlpProfile) Schema(ctx context.Context, req resource.Sc
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
+	}
+}
+
+func (r *resourceSecurityDlpProfile) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validateDiscriminator(path.MatchRoot("dlp_rules").AtAnyListIndex().AtName("datasource_type"), map[string]discriminatorVariant{
+			"sensors": {
+				required:    [][]string{{"dlp_sensors"}},
+				conflicting: []string{"sensitivity_label"},
+			},
+			"mpip-label": {
+				required:    [][]string{{"sensitivity_label"}},
+				conflicting: []string{"dlp_sensors"},
+			},
+			"fingerprint": {
+				required:    [][]string{{"sensitivities"}},
+				conflicting: []string{"dlp_sensors", "sensitivity_label"},
+			},
+			"none": {
+				conflicting: []string{"dlp_sensors", "sensitivity_label", "sensitivities"},
+			},
+		}),
+		validateDiscriminator(path.MatchRoot("dlp_rules").AtAnyListIndex().AtName("dlp_rule_type"), map[string]discriminatorVariant{
+			"message": {
+				conflicting: []string{"file_type", "dlp_file_pattern"},
+			},
+		}),
+		validateDiscriminator(path.MatchRoot("dlp_rules").AtAnyListIndex().AtName("file_type"), map[string]discriminatorVariant{
+			"all": {
+				conflicting: []string{"dlp_file_pattern"},
+			},
+			"specify": {
+				required: [][]string{{"dlp_file_pattern"}},
+			},
+		}),
 	}
 }
 
@@ -209,6 +253,10 @@ func (r *resourceSecurityDlpProfile) Configure(ctx context.Context, req resource
 	r.resourceName = "fortisase_security_dlp_profile"
 }
 
+func (r *resourceSecurityDlpProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
 func (r *resourceSecurityDlpProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityDlpProfile")
 	lock.Lock()
@@ -221,9 +269,12 @@ func (r *resourceSecurityDlpProfile) Create(ctx context.Context, req resource.Cr
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityDlpProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityDlpProfile(ctx, "create", diags))
@@ -243,10 +294,11 @@ func (r *resourceSecurityDlpProfile) Create(ctx context.Context, req resource.Cr
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -281,14 +333,18 @@ func (r *resourceSecurityDlpProfile) Update(ctx context.Context, req resource.Up
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityDlpProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityDlpProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -304,10 +360,11 @@ func (r *resourceSecurityDlpProfile) Update(ctx context.Context, req resource.Up
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -338,11 +395,14 @@ func (r *resourceSecurityDlpProfile) Read(ctx context.Context, req resource.Read
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpProfile(ctx, "read", diags))
 
@@ -373,6 +433,8 @@ func (m *resourceSecurityDlpProfileModel) refreshSecurityDlpProfile(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["dlpRules"]; ok {
 		m.DlpRules = m.flattenSecurityDlpProfileDlpRulesList(ctx, v, &diags)
 	}
@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityDlpSensors{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityDlpSensors{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityDlpSensors{}
 
 func newResourceSecurityDlpSensors() resource.Resource {
 	return &resourceSecurityDlpSensors{}
@@ -36,6 +39,10 @@ type resourceSecurityDlpSensorsModel struct {
 	EntryMatchesToTriggerSensor types.String                                        `tfsdk:"entry_matches_to_trigger_sensor"`
 	SensorDictionaries          []resourceSecurityDlpSensorsSensorDictionariesModel `tfsdk:"sensor_dictionaries"`
 	Description                 types.String                                        `tfsdk:"description"`
+	AdoptExisting               types.Bool                                          `tfsdk:"adopt_existing"`
+	ForceDetach                 types.Bool                                          `tfsdk:"force_detach"`
+	RawJson                     types.String                                        `tfsdk:"raw_json"`
+	Timeouts                    timeouts.Value                                      `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityDlpSensors) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,6 +51,7 @@ func (r *resourceSecurityDlpSensors) Metadata(ctx context.Context, req resource.
 
 func (r *resourceSecurityDlpSensors) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -52,6 +60,9 @@ func (r *resourceSecurityDlpSensors) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 64),
@@ -75,16 +86,16 @@ func (r *resourceSecurityDlpSensors) Schema(ctx context.Context, req resource.Sc
 			"sensor_dictionaries": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"dictionary_id": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.Between(1, 32),
+						"dictionary_id": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(1, 32),
 							},
 							Computed: true,
 							Optional: true,
 						},
-						"dictionary_matches_to_consider_risk": schema.Float64Attribute{
-							Validators: []validator.Float64{
-								float64validator.AtMost(255),
+						"dictionary_matches_to_consider_risk": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
 							},
 							Computed: true,
 							Optional: true,
@@ -116,6 +127,9 @@ func (r *resourceSecurityDlpSensors) Schema(ctx context.Context, req resource.Sc
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -141,6 +155,17 @@ func (r *resourceSecurityDlpSensors) Configure(ctx context.Context, req resource
 	r.resourceName = "fortisase_security_dlp_sensors"
 }
 
+func (r *resourceSecurityDlpSensors) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityDlpSensors) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityDlpSensors) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityDlpSensors")
 	lock.Lock()
@@ -153,9 +178,12 @@ func (r *resourceSecurityDlpSensors) Create(ctx context.Context, req resource.Cr
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityDlpSensors(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityDlpSensors(ctx, "create", diags))
 
@@ -163,6 +191,17 @@ func (r *resourceSecurityDlpSensors) Create(ctx context.Context, req resource.Cr
 		return
 	}
 	output, err := c.CreateSecurityDlpSensors(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityDlpSensors(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityDlpSensors(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityDlpSensors, c.UpdateSecurityDlpSensors, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -174,10 +213,11 @@ func (r *resourceSecurityDlpSensors) Create(ctx context.Context, req resource.Cr
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpSensors(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpSensors(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpSensors, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -212,14 +252,18 @@ func (r *resourceSecurityDlpSensors) Update(ctx context.Context, req resource.Up
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityDlpSensors(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityDlpSensors(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -235,10 +279,11 @@ func (r *resourceSecurityDlpSensors) Update(ctx context.Context, req resource.Up
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDlpSensors(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDlpSensors(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDlpSensors, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -268,14 +313,21 @@ func (r *resourceSecurityDlpSensors) Delete(ctx context.Context, req resource.De
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpSensors(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/dlp-sensors", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityDlpSensors(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -296,11 +348,14 @@ func (r *resourceSecurityDlpSensors) Read(ctx context.Context, req resource.Read
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDlpSensors(ctx, "read", diags))
 
@@ -331,6 +386,8 @@ func (m *resourceSecurityDlpSensorsModel) refreshSecurityDlpSensors(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["entryMatchesToTriggerSensor"]; ok {
 		m.EntryMatchesToTriggerSensor = parseStringValue(v)
 	}
@@ -396,9 +453,9 @@ func (data *resourceSecurityDlpSensorsModel) getURLObjectSecurityDlpSensors(ctx
 }
 
 type resourceSecurityDlpSensorsSensorDictionariesModel struct {
-	DictionaryId                    types.Float64                                                `tfsdk:"dictionary_id"`
+	DictionaryId                    types.Int64                                                  `tfsdk:"dictionary_id"`
 	Dictionary                      *resourceSecurityDlpSensorsSensorDictionariesDictionaryModel `tfsdk:"dictionary"`
-	DictionaryMatchesToConsiderRisk types.Float64                                                `tfsdk:"dictionary_matches_to_consider_risk"`
+	DictionaryMatchesToConsiderRisk types.Int64                                                  `tfsdk:"dictionary_matches_to_consider_risk"`
 	Status                          types.String                                                 `tfsdk:"status"`
 }
 
@@ -416,7 +473,7 @@ func (m *resourceSecurityDlpSensorsSensorDictionariesModel) flattenSecurityDlpSe
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["dictionaryId"]; ok {
-		m.DictionaryId = parseFloat64Value(v)
+		m.DictionaryId = parseInt64Value(v)
 	}
 
 	if v, ok := o["dictionary"]; ok {
@@ -424,7 +481,7 @@ func (m *resourceSecurityDlpSensorsSensorDictionariesModel) flattenSecurityDlpSe
 	}
 
 	if v, ok := o["dictionaryMatchesToConsiderRisk"]; ok {
-		m.DictionaryMatchesToConsiderRisk = parseFloat64Value(v)
+		m.DictionaryMatchesToConsiderRisk = parseInt64Value(v)
 	}
 
 	if v, ok := o["status"]; ok {
@@ -480,7 +537,7 @@ func (m *resourceSecurityDlpSensorsSensorDictionariesDictionaryModel) flattenSec
 func (data *resourceSecurityDlpSensorsSensorDictionariesModel) expandSecurityDlpSensorsSensorDictionaries(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.DictionaryId.IsNull() {
-		result["dictionaryId"] = data.DictionaryId.ValueFloat64()
+		result["dictionaryId"] = data.DictionaryId.ValueInt64()
 	}
 
 	if data.Dictionary != nil && !isZeroStruct(*data.Dictionary) {
@@ -488,7 +545,7 @@ func (data *resourceSecurityDlpSensorsSensorDictionariesModel) expandSecurityDlp
 	}
 
 	if !data.DictionaryMatchesToConsiderRisk.IsNull() {
-		result["dictionaryMatchesToConsiderRisk"] = data.DictionaryMatchesToConsiderRisk.ValueFloat64()
+		result["dictionaryMatchesToConsiderRisk"] = data.DictionaryMatchesToConsiderRisk.ValueInt64()
 	}
 
 	if !data.Status.IsNull() {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityDnsFilterProfile{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityDnsFilterProfile{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityDnsFilterProfile{}
 
 func newResourceSecurityDnsFilterProfile() resource.Resource {
 	return &resourceSecurityDnsFilterProfile{}
@@ -43,6 +46,8 @@ type resourceSecurityDnsFilterProfileModel struct {
 	FortiguardFilters             []resourceSecurityDnsFilterProfileFortiguardFiltersModel       `tfsdk:"fortiguard_filters"`
 	DomainThreatFeedFilters       []resourceSecurityDnsFilterProfileDomainThreatFeedFiltersModel `tfsdk:"domain_threat_feed_filters"`
 	Direction                     types.String                                                   `tfsdk:"direction"`
+	RawJson                       types.String                                                   `tfsdk:"raw_json"`
+	Timeouts                      timeouts.Value                                                 `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityDnsFilterProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +56,7 @@ func (r *resourceSecurityDnsFilterProfile) Metadata(ctx context.Context, req res
 
 func (r *resourceSecurityDnsFilterProfile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -59,6 +65,7 @@ func (r *resourceSecurityDnsFilterProfile) Schema(ctx context.Context, req resou
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -166,7 +173,7 @@ func (r *resourceSecurityDnsFilterProfile) Schema(ctx context.Context, req resou
 				Computed: true,
 				Optional: true,
 			},
-			"fortiguard_filters": schema.ListNestedAttribute{
+			"fortiguard_filters": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -198,7 +205,7 @@ func (r *resourceSecurityDnsFilterProfile) Schema(ctx context.Context, req resou
 				Computed: true,
 				Optional: true,
 			},
-			"domain_threat_feed_filters": schema.ListNestedAttribute{
+			"domain_threat_feed_filters": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -231,6 +238,9 @@ func (r *resourceSecurityDnsFilterProfile) Schema(ctx context.Context, req resou
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -256,6 +266,17 @@ func (r *resourceSecurityDnsFilterProfile) Configure(ctx context.Context, req re
 	r.resourceName = "fortisase_security_dns_filter_profile"
 }
 
+func (r *resourceSecurityDnsFilterProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityDnsFilterProfile) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered lists fortiguard_filters and domain_threat_feed_filters to sets.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityDnsFilterProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityDnsFilterProfile")
 	lock.Lock()
@@ -268,9 +289,12 @@ func (r *resourceSecurityDnsFilterProfile) Create(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityDnsFilterProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityDnsFilterProfile(ctx, "create", diags))
@@ -290,10 +314,11 @@ func (r *resourceSecurityDnsFilterProfile) Create(ctx context.Context, req resou
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDnsFilterProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDnsFilterProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDnsFilterProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -328,14 +353,18 @@ func (r *resourceSecurityDnsFilterProfile) Update(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityDnsFilterProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityDnsFilterProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -351,10 +380,11 @@ func (r *resourceSecurityDnsFilterProfile) Update(ctx context.Context, req resou
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDnsFilterProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDnsFilterProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDnsFilterProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -385,11 +415,14 @@ func (r *resourceSecurityDnsFilterProfile) Read(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDnsFilterProfile(ctx, "read", diags))
 
@@ -420,6 +453,8 @@ func (m *resourceSecurityDnsFilterProfileModel) refreshSecurityDnsFilterProfile(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["useForEdgeDevices"]; ok {
 		m.UseForEdgeDevices = parseBoolValue(v)
 	}
@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityDomainThreatFeeds{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityDomainThreatFeeds{}
 
 func newResourceSecurityDomainThreatFeeds() resource.Resource {
 	return &resourceSecurityDomainThreatFeeds{}
@@ -31,15 +33,21 @@ type resourceSecurityDomainThreatFeeds struct {
 
 // resourceSecurityDomainThreatFeedsModel describes the resource data model.
 type resourceSecurityDomainThreatFeedsModel struct {
-	ID                  types.String  `tfsdk:"id"`
-	PrimaryKey          types.String  `tfsdk:"primary_key"`
-	Comments            types.String  `tfsdk:"comments"`
-	Status              types.String  `tfsdk:"status"`
-	RefreshRate         types.Float64 `tfsdk:"refresh_rate"`
-	Uri                 types.String  `tfsdk:"uri"`
-	BasicAuthentication types.String  `tfsdk:"basic_authentication"`
-	Username            types.String  `tfsdk:"username"`
-	Password            types.String  `tfsdk:"password"`
+	ID                  types.String   `tfsdk:"id"`
+	PrimaryKey          types.String   `tfsdk:"primary_key"`
+	Comments            types.String   `tfsdk:"comments"`
+	Status              types.String   `tfsdk:"status"`
+	RefreshRate         types.Int64    `tfsdk:"refresh_rate"`
+	Uri                 types.String   `tfsdk:"uri"`
+	BasicAuthentication types.String   `tfsdk:"basic_authentication"`
+	Username            types.String   `tfsdk:"username"`
+	Password            types.String   `tfsdk:"password"`
+	PasswordWo          types.String   `tfsdk:"password_wo"`
+	PasswordWoVersion   types.Int64    `tfsdk:"password_wo_version"`
+	AdoptExisting       types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach         types.Bool     `tfsdk:"force_detach"`
+	RawJson             types.String   `tfsdk:"raw_json"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityDomainThreatFeeds) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +56,7 @@ func (r *resourceSecurityDomainThreatFeeds) Metadata(ctx context.Context, req re
 
 func (r *resourceSecurityDomainThreatFeeds) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -56,6 +65,9 @@ func (r *resourceSecurityDomainThreatFeeds) Schema(ctx context.Context, req reso
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -76,9 +88,9 @@ func (r *resourceSecurityDomainThreatFeeds) Schema(ctx context.Context, req reso
 				Computed: true,
 				Optional: true,
 			},
-			"refresh_rate": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 43200),
+			"refresh_rate": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 43200),
 				},
 				Computed: true,
 				Optional: true,
@@ -112,6 +124,23 @@ func (r *resourceSecurityDomainThreatFeeds) Schema(ctx context.Context, req reso
 				Computed:  true,
 				Optional:  true,
 			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `password`, the value is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password_wo`. The value of `password_wo` is sent on every create and update, change the version to send a new value when no other attribute changes.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -138,6 +167,13 @@ func (r *resourceSecurityDomainThreatFeeds) Configure(ctx context.Context, req r
 	r.resourceName = "fortisase_security_domain_threat_feeds"
 }
 
+func (r *resourceSecurityDomainThreatFeeds) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityDomainThreatFeeds) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityDomainThreatFeeds")
 	lock.Lock()
@@ -150,9 +186,12 @@ func (r *resourceSecurityDomainThreatFeeds) Create(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityDomainThreatFeeds(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityDomainThreatFeeds(ctx, "create", diags))
 
@@ -160,6 +199,17 @@ func (r *resourceSecurityDomainThreatFeeds) Create(ctx context.Context, req reso
 		return
 	}
 	output, err := c.CreateSecurityDomainThreatFeeds(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityDomainThreatFeeds(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityDomainThreatFeeds(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityDomainThreatFeeds, c.UpdateSecurityDomainThreatFeeds, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -171,10 +221,11 @@ func (r *resourceSecurityDomainThreatFeeds) Create(ctx context.Context, req reso
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDomainThreatFeeds(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDomainThreatFeeds(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDomainThreatFeeds, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -209,14 +260,18 @@ func (r *resourceSecurityDomainThreatFeeds) Update(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityDomainThreatFeeds(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityDomainThreatFeeds(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -232,10 +287,11 @@ func (r *resourceSecurityDomainThreatFeeds) Update(ctx context.Context, req reso
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityDomainThreatFeeds(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityDomainThreatFeeds(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityDomainThreatFeeds, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -265,14 +321,21 @@ func (r *resourceSecurityDomainThreatFeeds) Delete(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDomainThreatFeeds(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/domain-threat-feeds", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityDomainThreatFeeds(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -293,11 +356,14 @@ func (r *resourceSecurityDomainThreatFeeds) Read(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityDomainThreatFeeds(ctx, "read", diags))
 
@@ -328,6 +394,8 @@ func (m *resourceSecurityDomainThreatFeedsModel) refreshSecurityDomainThreatFeed
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["comments"]; ok {
 		m.Comments = parseStringValue(v)
 	}
@@ -337,7 +405,7 @@ func (m *resourceSecurityDomainThreatFeedsModel) refreshSecurityDomainThreatFeed
 	}
 
 	if v, ok := o["refreshRate"]; ok {
-		m.RefreshRate = parseFloat64Value(v)
+		m.RefreshRate = parseInt64Value(v)
 	}
 
 	if v, ok := o["uri"]; ok {
@@ -370,7 +438,7 @@ func (data *resourceSecurityDomainThreatFeedsModel) getCreateObjectSecurityDomai
 	}
 
 	if !data.RefreshRate.IsNull() {
-		result["refreshRate"] = data.RefreshRate.ValueFloat64()
+		result["refreshRate"] = data.RefreshRate.ValueInt64()
 	}
 
 	if !data.Uri.IsNull() {
@@ -389,6 +457,10 @@ func (data *resourceSecurityDomainThreatFeedsModel) getCreateObjectSecurityDomai
 		result["password"] = data.Password.ValueString()
 	}
 
+	if !data.PasswordWo.IsNull() {
+		result["password"] = data.PasswordWo.ValueString()
+	}
+
 	return &result
 }
 
@@ -407,7 +479,7 @@ func (data *resourceSecurityDomainThreatFeedsModel) getUpdateObjectSecurityDomai
 	}
 
 	if !data.RefreshRate.IsNull() {
-		result["refreshRate"] = data.RefreshRate.ValueFloat64()
+		result["refreshRate"] = data.RefreshRate.ValueInt64()
 	}
 
 	if !data.Uri.IsNull() {
@@ -426,6 +498,10 @@ func (data *resourceSecurityDomainThreatFeedsModel) getUpdateObjectSecurityDomai
 		result["password"] = data.Password.ValueString()
 	}
 
+	if !data.PasswordWo.IsNull() {
+		result["password"] = data.PasswordWo.ValueString()
+	}
+
 	return &result
 }
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityEndpointToEndpointPolicies{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityEndpointToEndpointPolicies{}
+var _ resource.ResourceWithMoveState = &resourceSecurityEndpointToEndpointPolicies{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityEndpointToEndpointPolicies{}
 
 func newResourceSecurityEndpointToEndpointPolicies() resource.Resource {
 	return &resourceSecurityEndpointToEndpointPolicies{}
@@ -30,17 +34,22 @@ type resourceSecurityEndpointToEndpointPolicies struct {
 
 // resourceSecurityEndpointToEndpointPoliciesModel describes the resource data model.
 type resourceSecurityEndpointToEndpointPoliciesModel struct {
-	ID           types.String                                                 `tfsdk:"id"`
-	PrimaryKey   types.String                                                 `tfsdk:"primary_key"`
-	Enabled      types.Bool                                                   `tfsdk:"enabled"`
-	Users        []resourceSecurityEndpointToEndpointPoliciesUsersModel       `tfsdk:"users"`
-	Sources      []resourceSecurityEndpointToEndpointPoliciesSourcesModel     `tfsdk:"sources"`
-	Services     []resourceSecurityEndpointToEndpointPoliciesServicesModel    `tfsdk:"services"`
-	Action       types.String                                                 `tfsdk:"action"`
-	Schedule     *resourceSecurityEndpointToEndpointPoliciesScheduleModel     `tfsdk:"schedule"`
-	Comments     types.String                                                 `tfsdk:"comments"`
-	ProfileGroup *resourceSecurityEndpointToEndpointPoliciesProfileGroupModel `tfsdk:"profile_group"`
-	LogTraffic   types.String                                                 `tfsdk:"log_traffic"`
+	ID                 types.String                                                 `tfsdk:"id"`
+	PrimaryKey         types.String                                                 `tfsdk:"primary_key"`
+	Enabled            types.Bool                                                   `tfsdk:"enabled"`
+	Users              []resourceSecurityEndpointToEndpointPoliciesUsersModel       `tfsdk:"users"`
+	Sources            []resourceSecurityEndpointToEndpointPoliciesSourcesModel     `tfsdk:"sources"`
+	Services           []resourceSecurityEndpointToEndpointPoliciesServicesModel    `tfsdk:"services"`
+	Action             types.String                                                 `tfsdk:"action"`
+	Schedule           *resourceSecurityEndpointToEndpointPoliciesScheduleModel     `tfsdk:"schedule"`
+	Comments           types.String                                                 `tfsdk:"comments"`
+	ProfileGroup       *resourceSecurityEndpointToEndpointPoliciesProfileGroupModel `tfsdk:"profile_group"`
+	LogTraffic         types.String                                                 `tfsdk:"log_traffic"`
+	DeletionProtection types.Bool                                                   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool                                                   `tfsdk:"adopt_existing"`
+	ForceDetach        types.Bool                                                   `tfsdk:"force_detach"`
+	RawJson            types.String                                                 `tfsdk:"raw_json"`
+	Timeouts           timeouts.Value                                               `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityEndpointToEndpointPolicies) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,7 +58,9 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Metadata(ctx context.Contex
 
 func (r *resourceSecurityEndpointToEndpointPolicies) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -57,6 +68,9 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Schema(ctx context.Context,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -88,7 +102,7 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Schema(ctx context.Context,
 				Computed: true,
 				Optional: true,
 			},
-			"users": schema.ListNestedAttribute{
+			"users": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -107,7 +121,7 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Schema(ctx context.Context,
 				Computed: true,
 				Optional: true,
 			},
-			"sources": schema.ListNestedAttribute{
+			"sources": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -126,7 +140,7 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Schema(ctx context.Context,
 				Computed: true,
 				Optional: true,
 			},
-			"services": schema.ListNestedAttribute{
+			"services": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -190,6 +204,9 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Schema(ctx context.Context,
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -215,6 +232,18 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Configure(ctx context.Conte
 	r.resourceName = "fortisase_security_endpoint_to_endpoint_policies"
 }
 
+func (r *resourceSecurityEndpointToEndpointPolicies) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
+func (r *resourceSecurityEndpointToEndpointPolicies) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered lists users, sources and services to sets.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityEndpointToEndpointPolicies) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("profile-group")
 	lock.Lock()
@@ -224,12 +253,16 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Create(ctx context.Context,
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityEndpointToEndpointPolicies(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityEndpointToEndpointPolicies(ctx, "create", diags))
 
@@ -237,6 +270,17 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Create(ctx context.Context,
 		return
 	}
 	output, err := c.CreateSecurityEndpointToEndpointPolicies(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityEndpointToEndpointPolicies(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityEndpointToEndpointPolicies(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityEndpointToEndpointPolicies, c.UpdateSecurityEndpointToEndpointPolicies, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -248,10 +292,11 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Create(ctx context.Context,
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityEndpointToEndpointPolicies(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityEndpointToEndpointPolicies(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityEndpointToEndpointPolicies, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -283,17 +328,22 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Update(ctx context.Context,
 
 	var data resourceSecurityEndpointToEndpointPoliciesModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityEndpointToEndpointPolicies(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityEndpointToEndpointPolicies(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -309,10 +359,11 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Update(ctx context.Context,
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityEndpointToEndpointPolicies(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityEndpointToEndpointPolicies(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityEndpointToEndpointPolicies, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -342,14 +393,25 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Delete(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityEndpointToEndpointPolicies(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/endpoint-to-endpoint-policies", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityEndpointToEndpointPolicies(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -370,11 +432,14 @@ func (r *resourceSecurityEndpointToEndpointPolicies) Read(ctx context.Context, r
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityEndpointToEndpointPolicies(ctx, "read", diags))
 
@@ -399,12 +464,20 @@ func (r *resourceSecurityEndpointToEndpointPolicies) ImportState(ctx context.Con
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *resourceSecurityEndpointToEndpointPolicies) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateFrom("fortisase_security_endpoint_to_endpoint_policies_clone"),
+	}
+}
+
 func (m *resourceSecurityEndpointToEndpointPoliciesModel) refreshSecurityEndpointToEndpointPolicies(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if o == nil {
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityFileFilterProfile{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityFileFilterProfile{}
 
 func newResourceSecurityFileFilterProfile() resource.Resource {
 	return &resourceSecurityFileFilterProfile{}
@@ -36,6 +38,8 @@ type resourceSecurityFileFilterProfileModel struct {
 	Monitor                     []resourceSecurityFileFilterProfileMonitorModel `tfsdk:"monitor"`
 	BlockPasswordProtectedFiles types.Bool                                      `tfsdk:"block_password_protected_files"`
 	Direction                   types.String                                    `tfsdk:"direction"`
+	RawJson                     types.String                                    `tfsdk:"raw_json"`
+	Timeouts                    timeouts.Value                                  `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityFileFilterProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +56,7 @@ func (r *resourceSecurityFileFilterProfile) Schema(ctx context.Context, req reso
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -106,6 +111,9 @@ func (r *resourceSecurityFileFilterProfile) Schema(ctx context.Context, req reso
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -131,6 +139,10 @@ func (r *resourceSecurityFileFilterProfile) Configure(ctx context.Context, req r
 	r.resourceName = "fortisase_security_file_filter_profile"
 }
 
+func (r *resourceSecurityFileFilterProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
 func (r *resourceSecurityFileFilterProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityFileFilterProfile")
 	lock.Lock()
@@ -143,9 +155,12 @@ func (r *resourceSecurityFileFilterProfile) Create(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityFileFilterProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityFileFilterProfile(ctx, "create", diags))
@@ -165,10 +180,11 @@ func (r *resourceSecurityFileFilterProfile) Create(ctx context.Context, req reso
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityFileFilterProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityFileFilterProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityFileFilterProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -203,14 +219,18 @@ func (r *resourceSecurityFileFilterProfile) Update(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityFileFilterProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityFileFilterProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -226,10 +246,11 @@ func (r *resourceSecurityFileFilterProfile) Update(ctx context.Context, req reso
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityFileFilterProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityFileFilterProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityFileFilterProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -260,11 +281,14 @@ func (r *resourceSecurityFileFilterProfile) Read(ctx context.Context, req resour
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityFileFilterProfile(ctx, "read", diags))
 
@@ -295,6 +319,8 @@ func (m *resourceSecurityFileFilterProfileModel) refreshSecurityFileFilterProfil
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["block"]; ok {
 		m.Block = m.flattenSecurityFileFilterProfileBlockList(ctx, v, &diags)
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,10 +31,14 @@ type resourceSecurityFortiguardLocalCategories struct {
 
 // resourceSecurityFortiguardLocalCategoriesModel describes the resource data model.
 type resourceSecurityFortiguardLocalCategoriesModel struct {
-	ID           types.String `tfsdk:"id"`
-	PrimaryKey   types.String `tfsdk:"primary_key"`
-	ThreatWeight types.String `tfsdk:"threat_weight"`
-	Urls         types.Set    `tfsdk:"urls"`
+	ID            types.String   `tfsdk:"id"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	ThreatWeight  types.String   `tfsdk:"threat_weight"`
+	Urls          types.Set      `tfsdk:"urls"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool     `tfsdk:"force_detach"`
+	RawJson       types.String   `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityFortiguardLocalCategories) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +55,9 @@ func (r *resourceSecurityFortiguardLocalCategories) Schema(ctx context.Context,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -69,6 +77,9 @@ func (r *resourceSecurityFortiguardLocalCategories) Schema(ctx context.Context,
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -106,9 +117,12 @@ func (r *resourceSecurityFortiguardLocalCategories) Create(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityFortiguardLocalCategories(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityFortiguardLocalCategories(ctx, "create", diags))
 
@@ -116,6 +130,17 @@ func (r *resourceSecurityFortiguardLocalCategories) Create(ctx context.Context,
 		return
 	}
 	output, err := c.CreateSecurityFortiguardLocalCategories(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityFortiguardLocalCategories(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityFortiguardLocalCategories(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityFortiguardLocalCategories, c.UpdateSecurityFortiguardLocalCategories, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -127,10 +152,11 @@ func (r *resourceSecurityFortiguardLocalCategories) Create(ctx context.Context,
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityFortiguardLocalCategories(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityFortiguardLocalCategories(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityFortiguardLocalCategories, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -165,14 +191,18 @@ func (r *resourceSecurityFortiguardLocalCategories) Update(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityFortiguardLocalCategories(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityFortiguardLocalCategories(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -188,10 +218,11 @@ func (r *resourceSecurityFortiguardLocalCategories) Update(ctx context.Context,
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityFortiguardLocalCategories(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityFortiguardLocalCategories(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityFortiguardLocalCategories, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -221,14 +252,21 @@ func (r *resourceSecurityFortiguardLocalCategories) Delete(ctx context.Context,
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityFortiguardLocalCategories(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/fortiguard-local-categories", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityFortiguardLocalCategories(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -249,11 +287,14 @@ func (r *resourceSecurityFortiguardLocalCategories) Read(ctx context.Context, re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityFortiguardLocalCategories(ctx, "read", diags))
 
@@ -284,6 +325,8 @@ func (m *resourceSecurityFortiguardLocalCategoriesModel) refreshSecurityFortigua
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["threatWeight"]; ok {
 		m.ThreatWeight = parseStringValue(v)
 	}
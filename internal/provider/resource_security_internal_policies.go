@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityInternalPolicies{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityInternalPolicies{}
+var _ resource.ResourceWithMoveState = &resourceSecurityInternalPolicies{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityInternalPolicies{}
 
 func newResourceSecurityInternalPolicies() resource.Resource {
 	return &resourceSecurityInternalPolicies{}
@@ -44,6 +48,11 @@ type resourceSecurityInternalPoliciesModel struct {
 	LogTraffic          types.String                                        `tfsdk:"log_traffic"`
 	Sources             []resourceSecurityInternalPoliciesSourcesModel      `tfsdk:"sources"`
 	CaptivePortalExempt types.Bool                                          `tfsdk:"captive_portal_exempt"`
+	DeletionProtection  types.Bool                                          `tfsdk:"deletion_protection"`
+	AdoptExisting       types.Bool                                          `tfsdk:"adopt_existing"`
+	ForceDetach         types.Bool                                          `tfsdk:"force_detach"`
+	RawJson             types.String                                        `tfsdk:"raw_json"`
+	Timeouts            timeouts.Value                                      `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityInternalPolicies) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,7 +61,9 @@ func (r *resourceSecurityInternalPolicies) Metadata(ctx context.Context, req res
 
 func (r *resourceSecurityInternalPolicies) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -60,6 +71,9 @@ func (r *resourceSecurityInternalPolicies) Schema(ctx context.Context, req resou
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -102,7 +116,7 @@ func (r *resourceSecurityInternalPolicies) Schema(ctx context.Context, req resou
 				Computed: true,
 				Optional: true,
 			},
-			"users": schema.ListNestedAttribute{
+			"users": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -121,7 +135,7 @@ func (r *resourceSecurityInternalPolicies) Schema(ctx context.Context, req resou
 				Computed: true,
 				Optional: true,
 			},
-			"destinations": schema.ListNestedAttribute{
+			"destinations": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -140,7 +154,7 @@ func (r *resourceSecurityInternalPolicies) Schema(ctx context.Context, req resou
 				Computed: true,
 				Optional: true,
 			},
-			"services": schema.ListNestedAttribute{
+			"services": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -203,7 +217,7 @@ func (r *resourceSecurityInternalPolicies) Schema(ctx context.Context, req resou
 				Computed: true,
 				Optional: true,
 			},
-			"sources": schema.ListNestedAttribute{
+			"sources": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -223,6 +237,9 @@ func (r *resourceSecurityInternalPolicies) Schema(ctx context.Context, req resou
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -248,6 +265,18 @@ func (r *resourceSecurityInternalPolicies) Configure(ctx context.Context, req re
 	r.resourceName = "fortisase_security_internal_policies"
 }
 
+func (r *resourceSecurityInternalPolicies) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
+func (r *resourceSecurityInternalPolicies) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered lists users, destinations, services and sources to sets.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityInternalPolicies) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("profile-group")
 	lock.Lock()
@@ -257,12 +286,16 @@ func (r *resourceSecurityInternalPolicies) Create(ctx context.Context, req resou
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityInternalPolicies(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityInternalPolicies(ctx, "create", diags))
 
@@ -270,6 +303,17 @@ func (r *resourceSecurityInternalPolicies) Create(ctx context.Context, req resou
 		return
 	}
 	output, err := c.CreateSecurityInternalPolicies(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityInternalPolicies(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityInternalPolicies(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityInternalPolicies, c.UpdateSecurityInternalPolicies, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -281,10 +325,11 @@ func (r *resourceSecurityInternalPolicies) Create(ctx context.Context, req resou
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityInternalPolicies(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityInternalPolicies(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityInternalPolicies, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -316,17 +361,22 @@ func (r *resourceSecurityInternalPolicies) Update(ctx context.Context, req resou
 
 	var data resourceSecurityInternalPoliciesModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityInternalPolicies(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityInternalPolicies(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -342,10 +392,11 @@ func (r *resourceSecurityInternalPolicies) Update(ctx context.Context, req resou
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityInternalPolicies(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityInternalPolicies(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityInternalPolicies, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -375,14 +426,25 @@ func (r *resourceSecurityInternalPolicies) Delete(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityInternalPolicies(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/internal-policies", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityInternalPolicies(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -403,11 +465,14 @@ func (r *resourceSecurityInternalPolicies) Read(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityInternalPolicies(ctx, "read", diags))
 
@@ -432,12 +497,20 @@ func (r *resourceSecurityInternalPolicies) ImportState(ctx context.Context, req
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *resourceSecurityInternalPolicies) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateFrom("fortisase_security_internal_policies_clone"),
+	}
+}
+
 func (m *resourceSecurityInternalPoliciesModel) refreshSecurityInternalPolicies(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if o == nil {
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
@@ -0,0 +1,28 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func newResourceSecurityInternalPolicySet() resource.Resource {
+	return &resourceSecurityPolicySet[resourceSecurityInternalPoliciesModel]{
+		policyType: securityPolicySetType[resourceSecurityInternalPoliciesModel]{
+			name:           "internal",
+			url:            "/resource-api/v2/security/internal-policies",
+			policyResource: newResourceSecurityInternalPolicies,
+			fields: func(m *resourceSecurityInternalPoliciesModel) securityPolicySetFields {
+				return securityPolicySetFields{ID: &m.ID, PrimaryKey: &m.PrimaryKey, DeletionProtection: &m.DeletionProtection, Timeouts: &m.Timeouts}
+			},
+			getCreateObject: (*resourceSecurityInternalPoliciesModel).getCreateObjectSecurityInternalPolicies,
+			getUpdateObject: (*resourceSecurityInternalPoliciesModel).getUpdateObjectSecurityInternalPolicies,
+			getURLObject:    (*resourceSecurityInternalPoliciesModel).getURLObjectSecurityInternalPolicies,
+			refresh:         (*resourceSecurityInternalPoliciesModel).refreshSecurityInternalPolicies,
+			create:          (*forticlient.FortiSDKClient).CreateSecurityInternalPolicies,
+			read:            (*forticlient.FortiSDKClient).ReadSecurityInternalPolicies,
+			update:          (*forticlient.FortiSDKClient).UpdateSecurityInternalPolicies,
+			delete:          (*forticlient.FortiSDKClient).DeleteSecurityInternalPolicies,
+		},
+	}
+}
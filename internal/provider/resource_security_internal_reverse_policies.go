@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityInternalReversePolicies{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityInternalReversePolicies{}
+var _ resource.ResourceWithMoveState = &resourceSecurityInternalReversePolicies{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityInternalReversePolicies{}
 
 func newResourceSecurityInternalReversePolicies() resource.Resource {
 	return &resourceSecurityInternalReversePolicies{}
@@ -30,18 +34,23 @@ type resourceSecurityInternalReversePolicies struct {
 
 // resourceSecurityInternalReversePoliciesModel describes the resource data model.
 type resourceSecurityInternalReversePoliciesModel struct {
-	ID           types.String                                               `tfsdk:"id"`
-	PrimaryKey   types.String                                               `tfsdk:"primary_key"`
-	Enabled      types.Bool                                                 `tfsdk:"enabled"`
-	Scope        types.String                                               `tfsdk:"scope"`
-	Sources      []resourceSecurityInternalReversePoliciesSourcesModel      `tfsdk:"sources"`
-	Services     []resourceSecurityInternalReversePoliciesServicesModel     `tfsdk:"services"`
-	Action       types.String                                               `tfsdk:"action"`
-	Schedule     *resourceSecurityInternalReversePoliciesScheduleModel      `tfsdk:"schedule"`
-	Comments     types.String                                               `tfsdk:"comments"`
-	ProfileGroup *resourceSecurityInternalReversePoliciesProfileGroupModel  `tfsdk:"profile_group"`
-	LogTraffic   types.String                                               `tfsdk:"log_traffic"`
-	Destinations []resourceSecurityInternalReversePoliciesDestinationsModel `tfsdk:"destinations"`
+	ID                 types.String                                               `tfsdk:"id"`
+	PrimaryKey         types.String                                               `tfsdk:"primary_key"`
+	Enabled            types.Bool                                                 `tfsdk:"enabled"`
+	Scope              types.String                                               `tfsdk:"scope"`
+	Sources            []resourceSecurityInternalReversePoliciesSourcesModel      `tfsdk:"sources"`
+	Services           []resourceSecurityInternalReversePoliciesServicesModel     `tfsdk:"services"`
+	Action             types.String                                               `tfsdk:"action"`
+	Schedule           *resourceSecurityInternalReversePoliciesScheduleModel      `tfsdk:"schedule"`
+	Comments           types.String                                               `tfsdk:"comments"`
+	ProfileGroup       *resourceSecurityInternalReversePoliciesProfileGroupModel  `tfsdk:"profile_group"`
+	LogTraffic         types.String                                               `tfsdk:"log_traffic"`
+	Destinations       []resourceSecurityInternalReversePoliciesDestinationsModel `tfsdk:"destinations"`
+	DeletionProtection types.Bool                                                 `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool                                                 `tfsdk:"adopt_existing"`
+	ForceDetach        types.Bool                                                 `tfsdk:"force_detach"`
+	RawJson            types.String                                               `tfsdk:"raw_json"`
+	Timeouts           timeouts.Value                                             `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityInternalReversePolicies) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,7 +59,9 @@ func (r *resourceSecurityInternalReversePolicies) Metadata(ctx context.Context,
 
 func (r *resourceSecurityInternalReversePolicies) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -58,6 +69,9 @@ func (r *resourceSecurityInternalReversePolicies) Schema(ctx context.Context, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
@@ -96,7 +110,7 @@ func (r *resourceSecurityInternalReversePolicies) Schema(ctx context.Context, re
 				Computed: true,
 				Optional: true,
 			},
-			"sources": schema.ListNestedAttribute{
+			"sources": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -115,7 +129,7 @@ func (r *resourceSecurityInternalReversePolicies) Schema(ctx context.Context, re
 				Computed: true,
 				Optional: true,
 			},
-			"services": schema.ListNestedAttribute{
+			"services": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -178,7 +192,7 @@ func (r *resourceSecurityInternalReversePolicies) Schema(ctx context.Context, re
 				Computed: true,
 				Optional: true,
 			},
-			"destinations": schema.ListNestedAttribute{
+			"destinations": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -198,6 +212,9 @@ func (r *resourceSecurityInternalReversePolicies) Schema(ctx context.Context, re
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -223,6 +240,18 @@ func (r *resourceSecurityInternalReversePolicies) Configure(ctx context.Context,
 	r.resourceName = "fortisase_security_internal_reverse_policies"
 }
 
+func (r *resourceSecurityInternalReversePolicies) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
+func (r *resourceSecurityInternalReversePolicies) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered lists sources, services and destinations to sets.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityInternalReversePolicies) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("profile-group")
 	lock.Lock()
@@ -232,12 +261,16 @@ func (r *resourceSecurityInternalReversePolicies) Create(ctx context.Context, re
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityInternalReversePolicies(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityInternalReversePolicies(ctx, "create", diags))
 
@@ -245,6 +278,17 @@ func (r *resourceSecurityInternalReversePolicies) Create(ctx context.Context, re
 		return
 	}
 	output, err := c.CreateSecurityInternalReversePolicies(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityInternalReversePolicies(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityInternalReversePolicies(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityInternalReversePolicies, c.UpdateSecurityInternalReversePolicies, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -256,10 +300,11 @@ func (r *resourceSecurityInternalReversePolicies) Create(ctx context.Context, re
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityInternalReversePolicies(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityInternalReversePolicies(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityInternalReversePolicies, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -291,17 +336,22 @@ func (r *resourceSecurityInternalReversePolicies) Update(ctx context.Context, re
 
 	var data resourceSecurityInternalReversePoliciesModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityInternalReversePolicies(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityInternalReversePolicies(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -317,10 +367,11 @@ func (r *resourceSecurityInternalReversePolicies) Update(ctx context.Context, re
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityInternalReversePolicies(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityInternalReversePolicies(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityInternalReversePolicies, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -350,14 +401,25 @@ func (r *resourceSecurityInternalReversePolicies) Delete(ctx context.Context, re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityInternalReversePolicies(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/internal-reverse-policies", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityInternalReversePolicies(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -378,11 +440,14 @@ func (r *resourceSecurityInternalReversePolicies) Read(ctx context.Context, req
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityInternalReversePolicies(ctx, "read", diags))
 
@@ -407,12 +472,20 @@ func (r *resourceSecurityInternalReversePolicies) ImportState(ctx context.Contex
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *resourceSecurityInternalReversePolicies) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateFrom("fortisase_security_internal_reverse_policies_clone"),
+	}
+}
+
 func (m *resourceSecurityInternalReversePoliciesModel) refreshSecurityInternalReversePolicies(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if o == nil {
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["enabled"]; ok {
 		m.Enabled = parseBoolValue(v)
 	}
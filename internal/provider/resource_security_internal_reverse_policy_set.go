@@ -0,0 +1,28 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func newResourceSecurityInternalReversePolicySet() resource.Resource {
+	return &resourceSecurityPolicySet[resourceSecurityInternalReversePoliciesModel]{
+		policyType: securityPolicySetType[resourceSecurityInternalReversePoliciesModel]{
+			name:           "internal-reverse",
+			url:            "/resource-api/v2/security/internal-reverse-policies",
+			policyResource: newResourceSecurityInternalReversePolicies,
+			fields: func(m *resourceSecurityInternalReversePoliciesModel) securityPolicySetFields {
+				return securityPolicySetFields{ID: &m.ID, PrimaryKey: &m.PrimaryKey, DeletionProtection: &m.DeletionProtection, Timeouts: &m.Timeouts}
+			},
+			getCreateObject: (*resourceSecurityInternalReversePoliciesModel).getCreateObjectSecurityInternalReversePolicies,
+			getUpdateObject: (*resourceSecurityInternalReversePoliciesModel).getUpdateObjectSecurityInternalReversePolicies,
+			getURLObject:    (*resourceSecurityInternalReversePoliciesModel).getURLObjectSecurityInternalReversePolicies,
+			refresh:         (*resourceSecurityInternalReversePoliciesModel).refreshSecurityInternalReversePolicies,
+			create:          (*forticlient.FortiSDKClient).CreateSecurityInternalReversePolicies,
+			read:            (*forticlient.FortiSDKClient).ReadSecurityInternalReversePolicies,
+			update:          (*forticlient.FortiSDKClient).UpdateSecurityInternalReversePolicies,
+			delete:          (*forticlient.FortiSDKClient).DeleteSecurityInternalReversePolicies,
+		},
+	}
+}
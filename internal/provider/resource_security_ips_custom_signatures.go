@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityIpsCustomSignatures{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityIpsCustomSignatures{}
 
 func newResourceSecurityIpsCustomSignatures() resource.Resource {
 	return &resourceSecurityIpsCustomSignatures{}
@@ -30,21 +33,25 @@ type resourceSecurityIpsCustomSignatures struct {
 
 // resourceSecurityIpsCustomSignaturesModel describes the resource data model.
 type resourceSecurityIpsCustomSignaturesModel struct {
-	ID          types.String  `tfsdk:"id"`
-	PrimaryKey  types.String  `tfsdk:"primary_key"`
-	Tag         types.String  `tfsdk:"tag"`
-	Signature   types.String  `tfsdk:"signature"`
-	RuleId      types.Float64 `tfsdk:"rule_id"`
-	Status      types.String  `tfsdk:"status"`
-	Log         types.String  `tfsdk:"log"`
-	LogPacket   types.String  `tfsdk:"log_packet"`
-	Action      types.String  `tfsdk:"action"`
-	Severity    types.String  `tfsdk:"severity"`
-	Location    types.String  `tfsdk:"location"`
-	Os          types.String  `tfsdk:"os"`
-	Application types.String  `tfsdk:"application"`
-	Protocol    types.String  `tfsdk:"protocol"`
-	Comment     types.String  `tfsdk:"comment"`
+	ID            types.String   `tfsdk:"id"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	Tag           types.String   `tfsdk:"tag"`
+	Signature     types.String   `tfsdk:"signature"`
+	RuleId        types.Int64    `tfsdk:"rule_id"`
+	Status        types.String   `tfsdk:"status"`
+	Log           types.String   `tfsdk:"log"`
+	LogPacket     types.String   `tfsdk:"log_packet"`
+	Action        types.String   `tfsdk:"action"`
+	Severity      types.String   `tfsdk:"severity"`
+	Location      types.String   `tfsdk:"location"`
+	Os            types.String   `tfsdk:"os"`
+	Application   types.String   `tfsdk:"application"`
+	Protocol      types.String   `tfsdk:"protocol"`
+	Comment       types.String   `tfsdk:"comment"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool     `tfsdk:"force_detach"`
+	RawJson       types.String   `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityIpsCustomSignatures) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +60,7 @@ func (r *resourceSecurityIpsCustomSignatures) Metadata(ctx context.Context, req
 
 func (r *resourceSecurityIpsCustomSignatures) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -61,6 +69,9 @@ func (r *resourceSecurityIpsCustomSignatures) Schema(ctx context.Context, req re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 63),
@@ -78,7 +89,10 @@ func (r *resourceSecurityIpsCustomSignatures) Schema(ctx context.Context, req re
 				Computed: true,
 				Optional: true,
 			},
-			"rule_id": schema.Float64Attribute{
+			"rule_id": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
@@ -126,6 +140,9 @@ func (r *resourceSecurityIpsCustomSignatures) Schema(ctx context.Context, req re
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -151,6 +168,13 @@ func (r *resourceSecurityIpsCustomSignatures) Configure(ctx context.Context, req
 	r.resourceName = "fortisase_security_ips_custom_signatures"
 }
 
+func (r *resourceSecurityIpsCustomSignatures) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityIpsCustomSignatures) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityIpsCustomSignatures")
 	lock.Lock()
@@ -163,9 +187,12 @@ func (r *resourceSecurityIpsCustomSignatures) Create(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityIpsCustomSignatures(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityIpsCustomSignatures(ctx, "create", diags))
 
@@ -173,6 +200,17 @@ func (r *resourceSecurityIpsCustomSignatures) Create(ctx context.Context, req re
 		return
 	}
 	output, err := c.CreateSecurityIpsCustomSignatures(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityIpsCustomSignatures(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityIpsCustomSignatures(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityIpsCustomSignatures, c.UpdateSecurityIpsCustomSignatures, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -184,10 +222,11 @@ func (r *resourceSecurityIpsCustomSignatures) Create(ctx context.Context, req re
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityIpsCustomSignatures(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityIpsCustomSignatures(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityIpsCustomSignatures, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -222,14 +261,18 @@ func (r *resourceSecurityIpsCustomSignatures) Update(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityIpsCustomSignatures(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityIpsCustomSignatures(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -245,10 +288,11 @@ func (r *resourceSecurityIpsCustomSignatures) Update(ctx context.Context, req re
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityIpsCustomSignatures(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityIpsCustomSignatures(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityIpsCustomSignatures, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -278,14 +322,21 @@ func (r *resourceSecurityIpsCustomSignatures) Delete(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityIpsCustomSignatures(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/ips-custom-signatures", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityIpsCustomSignatures(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -306,11 +357,14 @@ func (r *resourceSecurityIpsCustomSignatures) Read(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityIpsCustomSignatures(ctx, "read", diags))
 
@@ -341,12 +395,14 @@ func (m *resourceSecurityIpsCustomSignaturesModel) refreshSecurityIpsCustomSigna
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["tag"]; ok {
 		m.Tag = parseStringValue(v)
 	}
 
 	if v, ok := o["ruleId"]; ok {
-		m.RuleId = parseFloat64Value(v)
+		m.RuleId = parseInt64Value(v)
 	}
 
 	if v, ok := o["status"]; ok {
@@ -407,7 +463,7 @@ func (data *resourceSecurityIpsCustomSignaturesModel) getCreateObjectSecurityIps
 	}
 
 	if !data.RuleId.IsNull() {
-		result["ruleId"] = data.RuleId.ValueFloat64()
+		result["ruleId"] = data.RuleId.ValueInt64()
 	}
 
 	if !data.Status.IsNull() {
@@ -468,7 +524,7 @@ func (data *resourceSecurityIpsCustomSignaturesModel) getUpdateObjectSecurityIps
 	}
 
 	if !data.RuleId.IsNull() {
-		result["ruleId"] = data.RuleId.ValueFloat64()
+		result["ruleId"] = data.RuleId.ValueInt64()
 	}
 
 	if !data.Status.IsNull() {
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +20,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityIpsProfile{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityIpsProfile{}
+var _ resource.ResourceWithConfigValidators = &resourceSecurityIpsProfile{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityIpsProfile{}
 
 func newResourceSecurityIpsProfile() resource.Resource {
 	return &resourceSecurityIpsProfile{}
@@ -40,6 +45,8 @@ type resourceSecurityIpsProfileModel struct {
 	Comment                types.String                                      `tfsdk:"comment"`
 	Entries                []resourceSecurityIpsProfileEntriesModel          `tfsdk:"entries"`
 	Direction              types.String                                      `tfsdk:"direction"`
+	RawJson                types.String                                      `tfsdk:"raw_json"`
+	Timeouts               timeouts.Value                                    `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityIpsProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +55,7 @@ func (r *resourceSecurityIpsProfile) Metadata(ctx context.Context, req resource.
 
 func (r *resourceSecurityIpsProfile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -56,6 +64,7 @@ func (r *resourceSecurityIpsProfile) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -227,7 +236,10 @@ func (r *resourceSecurityIpsProfile) Schema(ctx context.Context, req resource.Sc
 						"vuln_type": schema.ListNestedAttribute{
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
-									"id": schema.Float64Attribute{
+									"id": schema.Int64Attribute{
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
 										Computed: true,
 										Optional: true,
 									},
@@ -239,7 +251,10 @@ func (r *resourceSecurityIpsProfile) Schema(ctx context.Context, req resource.Sc
 						"exempt_ip": schema.ListNestedAttribute{
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
-									"id": schema.Float64Attribute{
+									"id": schema.Int64Attribute{
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
 										Computed: true,
 										Optional: true,
 									},
@@ -262,6 +277,25 @@ func (r *resourceSecurityIpsProfile) Schema(ctx context.Context, req resource.Sc
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
+	}
+}
+
+func (r *resourceSecurityIpsProfile) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validateDiscriminator(path.MatchRoot("profile_type"), map[string]discriminatorVariant{
+			"recommended": {
+				conflicting: []string{"custom_rule_groups", "entries"},
+			},
+			"critical": {
+				conflicting: []string{"custom_rule_groups", "entries"},
+			},
+			"monitor": {
+				conflicting: []string{"custom_rule_groups", "entries"},
+			},
+		}),
 	}
 }
 
@@ -287,6 +321,17 @@ func (r *resourceSecurityIpsProfile) Configure(ctx context.Context, req resource
 	r.resourceName = "fortisase_security_ips_profile"
 }
 
+func (r *resourceSecurityIpsProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityIpsProfile) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityIpsProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityIpsProfile")
 	lock.Lock()
@@ -299,9 +344,12 @@ func (r *resourceSecurityIpsProfile) Create(ctx context.Context, req resource.Cr
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityIpsProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityIpsProfile(ctx, "create", diags))
@@ -321,10 +369,11 @@ func (r *resourceSecurityIpsProfile) Create(ctx context.Context, req resource.Cr
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityIpsProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityIpsProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityIpsProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -359,14 +408,18 @@ func (r *resourceSecurityIpsProfile) Update(ctx context.Context, req resource.Up
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityIpsProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityIpsProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -382,10 +435,11 @@ func (r *resourceSecurityIpsProfile) Update(ctx context.Context, req resource.Up
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityIpsProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityIpsProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityIpsProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -416,11 +470,14 @@ func (r *resourceSecurityIpsProfile) Read(ctx context.Context, req resource.Read
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityIpsProfile(ctx, "read", diags))
 
@@ -451,6 +508,8 @@ func (m *resourceSecurityIpsProfileModel) refreshSecurityIpsProfile(ctx context.
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["profileType"]; ok {
 		m.ProfileType = parseStringValue(v)
 	}
@@ -606,13 +665,13 @@ type resourceSecurityIpsProfileEntriesRuleModel struct {
 }
 
 type resourceSecurityIpsProfileEntriesVulnTypeModel struct {
-	Id types.Float64 `tfsdk:"id"`
+	Id types.Int64 `tfsdk:"id"`
 }
 
 type resourceSecurityIpsProfileEntriesExemptIpModel struct {
-	Id    types.Float64 `tfsdk:"id"`
-	SrcIp types.String  `tfsdk:"src_ip"`
-	DstIp types.String  `tfsdk:"dst_ip"`
+	Id    types.Int64  `tfsdk:"id"`
+	SrcIp types.String `tfsdk:"src_ip"`
+	DstIp types.String `tfsdk:"dst_ip"`
 }
 
 func (m *resourceSecurityIpsProfileCustomRuleGroupsModel) flattenSecurityIpsProfileCustomRuleGroups(ctx context.Context, input interface{}, diags *diag.Diagnostics) *resourceSecurityIpsProfileCustomRuleGroupsModel {
@@ -856,7 +915,7 @@ func (m *resourceSecurityIpsProfileEntriesVulnTypeModel) flattenSecurityIpsProfi
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	return m
@@ -895,7 +954,7 @@ func (m *resourceSecurityIpsProfileEntriesExemptIpModel) flattenSecurityIpsProfi
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["id"]; ok {
-		m.Id = parseFloat64Value(v)
+		m.Id = parseInt64Value(v)
 	}
 
 	if v, ok := o["src-ip"]; ok {
@@ -1078,7 +1137,7 @@ func (s *resourceSecurityIpsProfileEntriesModel) expandSecurityIpsProfileEntries
 func (data *resourceSecurityIpsProfileEntriesVulnTypeModel) expandSecurityIpsProfileEntriesVulnType(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Id.IsNull() {
-		result["id"] = data.Id.ValueFloat64()
+		result["id"] = data.Id.ValueInt64()
 	}
 
 	return result
@@ -1095,7 +1154,7 @@ func (s *resourceSecurityIpsProfileEntriesModel) expandSecurityIpsProfileEntries
 func (data *resourceSecurityIpsProfileEntriesExemptIpModel) expandSecurityIpsProfileEntriesExemptIp(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Id.IsNull() {
-		result["id"] = data.Id.ValueFloat64()
+		result["id"] = data.Id.ValueInt64()
 	}
 
 	if !data.SrcIp.IsNull() {
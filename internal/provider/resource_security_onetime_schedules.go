@@ -5,7 +5,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +21,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityOnetimeSchedules{}
+var _ resource.ResourceWithConfigValidators = &resourceSecurityOnetimeSchedules{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityOnetimeSchedules{}
 
 func newResourceSecurityOnetimeSchedules() resource.Resource {
 	return &resourceSecurityOnetimeSchedules{}
@@ -31,11 +35,15 @@ type resourceSecurityOnetimeSchedules struct {
 
 // resourceSecurityOnetimeSchedulesModel describes the resource data model.
 type resourceSecurityOnetimeSchedulesModel struct {
-	ID             types.String  `tfsdk:"id"`
-	PrimaryKey     types.String  `tfsdk:"primary_key"`
-	ExpirationDays types.Float64 `tfsdk:"expiration_days"`
-	StartUtc       types.Float64 `tfsdk:"start_utc"`
-	EndUtc         types.Float64 `tfsdk:"end_utc"`
+	ID             types.String   `tfsdk:"id"`
+	PrimaryKey     types.String   `tfsdk:"primary_key"`
+	ExpirationDays types.Int64    `tfsdk:"expiration_days"`
+	StartUtc       types.Int64    `tfsdk:"start_utc"`
+	EndUtc         types.Int64    `tfsdk:"end_utc"`
+	AdoptExisting  types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach    types.Bool     `tfsdk:"force_detach"`
+	RawJson        types.String   `tfsdk:"raw_json"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityOnetimeSchedules) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,6 +52,7 @@ func (r *resourceSecurityOnetimeSchedules) Metadata(ctx context.Context, req res
 
 func (r *resourceSecurityOnetimeSchedules) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -52,28 +61,49 @@ func (r *resourceSecurityOnetimeSchedules) Schema(ctx context.Context, req resou
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 31),
 				},
 				Required: true,
 			},
-			"expiration_days": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.Between(1, 100),
+			"expiration_days": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"start_utc": schema.Float64Attribute{
+			"start_utc": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
-			"end_utc": schema.Float64Attribute{
+			"end_utc": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				Computed: true,
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceSecurityOnetimeSchedules) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("start_utc"),
+			path.MatchRoot("end_utc"),
+		),
 	}
 }
 
@@ -99,6 +129,13 @@ func (r *resourceSecurityOnetimeSchedules) Configure(ctx context.Context, req re
 	r.resourceName = "fortisase_security_onetime_schedules"
 }
 
+func (r *resourceSecurityOnetimeSchedules) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityOnetimeSchedules) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityOnetimeSchedules")
 	lock.Lock()
@@ -111,9 +148,12 @@ func (r *resourceSecurityOnetimeSchedules) Create(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityOnetimeSchedules(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityOnetimeSchedules(ctx, "create", diags))
 
@@ -121,6 +161,17 @@ func (r *resourceSecurityOnetimeSchedules) Create(ctx context.Context, req resou
 		return
 	}
 	output, err := c.CreateSecurityOnetimeSchedules(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityOnetimeSchedules(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityOnetimeSchedules(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityOnetimeSchedules, c.UpdateSecurityOnetimeSchedules, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -132,10 +183,11 @@ func (r *resourceSecurityOnetimeSchedules) Create(ctx context.Context, req resou
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityOnetimeSchedules(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityOnetimeSchedules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityOnetimeSchedules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -170,14 +222,18 @@ func (r *resourceSecurityOnetimeSchedules) Update(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityOnetimeSchedules(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityOnetimeSchedules(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -193,10 +249,11 @@ func (r *resourceSecurityOnetimeSchedules) Update(ctx context.Context, req resou
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityOnetimeSchedules(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityOnetimeSchedules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityOnetimeSchedules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -226,14 +283,21 @@ func (r *resourceSecurityOnetimeSchedules) Delete(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityOnetimeSchedules(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/onetime-schedules", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityOnetimeSchedules(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -254,11 +318,14 @@ func (r *resourceSecurityOnetimeSchedules) Read(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityOnetimeSchedules(ctx, "read", diags))
 
@@ -289,16 +356,18 @@ func (m *resourceSecurityOnetimeSchedulesModel) refreshSecurityOnetimeSchedules(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["expirationDays"]; ok {
-		m.ExpirationDays = parseFloat64Value(v)
+		m.ExpirationDays = parseInt64Value(v)
 	}
 
 	if v, ok := o["startUtc"]; ok {
-		m.StartUtc = parseFloat64Value(v)
+		m.StartUtc = parseInt64Value(v)
 	}
 
 	if v, ok := o["endUtc"]; ok {
-		m.EndUtc = parseFloat64Value(v)
+		m.EndUtc = parseInt64Value(v)
 	}
 
 	return diags
@@ -311,15 +380,15 @@ func (data *resourceSecurityOnetimeSchedulesModel) getCreateObjectSecurityOnetim
 	}
 
 	if !data.ExpirationDays.IsNull() {
-		result["expirationDays"] = data.ExpirationDays.ValueFloat64()
+		result["expirationDays"] = data.ExpirationDays.ValueInt64()
 	}
 
 	if !data.StartUtc.IsNull() {
-		result["startUtc"] = data.StartUtc.ValueFloat64()
+		result["startUtc"] = data.StartUtc.ValueInt64()
 	}
 
 	if !data.EndUtc.IsNull() {
-		result["endUtc"] = data.EndUtc.ValueFloat64()
+		result["endUtc"] = data.EndUtc.ValueInt64()
 	}
 
 	return &result
@@ -332,15 +401,15 @@ func (data *resourceSecurityOnetimeSchedulesModel) getUpdateObjectSecurityOnetim
 	}
 
 	if !data.ExpirationDays.IsNull() {
-		result["expirationDays"] = data.ExpirationDays.ValueFloat64()
+		result["expirationDays"] = data.ExpirationDays.ValueInt64()
 	}
 
 	if !data.StartUtc.IsNull() {
-		result["startUtc"] = data.StartUtc.ValueFloat64()
+		result["startUtc"] = data.StartUtc.ValueInt64()
 	}
 
 	if !data.EndUtc.IsNull() {
-		result["endUtc"] = data.EndUtc.ValueFloat64()
+		result["endUtc"] = data.EndUtc.ValueInt64()
 	}
 
 	return &result
@@ -17,6 +17,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityOutboundPoliciesClone2Edl{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityOutboundPoliciesClone2Edl{}
 
 func newResourceSecurityOutboundPoliciesClone() resource.Resource {
 	return &resourceSecurityOutboundPoliciesClone2Edl{}
@@ -28,10 +29,10 @@ type resourceSecurityOutboundPoliciesClone2Edl struct {
 }
 
 // resourceSecurityOutboundPoliciesClone2EdlModel describes the resource data model.
+// The attributes of the cloned policy are those of fortisase_security_outbound_policies.
 type resourceSecurityOutboundPoliciesClone2EdlModel struct {
-	ID         types.String `tfsdk:"id"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	BasedOn    types.String `tfsdk:"based_on"`
+	resourceSecurityOutboundPoliciesModel
+	BasedOn types.String `tfsdk:"based_on"`
 }
 
 func (r *resourceSecurityOutboundPoliciesClone2Edl) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,7 +41,8 @@ func (r *resourceSecurityOutboundPoliciesClone2Edl) Metadata(ctx context.Context
 
 func (r *resourceSecurityOutboundPoliciesClone2Edl) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
+		Version: 1,
+		Attributes: cloneSchemaAttributes(ctx, newResourceSecurityOutboundPolicies(), map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -52,14 +54,26 @@ func (r *resourceSecurityOutboundPoliciesClone2Edl) Schema(ctx context.Context,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 35),
 				},
-				Computed: true,
-				Optional: true,
+				MarkdownDescription: "The primary key of the cloned policy.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"based_on": schema.StringAttribute{
 				MarkdownDescription: "The policy you what to clone.",
 				Computed:            true,
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
+		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -86,18 +100,31 @@ func (r *resourceSecurityOutboundPoliciesClone2Edl) Configure(ctx context.Contex
 	r.resourceName = "fortisase_security_outbound_policies_clone"
 }
 
+func (r *resourceSecurityOutboundPoliciesClone2Edl) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered lists users, destinations, services and sources to sets.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityOutboundPoliciesClone2Edl) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	lock := r.fortiClient.GetResourceLock("profile-group")
+	lock.Lock()
+	defer lock.Unlock()
 	var data resourceSecurityOutboundPoliciesClone2EdlModel
 	diags := &resp.Diagnostics
 
 	// Read Terraform config data into the model
-	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(getCloneData(ctx, req.Config, &data)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityOutboundPoliciesClone(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityOutboundPoliciesClone(ctx, "create", diags))
 
@@ -113,71 +140,128 @@ func (r *resourceSecurityOutboundPoliciesClone2Edl) Create(ctx context.Context,
 		return
 	}
 
-	mkey := "SecurityOutboundPoliciesClone"
+	mkey := cloneCreatedMkey(output, data.PrimaryKey)
 	data.ID = types.StringValue(mkey)
+	data.PrimaryKey = types.StringValue(mkey)
+
+	diags.Append(r.read(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
 
-	diags.Append(resp.State.Set(ctx, &data)...)
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
 }
 
 func (r *resourceSecurityOutboundPoliciesClone2Edl) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	diags := &resp.Diagnostics
 
-	// Read Terraform plan data into the model
-	var state resourceSecurityOutboundPoliciesClone2EdlModel
-	diags.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform prior state data into the model, the cloned policy is only read back
+	// as every configurable attribute requires a replacement.
+	var data resourceSecurityOutboundPoliciesClone2EdlModel
+	diags.Append(getCloneData(ctx, req.State, &data)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 
-	var data resourceSecurityOutboundPoliciesClone2EdlModel
-	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(r.read(ctx, &data)...)
 	if diags.HasError() {
 		return
 	}
-	data.ID = state.ID
 
-	mkey := state.ID.ValueString()
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
+}
 
-	c := r.fortiClient.Client
-	var input_model forticlient.InputModel
-	input_model.Mkey = mkey
-	input_model.BodyParams = *(data.getUpdateObjectSecurityOutboundPoliciesClone(ctx, state, diags))
-	input_model.URLParams = *(data.getURLObjectSecurityOutboundPoliciesClone(ctx, "update", diags))
+func (r *resourceSecurityOutboundPoliciesClone2Edl) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	lock := r.fortiClient.GetResourceLock("profile-group")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+	var data resourceSecurityOutboundPoliciesClone2EdlModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(getCloneData(ctx, req.State, &data)...)
 
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
-	output, err := c.CreateSecurityOutboundPoliciesClone(&input_model)
+	mkey := cloneMkey(data.ID, data.PrimaryKey, "SecurityOutboundPoliciesClone")
+	if mkey == "" {
+		return
+	}
+	data.PrimaryKey = types.StringValue(mkey)
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+	input_model.URLParams = *(data.getURLObjectSecurityOutboundPolicies(ctx, "delete", diags))
+
+	output, err := c.DeleteSecurityOutboundPolicies(&input_model)
 	if err != nil {
 		diags.AddError(
-			fmt.Sprintf("Error to update resource %s: %v", r.resourceName, err),
+			fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
 			getErrorDetail(&input_model, output),
 		)
 		return
 	}
-
-	diags.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *resourceSecurityOutboundPoliciesClone2Edl) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No delete operation for this resource
 }
 
 func (r *resourceSecurityOutboundPoliciesClone2Edl) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// No read operation for this resource
+	diags := &resp.Diagnostics
+	var data resourceSecurityOutboundPoliciesClone2EdlModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(getCloneData(ctx, req.State, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
+
+	diags.Append(r.read(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
 }
 
-func (data *resourceSecurityOutboundPoliciesClone2EdlModel) getCreateObjectSecurityOutboundPoliciesClone(ctx context.Context, diags *diag.Diagnostics) *map[string]interface{} {
-	result := make(map[string]interface{})
-	if !data.PrimaryKey.IsNull() {
-		result["primaryKey"] = data.PrimaryKey.ValueString()
+// read refreshes the model from the cloned policy, which is read by its primary key.
+func (r *resourceSecurityOutboundPoliciesClone2Edl) read(ctx context.Context, data *resourceSecurityOutboundPoliciesClone2EdlModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mkey := cloneMkey(data.ID, data.PrimaryKey, "SecurityOutboundPoliciesClone")
+	if mkey == "" {
+		return diags
 	}
+	data.ID = types.StringValue(mkey)
+	data.PrimaryKey = types.StringValue(mkey)
 
-	return &result
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+	input_model.URLParams = *(data.getURLObjectSecurityOutboundPolicies(ctx, "read", &diags))
+
+	read_output, err := c.ReadSecurityOutboundPolicies(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, read_output),
+		)
+		return diags
+	}
+
+	diags.Append(data.refreshSecurityOutboundPolicies(ctx, read_output)...)
+	return diags
 }
 
-func (data *resourceSecurityOutboundPoliciesClone2EdlModel) getUpdateObjectSecurityOutboundPoliciesClone(ctx context.Context, state resourceSecurityOutboundPoliciesClone2EdlModel, diags *diag.Diagnostics) *map[string]interface{} {
+func (data *resourceSecurityOutboundPoliciesClone2EdlModel) getCreateObjectSecurityOutboundPoliciesClone(ctx context.Context, diags *diag.Diagnostics) *map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.PrimaryKey.IsNull() {
 		result["primaryKey"] = data.PrimaryKey.ValueString()
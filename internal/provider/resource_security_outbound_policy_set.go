@@ -0,0 +1,28 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func newResourceSecurityOutboundPolicySet() resource.Resource {
+	return &resourceSecurityPolicySet[resourceSecurityOutboundPoliciesModel]{
+		policyType: securityPolicySetType[resourceSecurityOutboundPoliciesModel]{
+			name:           "outbound",
+			url:            "/resource-api/v2/security/outbound-policies",
+			policyResource: newResourceSecurityOutboundPolicies,
+			fields: func(m *resourceSecurityOutboundPoliciesModel) securityPolicySetFields {
+				return securityPolicySetFields{ID: &m.ID, PrimaryKey: &m.PrimaryKey, DeletionProtection: &m.DeletionProtection, Timeouts: &m.Timeouts}
+			},
+			getCreateObject: (*resourceSecurityOutboundPoliciesModel).getCreateObjectSecurityOutboundPolicies,
+			getUpdateObject: (*resourceSecurityOutboundPoliciesModel).getUpdateObjectSecurityOutboundPolicies,
+			getURLObject:    (*resourceSecurityOutboundPoliciesModel).getURLObjectSecurityOutboundPolicies,
+			refresh:         (*resourceSecurityOutboundPoliciesModel).refreshSecurityOutboundPolicies,
+			create:          (*forticlient.FortiSDKClient).CreateSecurityOutboundPolicies,
+			read:            (*forticlient.FortiSDKClient).ReadSecurityOutboundPolicies,
+			update:          (*forticlient.FortiSDKClient).UpdateSecurityOutboundPolicies,
+			delete:          (*forticlient.FortiSDKClient).DeleteSecurityOutboundPolicies,
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +17,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityPkiUsers{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityPkiUsers{}
 
 func newResourceSecurityPkiUsers() resource.Resource {
 	return &resourceSecurityPkiUsers{}
@@ -33,8 +35,11 @@ type resourceSecurityPkiUsersModel struct {
 	Subject        types.String                     `tfsdk:"subject"`
 	Ca             *resourceSecurityPkiUsersCaModel `tfsdk:"ca"`
 	IsStaticObject types.Bool                       `tfsdk:"is_static_object"`
-	References     types.Float64                    `tfsdk:"references"`
+	References     types.Int64                      `tfsdk:"references"`
 	IsGlobalEntry  types.Bool                       `tfsdk:"is_global_entry"`
+	AdoptExisting  types.Bool                       `tfsdk:"adopt_existing"`
+	RawJson        types.String                     `tfsdk:"raw_json"`
+	Timeouts       timeouts.Value                   `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityPkiUsers) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,6 +48,7 @@ func (r *resourceSecurityPkiUsers) Metadata(ctx context.Context, req resource.Me
 
 func (r *resourceSecurityPkiUsers) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -51,6 +57,8 @@ func (r *resourceSecurityPkiUsers) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				MarkdownDescription: "Primary Key of PKI User.",
 				Required:            true,
@@ -62,7 +70,7 @@ func (r *resourceSecurityPkiUsers) Schema(ctx context.Context, req resource.Sche
 			"is_static_object": schema.BoolAttribute{
 				Computed: true,
 			},
-			"references": schema.Float64Attribute{
+			"references": schema.Int64Attribute{
 				Computed: true,
 			},
 			"is_global_entry": schema.BoolAttribute{
@@ -80,6 +88,9 @@ func (r *resourceSecurityPkiUsers) Schema(ctx context.Context, req resource.Sche
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -105,6 +116,13 @@ func (r *resourceSecurityPkiUsers) Configure(ctx context.Context, req resource.C
 	r.resourceName = "fortisase_security_pki_users"
 }
 
+func (r *resourceSecurityPkiUsers) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityPkiUsers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resourceSecurityPkiUsersModel
 	diags := &resp.Diagnostics
@@ -114,9 +132,12 @@ func (r *resourceSecurityPkiUsers) Create(ctx context.Context, req resource.Crea
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityPkiUsers(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityPkiUsers(ctx, "create", diags))
 
@@ -124,6 +145,17 @@ func (r *resourceSecurityPkiUsers) Create(ctx context.Context, req resource.Crea
 		return
 	}
 	output, err := c.CreateSecurityPkiUsers(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityPkiUsers(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityPkiUsers(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityPkiUsers, c.UpdateSecurityPkiUsers, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -135,10 +167,11 @@ func (r *resourceSecurityPkiUsers) Create(ctx context.Context, req resource.Crea
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityPkiUsers(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityPkiUsers(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityPkiUsers, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -170,14 +203,18 @@ func (r *resourceSecurityPkiUsers) Update(ctx context.Context, req resource.Upda
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityPkiUsers(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityPkiUsers(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -193,10 +230,11 @@ func (r *resourceSecurityPkiUsers) Update(ctx context.Context, req resource.Upda
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityPkiUsers(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityPkiUsers(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityPkiUsers, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -223,11 +261,14 @@ func (r *resourceSecurityPkiUsers) Delete(ctx context.Context, req resource.Dele
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityPkiUsers(ctx, "delete", diags))
 
@@ -251,11 +292,14 @@ func (r *resourceSecurityPkiUsers) Read(ctx context.Context, req resource.ReadRe
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityPkiUsers(ctx, "read", diags))
 
@@ -286,6 +330,8 @@ func (m *resourceSecurityPkiUsersModel) refreshSecurityPkiUsers(ctx context.Cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["subject"]; ok {
 		m.Subject = parseStringValue(v)
 	}
@@ -299,7 +345,7 @@ func (m *resourceSecurityPkiUsersModel) refreshSecurityPkiUsers(ctx context.Cont
 	}
 
 	if v, ok := o["references"]; ok {
-		m.References = parseFloat64Value(v)
+		m.References = parseInt64Value(v)
 	}
 
 	if v, ok := o["isGlobalEntry"]; ok {
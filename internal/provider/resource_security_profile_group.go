@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityProfileGroup{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityProfileGroup{}
+var _ resource.ResourceWithMoveState = &resourceSecurityProfileGroup{}
 
 func newResourceSecurityProfileGroup() resource.Resource {
 	return &resourceSecurityProfileGroup{}
@@ -42,6 +45,11 @@ type resourceSecurityProfileGroupModel struct {
 	IntrusionPreventionProfile *resourceSecurityProfileGroupIntrusionPreventionProfileModel `tfsdk:"intrusion_prevention_profile"`
 	SslSshProfile              *resourceSecurityProfileGroupSslSshProfileModel              `tfsdk:"ssl_ssh_profile"`
 	Direction                  types.String                                                 `tfsdk:"direction"`
+	DeletionProtection         types.Bool                                                   `tfsdk:"deletion_protection"`
+	AdoptExisting              types.Bool                                                   `tfsdk:"adopt_existing"`
+	ForceDetach                types.Bool                                                   `tfsdk:"force_detach"`
+	RawJson                    types.String                                                 `tfsdk:"raw_json"`
+	Timeouts                   timeouts.Value                                               `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityProfileGroup) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +59,7 @@ func (r *resourceSecurityProfileGroup) Metadata(ctx context.Context, req resourc
 func (r *resourceSecurityProfileGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": deletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -58,6 +67,9 @@ func (r *resourceSecurityProfileGroup) Schema(ctx context.Context, req resource.
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -343,6 +355,9 @@ func (r *resourceSecurityProfileGroup) Schema(ctx context.Context, req resource.
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -368,6 +383,11 @@ func (r *resourceSecurityProfileGroup) Configure(ctx context.Context, req resour
 	r.resourceName = "fortisase_security_profile_group"
 }
 
+func (r *resourceSecurityProfileGroup) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+	planDeletionProtection(ctx, r.fortiClient, req, resp, path.Root("deletion_protection"))
+}
+
 func (r *resourceSecurityProfileGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("profile-group")
 	lock.Lock()
@@ -377,12 +397,16 @@ func (r *resourceSecurityProfileGroup) Create(ctx context.Context, req resource.
 
 	// Read Terraform config data into the model
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityProfileGroup(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "create", diags))
 
@@ -390,6 +414,17 @@ func (r *resourceSecurityProfileGroup) Create(ctx context.Context, req resource.
 		return
 	}
 	output, err := c.CreateSecurityProfileGroup(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityProfileGroup(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityProfileGroup, c.UpdateSecurityProfileGroup, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -401,11 +436,12 @@ func (r *resourceSecurityProfileGroup) Create(ctx context.Context, req resource.
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var update_input_model forticlient.InputModel
+	update_input_model.Ctx = ctx
 	update_input_model.Mkey = mkey
 	update_input_model.BodyParams = *(data.getUpdateObjectSecurityProfileGroup(ctx, data, diags))
 	update_input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "update", diags))
 	if !diags.HasError() {
-		output, err := c.UpdateSecurityProfileGroup(&update_input_model)
+		output, err = c.UpdateSecurityProfileGroup(&update_input_model)
 		if err != nil {
 			diags.AddError(
 				fmt.Sprintf("Error to update resource after create %s: %v", r.resourceName, err),
@@ -415,10 +451,11 @@ func (r *resourceSecurityProfileGroup) Create(ctx context.Context, req resource.
 		}
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityProfileGroup(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityProfileGroup, &read_input_model, update_input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -450,17 +487,22 @@ func (r *resourceSecurityProfileGroup) Update(ctx context.Context, req resource.
 
 	var data resourceSecurityProfileGroupModel
 	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &data.DeletionProtection)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityProfileGroup(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -476,10 +518,11 @@ func (r *resourceSecurityProfileGroup) Update(ctx context.Context, req resource.
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityProfileGroup(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityProfileGroup, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -509,14 +552,25 @@ func (r *resourceSecurityProfileGroup) Delete(ctx context.Context, req resource.
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
+
+	if isDeletionProtected(data.DeletionProtection, r.resourceName, data.ID.ValueString(), diags) {
+		return
+	}
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/profile-groups", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityProfileGroup(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -537,11 +591,14 @@ func (r *resourceSecurityProfileGroup) Read(ctx context.Context, req resource.Re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "read", diags))
 
@@ -566,12 +623,20 @@ func (r *resourceSecurityProfileGroup) ImportState(ctx context.Context, req reso
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *resourceSecurityProfileGroup) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateFrom("fortisase_security_profile_group_clone", "direction"),
+	}
+}
+
 func (m *resourceSecurityProfileGroupModel) refreshSecurityProfileGroup(ctx context.Context, o map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if o == nil {
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["antivirusProfile"]; ok {
 		m.AntivirusProfile = m.AntivirusProfile.flattenSecurityProfileGroupAntivirusProfile(ctx, v, &diags)
 	}
@@ -28,11 +28,10 @@ type resourceSecurityProfileGroupClone2Edl struct {
 }
 
 // resourceSecurityProfileGroupClone2EdlModel describes the resource data model.
+// The attributes of the cloned profile group are those of fortisase_security_profile_group.
 type resourceSecurityProfileGroupClone2EdlModel struct {
-	ID         types.String `tfsdk:"id"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	Direction  types.String `tfsdk:"direction"`
-	BasedOn    types.String `tfsdk:"based_on"`
+	resourceSecurityProfileGroupModel
+	BasedOn types.String `tfsdk:"based_on"`
 }
 
 func (r *resourceSecurityProfileGroupClone2Edl) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,7 +40,7 @@ func (r *resourceSecurityProfileGroupClone2Edl) Metadata(ctx context.Context, re
 
 func (r *resourceSecurityProfileGroupClone2Edl) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
+		Attributes: cloneSchemaAttributes(ctx, newResourceSecurityProfileGroup(), map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Identifier, required by Terraform, not configurable.",
@@ -53,8 +52,13 @@ func (r *resourceSecurityProfileGroupClone2Edl) Schema(ctx context.Context, req
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
 				},
-				Computed: true,
-				Optional: true,
+				MarkdownDescription: "The primary key of the cloned profile group.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"direction": schema.StringAttribute{
 				Validators: []validator.String{
@@ -63,12 +67,23 @@ func (r *resourceSecurityProfileGroupClone2Edl) Schema(ctx context.Context, req
 				MarkdownDescription: "The direction of the target resource.\nSupported values: internal-profiles, outbound-profiles.",
 				Computed:            true,
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"based_on": schema.StringAttribute{
 				MarkdownDescription: "The profile group you what to clone.",
 				Computed:            true,
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
+		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -96,17 +111,23 @@ func (r *resourceSecurityProfileGroupClone2Edl) Configure(ctx context.Context, r
 }
 
 func (r *resourceSecurityProfileGroupClone2Edl) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	lock := r.fortiClient.GetResourceLock("profile-group")
+	lock.Lock()
+	defer lock.Unlock()
 	var data resourceSecurityProfileGroupClone2EdlModel
 	diags := &resp.Diagnostics
 
 	// Read Terraform config data into the model
-	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(getCloneData(ctx, req.Config, &data)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityProfileGroupClone(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityProfileGroupClone(ctx, "create", diags))
 
@@ -122,71 +143,128 @@ func (r *resourceSecurityProfileGroupClone2Edl) Create(ctx context.Context, req
 		return
 	}
 
-	mkey := "SecurityProfileGroupClone"
+	mkey := cloneCreatedMkey(output, data.PrimaryKey)
 	data.ID = types.StringValue(mkey)
+	data.PrimaryKey = types.StringValue(mkey)
+
+	diags.Append(r.read(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
 
-	diags.Append(resp.State.Set(ctx, &data)...)
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
 }
 
 func (r *resourceSecurityProfileGroupClone2Edl) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	diags := &resp.Diagnostics
 
-	// Read Terraform plan data into the model
-	var state resourceSecurityProfileGroupClone2EdlModel
-	diags.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform prior state data into the model, the cloned profile group is only read back
+	// as every configurable attribute requires a replacement.
+	var data resourceSecurityProfileGroupClone2EdlModel
+	diags.Append(getCloneData(ctx, req.State, &data)...)
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 
-	var data resourceSecurityProfileGroupClone2EdlModel
-	diags.Append(req.Config.Get(ctx, &data)...)
+	diags.Append(r.read(ctx, &data)...)
 	if diags.HasError() {
 		return
 	}
-	data.ID = state.ID
 
-	mkey := state.ID.ValueString()
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
+}
 
-	c := r.fortiClient.Client
-	var input_model forticlient.InputModel
-	input_model.Mkey = mkey
-	input_model.BodyParams = *(data.getUpdateObjectSecurityProfileGroupClone(ctx, state, diags))
-	input_model.URLParams = *(data.getURLObjectSecurityProfileGroupClone(ctx, "update", diags))
+func (r *resourceSecurityProfileGroupClone2Edl) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	lock := r.fortiClient.GetResourceLock("profile-group")
+	lock.Lock()
+	defer lock.Unlock()
+	diags := &resp.Diagnostics
+	var data resourceSecurityProfileGroupClone2EdlModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(getCloneData(ctx, req.State, &data)...)
 
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
-	output, err := c.CreateSecurityProfileGroupClone(&input_model)
+	mkey := cloneMkey(data.ID, data.PrimaryKey, "SecurityProfileGroupClone")
+	if mkey == "" {
+		return
+	}
+	data.PrimaryKey = types.StringValue(mkey)
+
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+	input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "delete", diags))
+
+	output, err := c.DeleteSecurityProfileGroup(&input_model)
 	if err != nil {
 		diags.AddError(
-			fmt.Sprintf("Error to update resource %s: %v", r.resourceName, err),
+			fmt.Sprintf("Error to delete resource %s: %v", r.resourceName, err),
 			getErrorDetail(&input_model, output),
 		)
 		return
 	}
-
-	diags.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *resourceSecurityProfileGroupClone2Edl) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No delete operation for this resource
 }
 
 func (r *resourceSecurityProfileGroupClone2Edl) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// No read operation for this resource
+	diags := &resp.Diagnostics
+	var data resourceSecurityProfileGroupClone2EdlModel
+
+	// Read Terraform prior state data into the model
+	diags.Append(getCloneData(ctx, req.State, &data)...)
+
+	if diags.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
+
+	diags.Append(r.read(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(setCloneState(ctx, &resp.State, &data)...)
 }
 
-func (data *resourceSecurityProfileGroupClone2EdlModel) getCreateObjectSecurityProfileGroupClone(ctx context.Context, diags *diag.Diagnostics) *map[string]interface{} {
-	result := make(map[string]interface{})
-	if !data.PrimaryKey.IsNull() {
-		result["primaryKey"] = data.PrimaryKey.ValueString()
+// read refreshes the model from the cloned profile group, which is read by its primary key.
+func (r *resourceSecurityProfileGroupClone2Edl) read(ctx context.Context, data *resourceSecurityProfileGroupClone2EdlModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mkey := cloneMkey(data.ID, data.PrimaryKey, "SecurityProfileGroupClone")
+	if mkey == "" {
+		return diags
 	}
+	data.ID = types.StringValue(mkey)
+	data.PrimaryKey = types.StringValue(mkey)
 
-	return &result
+	c := r.fortiClient.Client
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+	input_model.URLParams = *(data.getURLObjectSecurityProfileGroup(ctx, "read", &diags))
+
+	read_output, err := c.ReadSecurityProfileGroup(&input_model)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
+			getErrorDetail(&input_model, read_output),
+		)
+		return diags
+	}
+
+	diags.Append(data.refreshSecurityProfileGroup(ctx, read_output)...)
+	return diags
 }
 
-func (data *resourceSecurityProfileGroupClone2EdlModel) getUpdateObjectSecurityProfileGroupClone(ctx context.Context, state resourceSecurityProfileGroupClone2EdlModel, diags *diag.Diagnostics) *map[string]interface{} {
+func (data *resourceSecurityProfileGroupClone2EdlModel) getCreateObjectSecurityProfileGroupClone(ctx context.Context, diags *diag.Diagnostics) *map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.PrimaryKey.IsNull() {
 		result["primaryKey"] = data.PrimaryKey.ValueString()
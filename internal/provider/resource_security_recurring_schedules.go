@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,6 +21,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityRecurringSchedules{}
+var _ resource.ResourceWithConfigValidators = &resourceSecurityRecurringSchedules{}
 
 func newResourceSecurityRecurringSchedules() resource.Resource {
 	return &resourceSecurityRecurringSchedules{}
@@ -31,11 +34,15 @@ type resourceSecurityRecurringSchedules struct {
 
 // resourceSecurityRecurringSchedulesModel describes the resource data model.
 type resourceSecurityRecurringSchedulesModel struct {
-	ID         types.String `tfsdk:"id"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	Days       types.Set    `tfsdk:"days"`
-	StartTime  types.String `tfsdk:"start_time"`
-	EndTime    types.String `tfsdk:"end_time"`
+	ID            types.String   `tfsdk:"id"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	Days          types.Set      `tfsdk:"days"`
+	StartTime     types.String   `tfsdk:"start_time"`
+	EndTime       types.String   `tfsdk:"end_time"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool     `tfsdk:"force_detach"`
+	RawJson       types.String   `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityRecurringSchedules) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +59,9 @@ func (r *resourceSecurityRecurringSchedules) Schema(ctx context.Context, req res
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 31),
@@ -75,6 +85,18 @@ func (r *resourceSecurityRecurringSchedules) Schema(ctx context.Context, req res
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceSecurityRecurringSchedules) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("start_time"),
+			path.MatchRoot("end_time"),
+		),
 	}
 }
 
@@ -112,9 +134,12 @@ func (r *resourceSecurityRecurringSchedules) Create(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityRecurringSchedules(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityRecurringSchedules(ctx, "create", diags))
 
@@ -122,6 +147,17 @@ func (r *resourceSecurityRecurringSchedules) Create(ctx context.Context, req res
 		return
 	}
 	output, err := c.CreateSecurityRecurringSchedules(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityRecurringSchedules(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityRecurringSchedules(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityRecurringSchedules, c.UpdateSecurityRecurringSchedules, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -133,10 +169,11 @@ func (r *resourceSecurityRecurringSchedules) Create(ctx context.Context, req res
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityRecurringSchedules(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityRecurringSchedules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityRecurringSchedules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -171,14 +208,18 @@ func (r *resourceSecurityRecurringSchedules) Update(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityRecurringSchedules(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityRecurringSchedules(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -194,10 +235,11 @@ func (r *resourceSecurityRecurringSchedules) Update(ctx context.Context, req res
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityRecurringSchedules(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityRecurringSchedules(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityRecurringSchedules, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -227,14 +269,21 @@ func (r *resourceSecurityRecurringSchedules) Delete(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityRecurringSchedules(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/recurring-schedules", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityRecurringSchedules(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -255,11 +304,14 @@ func (r *resourceSecurityRecurringSchedules) Read(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityRecurringSchedules(ctx, "read", diags))
 
@@ -290,6 +342,8 @@ func (m *resourceSecurityRecurringSchedulesModel) refreshSecurityRecurringSchedu
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["days"]; ok {
 		m.Days = parseSetValue(ctx, v, types.StringType)
 	}
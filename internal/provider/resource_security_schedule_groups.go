@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityScheduleGroups{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityScheduleGroups{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityScheduleGroups{}
 
 func newResourceSecurityScheduleGroups() resource.Resource {
 	return &resourceSecurityScheduleGroups{}
@@ -30,9 +33,13 @@ type resourceSecurityScheduleGroups struct {
 
 // resourceSecurityScheduleGroupsModel describes the resource data model.
 type resourceSecurityScheduleGroupsModel struct {
-	ID         types.String                                 `tfsdk:"id"`
-	PrimaryKey types.String                                 `tfsdk:"primary_key"`
-	Members    []resourceSecurityScheduleGroupsMembersModel `tfsdk:"members"`
+	ID            types.String                                 `tfsdk:"id"`
+	PrimaryKey    types.String                                 `tfsdk:"primary_key"`
+	Members       []resourceSecurityScheduleGroupsMembersModel `tfsdk:"members"`
+	AdoptExisting types.Bool                                   `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool                                   `tfsdk:"force_detach"`
+	RawJson       types.String                                 `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value                               `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityScheduleGroups) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +48,7 @@ func (r *resourceSecurityScheduleGroups) Metadata(ctx context.Context, req resou
 
 func (r *resourceSecurityScheduleGroups) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -49,13 +57,16 @@ func (r *resourceSecurityScheduleGroups) Schema(ctx context.Context, req resourc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 31),
 				},
 				Required: true,
 			},
-			"members": schema.ListNestedAttribute{
+			"members": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -75,6 +86,9 @@ func (r *resourceSecurityScheduleGroups) Schema(ctx context.Context, req resourc
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -100,6 +114,17 @@ func (r *resourceSecurityScheduleGroups) Configure(ctx context.Context, req reso
 	r.resourceName = "fortisase_security_schedule_groups"
 }
 
+func (r *resourceSecurityScheduleGroups) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityScheduleGroups) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered list members to a set.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityScheduleGroups) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityScheduleGroups")
 	lock.Lock()
@@ -112,9 +137,12 @@ func (r *resourceSecurityScheduleGroups) Create(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityScheduleGroups(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityScheduleGroups(ctx, "create", diags))
 
@@ -122,6 +150,17 @@ func (r *resourceSecurityScheduleGroups) Create(ctx context.Context, req resourc
 		return
 	}
 	output, err := c.CreateSecurityScheduleGroups(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityScheduleGroups(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityScheduleGroups(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityScheduleGroups, c.UpdateSecurityScheduleGroups, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -133,10 +172,11 @@ func (r *resourceSecurityScheduleGroups) Create(ctx context.Context, req resourc
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityScheduleGroups(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityScheduleGroups(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityScheduleGroups, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -171,14 +211,18 @@ func (r *resourceSecurityScheduleGroups) Update(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityScheduleGroups(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityScheduleGroups(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -194,10 +238,11 @@ func (r *resourceSecurityScheduleGroups) Update(ctx context.Context, req resourc
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityScheduleGroups(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityScheduleGroups(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityScheduleGroups, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -227,14 +272,21 @@ func (r *resourceSecurityScheduleGroups) Delete(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityScheduleGroups(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/schedule-groups", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityScheduleGroups(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -255,11 +307,14 @@ func (r *resourceSecurityScheduleGroups) Read(ctx context.Context, req resource.
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityScheduleGroups(ctx, "read", diags))
 
@@ -290,6 +345,8 @@ func (m *resourceSecurityScheduleGroupsModel) refreshSecurityScheduleGroups(ctx
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["members"]; ok {
 		m.Members = m.flattenSecurityScheduleGroupsMembersList(ctx, v, &diags)
 	}
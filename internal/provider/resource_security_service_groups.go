@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityServiceGroups{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityServiceGroups{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityServiceGroups{}
 
 func newResourceSecurityServiceGroups() resource.Resource {
 	return &resourceSecurityServiceGroups{}
@@ -30,10 +33,14 @@ type resourceSecurityServiceGroups struct {
 
 // resourceSecurityServiceGroupsModel describes the resource data model.
 type resourceSecurityServiceGroupsModel struct {
-	ID         types.String                                `tfsdk:"id"`
-	PrimaryKey types.String                                `tfsdk:"primary_key"`
-	Proxy      types.Bool                                  `tfsdk:"proxy"`
-	Members    []resourceSecurityServiceGroupsMembersModel `tfsdk:"members"`
+	ID            types.String                                `tfsdk:"id"`
+	PrimaryKey    types.String                                `tfsdk:"primary_key"`
+	Proxy         types.Bool                                  `tfsdk:"proxy"`
+	Members       []resourceSecurityServiceGroupsMembersModel `tfsdk:"members"`
+	AdoptExisting types.Bool                                  `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool                                  `tfsdk:"force_detach"`
+	RawJson       types.String                                `tfsdk:"raw_json"`
+	Timeouts      timeouts.Value                              `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityServiceGroups) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,6 +49,7 @@ func (r *resourceSecurityServiceGroups) Metadata(ctx context.Context, req resour
 
 func (r *resourceSecurityServiceGroups) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -50,6 +58,9 @@ func (r *resourceSecurityServiceGroups) Schema(ctx context.Context, req resource
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(79),
@@ -60,7 +71,7 @@ func (r *resourceSecurityServiceGroups) Schema(ctx context.Context, req resource
 				Computed: true,
 				Optional: true,
 			},
-			"members": schema.ListNestedAttribute{
+			"members": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"primary_key": schema.StringAttribute{
@@ -80,6 +91,9 @@ func (r *resourceSecurityServiceGroups) Schema(ctx context.Context, req resource
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -105,6 +119,17 @@ func (r *resourceSecurityServiceGroups) Configure(ctx context.Context, req resou
 	r.resourceName = "fortisase_security_service_groups"
 }
 
+func (r *resourceSecurityServiceGroups) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityServiceGroups) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered list members to a set.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityServiceGroups) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityServiceGroups")
 	lock.Lock()
@@ -117,9 +142,12 @@ func (r *resourceSecurityServiceGroups) Create(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityServiceGroups(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityServiceGroups(ctx, "create", diags))
 
@@ -127,6 +155,17 @@ func (r *resourceSecurityServiceGroups) Create(ctx context.Context, req resource
 		return
 	}
 	output, err := c.CreateSecurityServiceGroups(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityServiceGroups(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityServiceGroups(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityServiceGroups, c.UpdateSecurityServiceGroups, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -138,10 +177,11 @@ func (r *resourceSecurityServiceGroups) Create(ctx context.Context, req resource
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityServiceGroups(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityServiceGroups(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityServiceGroups, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -176,14 +216,18 @@ func (r *resourceSecurityServiceGroups) Update(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityServiceGroups(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityServiceGroups(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -199,10 +243,11 @@ func (r *resourceSecurityServiceGroups) Update(ctx context.Context, req resource
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityServiceGroups(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityServiceGroups(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityServiceGroups, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -232,14 +277,21 @@ func (r *resourceSecurityServiceGroups) Delete(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityServiceGroups(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/service-groups", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityServiceGroups(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -260,11 +312,14 @@ func (r *resourceSecurityServiceGroups) Read(ctx context.Context, req resource.R
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityServiceGroups(ctx, "read", diags))
 
@@ -295,6 +350,8 @@ func (m *resourceSecurityServiceGroupsModel) refreshSecurityServiceGroups(ctx co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["proxy"]; ok {
 		m.Proxy = parseBoolValue(v)
 	}
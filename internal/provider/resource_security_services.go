@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityServices{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityServices{}
 
 func newResourceSecurityServices() resource.Resource {
 	return &resourceSecurityServices{}
@@ -36,11 +38,15 @@ type resourceSecurityServicesModel struct {
 	Proxy          types.Bool                                   `tfsdk:"proxy"`
 	Category       types.String                                 `tfsdk:"category"`
 	Protocol       types.String                                 `tfsdk:"protocol"`
-	ProtocolNumber types.Float64                                `tfsdk:"protocol_number"`
-	IcmpType       types.Float64                                `tfsdk:"icmp_type"`
+	ProtocolNumber types.Int64                                  `tfsdk:"protocol_number"`
+	IcmpType       types.Int64                                  `tfsdk:"icmp_type"`
 	UdpPortrange   []resourceSecurityServicesUdpPortrangeModel  `tfsdk:"udp_portrange"`
 	SctpPortrange  []resourceSecurityServicesSctpPortrangeModel `tfsdk:"sctp_portrange"`
 	TcpPortrange   []resourceSecurityServicesTcpPortrangeModel  `tfsdk:"tcp_portrange"`
+	AdoptExisting  types.Bool                                   `tfsdk:"adopt_existing"`
+	ForceDetach    types.Bool                                   `tfsdk:"force_detach"`
+	RawJson        types.String                                 `tfsdk:"raw_json"`
+	Timeouts       timeouts.Value                               `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityServices) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,6 +55,7 @@ func (r *resourceSecurityServices) Metadata(ctx context.Context, req resource.Me
 
 func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -57,6 +64,9 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": adoptExistingAttribute(),
+			"force_detach":   forceDetachAttribute(),
+			"raw_json":       rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 79),
@@ -81,16 +91,16 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 				Computed: true,
 				Optional: true,
 			},
-			"protocol_number": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(254),
+			"protocol_number": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 254),
 				},
 				Computed: true,
 				Optional: true,
 			},
-			"icmp_type": schema.Float64Attribute{
-				Validators: []validator.Float64{
-					float64validator.AtMost(4294967295),
+			"icmp_type": schema.Int64Attribute{
+				Validators: []validator.Int64{
+					int64validator.Between(0, 4294967295),
 				},
 				Computed: true,
 				Optional: true,
@@ -101,16 +111,16 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 
 						"destination": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -121,16 +131,16 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 						},
 						"source": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -150,16 +160,16 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 
 						"destination": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -170,16 +180,16 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 						},
 						"source": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -199,16 +209,16 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 
 						"destination": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -219,16 +229,16 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 						},
 						"source": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
-								"low": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"low": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
 								},
-								"high": schema.Float64Attribute{
-									Validators: []validator.Float64{
-										float64validator.AtMost(65535),
+								"high": schema.Int64Attribute{
+									Validators: []validator.Int64{
+										int64validator.Between(0, 65535),
 									},
 									Computed: true,
 									Optional: true,
@@ -243,6 +253,9 @@ func (r *resourceSecurityServices) Schema(ctx context.Context, req resource.Sche
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -268,6 +281,13 @@ func (r *resourceSecurityServices) Configure(ctx context.Context, req resource.C
 	r.resourceName = "fortisase_security_services"
 }
 
+func (r *resourceSecurityServices) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecurityServices) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityServices")
 	lock.Lock()
@@ -280,9 +300,12 @@ func (r *resourceSecurityServices) Create(ctx context.Context, req resource.Crea
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectSecurityServices(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityServices(ctx, "create", diags))
 
@@ -290,6 +313,17 @@ func (r *resourceSecurityServices) Create(ctx context.Context, req resource.Crea
 		return
 	}
 	output, err := c.CreateSecurityServices(&input_model)
+	if err != nil && isAdoptExisting(r.fortiClient, data.AdoptExisting, output) {
+		// The object may already exist, take it over by updating it to the config
+		var adopt_input_model forticlient.InputModel
+		adopt_input_model.Ctx = ctx
+		adopt_input_model.Mkey = data.PrimaryKey.ValueString()
+		adopt_input_model.BodyParams = *(data.getUpdateObjectSecurityServices(ctx, data, diags))
+		adopt_input_model.URLParams = *(data.getURLObjectSecurityServices(ctx, "update", diags))
+		if adopt_output, adopted, adopt_err := adoptExistingObject(&adopt_input_model, c.ReadSecurityServices, c.UpdateSecurityServices, r.resourceName, diags); adopted {
+			input_model, output, err = adopt_input_model, adopt_output, adopt_err
+		}
+	}
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to create resource %s: %v", r.resourceName, err),
@@ -301,10 +335,11 @@ func (r *resourceSecurityServices) Create(ctx context.Context, req resource.Crea
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityServices(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityServices(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityServices, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -339,14 +374,18 @@ func (r *resourceSecurityServices) Update(ctx context.Context, req resource.Upda
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityServices(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityServices(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -362,10 +401,11 @@ func (r *resourceSecurityServices) Update(ctx context.Context, req resource.Upda
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityServices(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityServices(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityServices, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -395,14 +435,21 @@ func (r *resourceSecurityServices) Delete(ctx context.Context, req resource.Dele
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityServices(ctx, "delete", diags))
 
+	if !checkUsage(ctx, c, "security/services", mkey, data.ForceDetach, r.resourceName, diags) {
+		return
+	}
+
 	output, err := c.DeleteSecurityServices(&input_model)
 	if err != nil {
 		diags.AddError(
@@ -423,11 +470,14 @@ func (r *resourceSecurityServices) Read(ctx context.Context, req resource.ReadRe
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityServices(ctx, "read", diags))
 
@@ -458,6 +508,8 @@ func (m *resourceSecurityServicesModel) refreshSecurityServices(ctx context.Cont
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["proxy"]; ok {
 		m.Proxy = parseBoolValue(v)
 	}
@@ -471,11 +523,11 @@ func (m *resourceSecurityServicesModel) refreshSecurityServices(ctx context.Cont
 	}
 
 	if v, ok := o["protocolNumber"]; ok {
-		m.ProtocolNumber = parseFloat64Value(v)
+		m.ProtocolNumber = parseInt64Value(v)
 	}
 
 	if v, ok := o["icmpType"]; ok {
-		m.IcmpType = parseFloat64Value(v)
+		m.IcmpType = parseInt64Value(v)
 	}
 
 	if v, ok := o["udpPortrange"]; ok {
@@ -512,11 +564,11 @@ func (data *resourceSecurityServicesModel) getCreateObjectSecurityServices(ctx c
 	}
 
 	if !data.ProtocolNumber.IsNull() {
-		result["protocolNumber"] = data.ProtocolNumber.ValueFloat64()
+		result["protocolNumber"] = data.ProtocolNumber.ValueInt64()
 	}
 
 	if !data.IcmpType.IsNull() {
-		result["icmpType"] = data.IcmpType.ValueFloat64()
+		result["icmpType"] = data.IcmpType.ValueInt64()
 	}
 
 	if data.UdpPortrange != nil {
@@ -553,11 +605,11 @@ func (data *resourceSecurityServicesModel) getUpdateObjectSecurityServices(ctx c
 	}
 
 	if !data.ProtocolNumber.IsNull() {
-		result["protocolNumber"] = data.ProtocolNumber.ValueFloat64()
+		result["protocolNumber"] = data.ProtocolNumber.ValueInt64()
 	}
 
 	if !data.IcmpType.IsNull() {
-		result["icmpType"] = data.IcmpType.ValueFloat64()
+		result["icmpType"] = data.IcmpType.ValueInt64()
 	}
 
 	if data.UdpPortrange != nil {
@@ -590,13 +642,13 @@ type resourceSecurityServicesUdpPortrangeModel struct {
 }
 
 type resourceSecurityServicesUdpPortrangeDestinationModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type resourceSecurityServicesUdpPortrangeSourceModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type resourceSecurityServicesSctpPortrangeModel struct {
@@ -605,13 +657,13 @@ type resourceSecurityServicesSctpPortrangeModel struct {
 }
 
 type resourceSecurityServicesSctpPortrangeDestinationModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type resourceSecurityServicesSctpPortrangeSourceModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type resourceSecurityServicesTcpPortrangeModel struct {
@@ -620,13 +672,13 @@ type resourceSecurityServicesTcpPortrangeModel struct {
 }
 
 type resourceSecurityServicesTcpPortrangeDestinationModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 type resourceSecurityServicesTcpPortrangeSourceModel struct {
-	Low  types.Float64 `tfsdk:"low"`
-	High types.Float64 `tfsdk:"high"`
+	Low  types.Int64 `tfsdk:"low"`
+	High types.Int64 `tfsdk:"high"`
 }
 
 func (m *resourceSecurityServicesUdpPortrangeModel) flattenSecurityServicesUdpPortrange(ctx context.Context, input interface{}, diags *diag.Diagnostics) *resourceSecurityServicesUdpPortrangeModel {
@@ -681,11 +733,11 @@ func (m *resourceSecurityServicesUdpPortrangeDestinationModel) flattenSecuritySe
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -700,11 +752,11 @@ func (m *resourceSecurityServicesUdpPortrangeSourceModel) flattenSecurityService
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -762,11 +814,11 @@ func (m *resourceSecurityServicesSctpPortrangeDestinationModel) flattenSecurityS
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -781,11 +833,11 @@ func (m *resourceSecurityServicesSctpPortrangeSourceModel) flattenSecurityServic
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -843,11 +895,11 @@ func (m *resourceSecurityServicesTcpPortrangeDestinationModel) flattenSecuritySe
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -862,11 +914,11 @@ func (m *resourceSecurityServicesTcpPortrangeSourceModel) flattenSecurityService
 	}
 	o := input.(map[string]interface{})
 	if v, ok := o["low"]; ok {
-		m.Low = parseFloat64Value(v)
+		m.Low = parseInt64Value(v)
 	}
 
 	if v, ok := o["high"]; ok {
-		m.High = parseFloat64Value(v)
+		m.High = parseInt64Value(v)
 	}
 
 	return m
@@ -896,11 +948,11 @@ func (s *resourceSecurityServicesModel) expandSecurityServicesUdpPortrangeList(c
 func (data *resourceSecurityServicesUdpPortrangeDestinationModel) expandSecurityServicesUdpPortrangeDestination(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Low.IsNull() {
-		result["low"] = data.Low.ValueFloat64()
+		result["low"] = data.Low.ValueInt64()
 	}
 
 	if !data.High.IsNull() {
-		result["high"] = data.High.ValueFloat64()
+		result["high"] = data.High.ValueInt64()
 	}
 
 	return result
@@ -909,11 +961,11 @@ func (data *resourceSecurityServicesUdpPortrangeDestinationModel) expandSecurity
 func (data *resourceSecurityServicesUdpPortrangeSourceModel) expandSecurityServicesUdpPortrangeSource(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Low.IsNull() {
-		result["low"] = data.Low.ValueFloat64()
+		result["low"] = data.Low.ValueInt64()
 	}
 
 	if !data.High.IsNull() {
-		result["high"] = data.High.ValueFloat64()
+		result["high"] = data.High.ValueInt64()
 	}
 
 	return result
@@ -943,11 +995,11 @@ func (s *resourceSecurityServicesModel) expandSecurityServicesSctpPortrangeList(
 func (data *resourceSecurityServicesSctpPortrangeDestinationModel) expandSecurityServicesSctpPortrangeDestination(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Low.IsNull() {
-		result["low"] = data.Low.ValueFloat64()
+		result["low"] = data.Low.ValueInt64()
 	}
 
 	if !data.High.IsNull() {
-		result["high"] = data.High.ValueFloat64()
+		result["high"] = data.High.ValueInt64()
 	}
 
 	return result
@@ -956,11 +1008,11 @@ func (data *resourceSecurityServicesSctpPortrangeDestinationModel) expandSecurit
 func (data *resourceSecurityServicesSctpPortrangeSourceModel) expandSecurityServicesSctpPortrangeSource(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Low.IsNull() {
-		result["low"] = data.Low.ValueFloat64()
+		result["low"] = data.Low.ValueInt64()
 	}
 
 	if !data.High.IsNull() {
-		result["high"] = data.High.ValueFloat64()
+		result["high"] = data.High.ValueInt64()
 	}
 
 	return result
@@ -990,11 +1042,11 @@ func (s *resourceSecurityServicesModel) expandSecurityServicesTcpPortrangeList(c
 func (data *resourceSecurityServicesTcpPortrangeDestinationModel) expandSecurityServicesTcpPortrangeDestination(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Low.IsNull() {
-		result["low"] = data.Low.ValueFloat64()
+		result["low"] = data.Low.ValueInt64()
 	}
 
 	if !data.High.IsNull() {
-		result["high"] = data.High.ValueFloat64()
+		result["high"] = data.High.ValueInt64()
 	}
 
 	return result
@@ -1003,11 +1055,11 @@ func (data *resourceSecurityServicesTcpPortrangeDestinationModel) expandSecurity
 func (data *resourceSecurityServicesTcpPortrangeSourceModel) expandSecurityServicesTcpPortrangeSource(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
 	result := make(map[string]interface{})
 	if !data.Low.IsNull() {
-		result["low"] = data.Low.ValueFloat64()
+		result["low"] = data.Low.ValueInt64()
 	}
 
 	if !data.High.IsNull() {
-		result["high"] = data.High.ValueFloat64()
+		result["high"] = data.High.ValueInt64()
 	}
 
 	return result
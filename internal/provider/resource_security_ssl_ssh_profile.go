@@ -5,7 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
-	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecuritySslSshProfile{}
+var _ resource.ResourceWithModifyPlan = &resourceSecuritySslSshProfile{}
+var _ resource.ResourceWithUpgradeState = &resourceSecuritySslSshProfile{}
 
 func newResourceSecuritySslSshProfile() resource.Resource {
 	return &resourceSecuritySslSshProfile{}
@@ -45,6 +48,8 @@ type resourceSecuritySslSshProfileModel struct {
 	HostExemptions                      []resourceSecuritySslSshProfileHostExemptionsModel        `tfsdk:"host_exemptions"`
 	UrlCategoryExemptions               []resourceSecuritySslSshProfileUrlCategoryExemptionsModel `tfsdk:"url_category_exemptions"`
 	Direction                           types.String                                              `tfsdk:"direction"`
+	RawJson                             types.String                                              `tfsdk:"raw_json"`
+	Timeouts                            timeouts.Value                                            `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecuritySslSshProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +58,7 @@ func (r *resourceSecuritySslSshProfile) Metadata(ctx context.Context, req resour
 
 func (r *resourceSecuritySslSshProfile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -61,6 +67,7 @@ func (r *resourceSecuritySslSshProfile) Schema(ctx context.Context, req resource
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -137,16 +144,16 @@ func (r *resourceSecuritySslSshProfile) Schema(ctx context.Context, req resource
 						Computed: true,
 						Optional: true,
 					},
-					"compressed_limit": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(10, 64),
+					"compressed_limit": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(10, 64),
 						},
 						Computed: true,
 						Optional: true,
 					},
-					"uncompressed_limit": schema.Float64Attribute{
-						Validators: []validator.Float64{
-							float64validator.Between(10, 64),
+					"uncompressed_limit": schema.Int64Attribute{
+						Validators: []validator.Int64{
+							int64validator.Between(10, 64),
 						},
 						Computed: true,
 						Optional: true,
@@ -211,6 +218,9 @@ func (r *resourceSecuritySslSshProfile) Schema(ctx context.Context, req resource
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -236,6 +246,17 @@ func (r *resourceSecuritySslSshProfile) Configure(ctx context.Context, req resou
 	r.resourceName = "fortisase_security_ssl_ssh_profile"
 }
 
+func (r *resourceSecuritySslSshProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecuritySslSshProfile) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+	)
+}
+
 func (r *resourceSecuritySslSshProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecuritySslSshProfile")
 	lock.Lock()
@@ -248,9 +269,12 @@ func (r *resourceSecuritySslSshProfile) Create(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecuritySslSshProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecuritySslSshProfile(ctx, "create", diags))
@@ -270,10 +294,11 @@ func (r *resourceSecuritySslSshProfile) Create(ctx context.Context, req resource
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecuritySslSshProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecuritySslSshProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecuritySslSshProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -308,14 +333,18 @@ func (r *resourceSecuritySslSshProfile) Update(ctx context.Context, req resource
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecuritySslSshProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecuritySslSshProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -331,10 +360,11 @@ func (r *resourceSecuritySslSshProfile) Update(ctx context.Context, req resource
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecuritySslSshProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecuritySslSshProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecuritySslSshProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -365,11 +395,14 @@ func (r *resourceSecuritySslSshProfile) Read(ctx context.Context, req resource.R
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecuritySslSshProfile(ctx, "read", diags))
 
@@ -400,6 +433,8 @@ func (m *resourceSecuritySslSshProfileModel) refreshSecuritySslSshProfile(ctx co
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["inspectionMode"]; ok {
 		m.InspectionMode = parseStringValue(v)
 	}
@@ -570,10 +605,10 @@ func (data *resourceSecuritySslSshProfileModel) getURLObjectSecuritySslSshProfil
 }
 
 type resourceSecuritySslSshProfileProfileProtocolOptionsModel struct {
-	UnknownContentEncoding types.String  `tfsdk:"unknown_content_encoding"`
-	OversizedAction        types.String  `tfsdk:"oversized_action"`
-	CompressedLimit        types.Float64 `tfsdk:"compressed_limit"`
-	UncompressedLimit      types.Float64 `tfsdk:"uncompressed_limit"`
+	UnknownContentEncoding types.String `tfsdk:"unknown_content_encoding"`
+	OversizedAction        types.String `tfsdk:"oversized_action"`
+	CompressedLimit        types.Int64  `tfsdk:"compressed_limit"`
+	UncompressedLimit      types.Int64  `tfsdk:"uncompressed_limit"`
 }
 
 type resourceSecuritySslSshProfileCaCertificateModel struct {
@@ -608,11 +643,11 @@ func (m *resourceSecuritySslSshProfileProfileProtocolOptionsModel) flattenSecuri
 	}
 
 	if v, ok := o["compressedLimit"]; ok {
-		m.CompressedLimit = parseFloat64Value(v)
+		m.CompressedLimit = parseInt64Value(v)
 	}
 
 	if v, ok := o["uncompressedLimit"]; ok {
-		m.UncompressedLimit = parseFloat64Value(v)
+		m.UncompressedLimit = parseInt64Value(v)
 	}
 
 	return m
@@ -734,11 +769,11 @@ func (data *resourceSecuritySslSshProfileProfileProtocolOptionsModel) expandSecu
 	}
 
 	if !data.CompressedLimit.IsNull() {
-		result["compressedLimit"] = data.CompressedLimit.ValueFloat64()
+		result["compressedLimit"] = data.CompressedLimit.ValueInt64()
 	}
 
 	if !data.UncompressedLimit.IsNull() {
-		result["uncompressedLimit"] = data.UncompressedLimit.ValueFloat64()
+		result["uncompressedLimit"] = data.UncompressedLimit.ValueInt64()
 	}
 
 	return result
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +19,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityVideoFilterProfile{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityVideoFilterProfile{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityVideoFilterProfile{}
 
 func newResourceSecurityVideoFilterProfile() resource.Resource {
 	return &resourceSecurityVideoFilterProfile{}
@@ -36,6 +39,8 @@ type resourceSecurityVideoFilterProfileModel struct {
 	DefaultAction     types.String                                               `tfsdk:"default_action"`
 	Channels          []resourceSecurityVideoFilterProfileChannelsModel          `tfsdk:"channels"`
 	Direction         types.String                                               `tfsdk:"direction"`
+	RawJson           types.String                                               `tfsdk:"raw_json"`
+	Timeouts          timeouts.Value                                             `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityVideoFilterProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,6 +49,7 @@ func (r *resourceSecurityVideoFilterProfile) Metadata(ctx context.Context, req r
 
 func (r *resourceSecurityVideoFilterProfile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -52,6 +58,7 @@ func (r *resourceSecurityVideoFilterProfile) Schema(ctx context.Context, req res
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -67,7 +74,7 @@ func (r *resourceSecurityVideoFilterProfile) Schema(ctx context.Context, req res
 				Computed:            true,
 				Optional:            true,
 			},
-			"fortiguard_filters": schema.ListNestedAttribute{
+			"fortiguard_filters": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -129,6 +136,9 @@ func (r *resourceSecurityVideoFilterProfile) Schema(ctx context.Context, req res
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -154,6 +164,17 @@ func (r *resourceSecurityVideoFilterProfile) Configure(ctx context.Context, req
 	r.resourceName = "fortisase_security_video_filter_profile"
 }
 
+func (r *resourceSecurityVideoFilterProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityVideoFilterProfile) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the unordered list fortiguard_filters to a set.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityVideoFilterProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityVideoFilterProfile")
 	lock.Lock()
@@ -166,9 +187,12 @@ func (r *resourceSecurityVideoFilterProfile) Create(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityVideoFilterProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityVideoFilterProfile(ctx, "create", diags))
@@ -188,10 +212,11 @@ func (r *resourceSecurityVideoFilterProfile) Create(ctx context.Context, req res
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityVideoFilterProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityVideoFilterProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityVideoFilterProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -226,14 +251,18 @@ func (r *resourceSecurityVideoFilterProfile) Update(ctx context.Context, req res
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityVideoFilterProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityVideoFilterProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -249,10 +278,11 @@ func (r *resourceSecurityVideoFilterProfile) Update(ctx context.Context, req res
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityVideoFilterProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityVideoFilterProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityVideoFilterProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -283,11 +313,14 @@ func (r *resourceSecurityVideoFilterProfile) Read(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityVideoFilterProfile(ctx, "read", diags))
 
@@ -318,6 +351,8 @@ func (m *resourceSecurityVideoFilterProfileModel) refreshSecurityVideoFilterProf
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["fortiguardFilters"]; ok {
 		convert_v := m.flattenSecurityVideoFilterProfileFortiguardFiltersList(ctx, v, &diags)
 		if m.FortiguardFilters == nil || !isSetSuperset(convert_v, m.FortiguardFilters) {
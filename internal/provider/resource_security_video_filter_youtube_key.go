@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,9 +32,11 @@ type resourceSecurityVideoFilterYoutubeKey struct {
 
 // resourceSecurityVideoFilterYoutubeKeyModel describes the resource data model.
 type resourceSecurityVideoFilterYoutubeKeyModel struct {
-	ID         types.String `tfsdk:"id"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	ApiKey     types.String `tfsdk:"api_key"`
+	ID         types.String   `tfsdk:"id"`
+	PrimaryKey types.String   `tfsdk:"primary_key"`
+	ApiKey     types.String   `tfsdk:"api_key"`
+	RawJson    types.String   `tfsdk:"raw_json"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityVideoFilterYoutubeKey) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +53,7 @@ func (r *resourceSecurityVideoFilterYoutubeKey) Schema(ctx context.Context, req
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Validators: []validator.String{
 					stringvalidator.OneOf("$sase-global"),
@@ -62,10 +66,14 @@ func (r *resourceSecurityVideoFilterYoutubeKey) Schema(ctx context.Context, req
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 47),
 				},
-				Computed: true,
-				Optional: true,
+				Sensitive: true,
+				Computed:  true,
+				Optional:  true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -103,9 +111,12 @@ func (r *resourceSecurityVideoFilterYoutubeKey) Create(ctx context.Context, req
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityVideoFilterYoutubeKey(ctx, diags))
 
@@ -124,9 +135,10 @@ func (r *resourceSecurityVideoFilterYoutubeKey) Create(ctx context.Context, req
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
-	read_output, err := c.ReadSecurityVideoFilterYoutubeKey(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityVideoFilterYoutubeKey, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -161,14 +173,18 @@ func (r *resourceSecurityVideoFilterYoutubeKey) Update(ctx context.Context, req
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityVideoFilterYoutubeKey(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 
 	if diags.HasError() {
 		return
@@ -183,9 +199,10 @@ func (r *resourceSecurityVideoFilterYoutubeKey) Update(ctx context.Context, req
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 
-	read_output, err := c.ReadSecurityVideoFilterYoutubeKey(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityVideoFilterYoutubeKey, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -216,11 +233,14 @@ func (r *resourceSecurityVideoFilterYoutubeKey) Read(ctx context.Context, req re
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 
 	read_output, err := c.ReadSecurityVideoFilterYoutubeKey(&input_model)
@@ -250,6 +270,8 @@ func (m *resourceSecurityVideoFilterYoutubeKeyModel) refreshSecurityVideoFilterY
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["primaryKey"]; ok {
 		m.PrimaryKey = parseStringValue(v)
 	}
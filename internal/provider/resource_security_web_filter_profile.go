@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +21,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceSecurityWebFilterProfile{}
+var _ resource.ResourceWithModifyPlan = &resourceSecurityWebFilterProfile{}
+var _ resource.ResourceWithUpgradeState = &resourceSecurityWebFilterProfile{}
 
 func newResourceSecurityWebFilterProfile() resource.Resource {
 	return &resourceSecurityWebFilterProfile{}
@@ -45,6 +49,8 @@ type resourceSecurityWebFilterProfileModel struct {
 	UrlFilters                     []resourceSecurityWebFilterProfileUrlFiltersModel                     `tfsdk:"url_filters"`
 	HttpHeaders                    []resourceSecurityWebFilterProfileHttpHeadersModel                    `tfsdk:"http_headers"`
 	Direction                      types.String                                                          `tfsdk:"direction"`
+	RawJson                        types.String                                                          `tfsdk:"raw_json"`
+	Timeouts                       timeouts.Value                                                        `tfsdk:"timeouts"`
 }
 
 func (r *resourceSecurityWebFilterProfile) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +59,7 @@ func (r *resourceSecurityWebFilterProfile) Metadata(ctx context.Context, req res
 
 func (r *resourceSecurityWebFilterProfile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 2,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -61,6 +68,7 @@ func (r *resourceSecurityWebFilterProfile) Schema(ctx context.Context, req resou
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"raw_json": rawJSONAttribute(),
 			"primary_key": schema.StringAttribute{
 				Required: true,
 			},
@@ -108,7 +116,7 @@ func (r *resourceSecurityWebFilterProfile) Schema(ctx context.Context, req resou
 				Computed:            true,
 				Optional:            true,
 			},
-			"fortiguard_filters": schema.ListNestedAttribute{
+			"fortiguard_filters": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -144,7 +152,7 @@ func (r *resourceSecurityWebFilterProfile) Schema(ctx context.Context, req resou
 				Computed: true,
 				Optional: true,
 			},
-			"fortiguard_local_category_filters": schema.ListNestedAttribute{
+			"fortiguard_local_category_filters": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -180,7 +188,7 @@ func (r *resourceSecurityWebFilterProfile) Schema(ctx context.Context, req resou
 				Computed: true,
 				Optional: true,
 			},
-			"fqdn_threat_feed_filters": schema.ListNestedAttribute{
+			"fqdn_threat_feed_filters": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -251,7 +259,10 @@ func (r *resourceSecurityWebFilterProfile) Schema(ctx context.Context, req resou
 							Computed: true,
 							Optional: true,
 						},
-						"score": schema.Float64Attribute{
+						"score": schema.Int64Attribute{
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 							Computed: true,
 							Optional: true,
 						},
@@ -336,6 +347,9 @@ func (r *resourceSecurityWebFilterProfile) Schema(ctx context.Context, req resou
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -361,6 +375,19 @@ func (r *resourceSecurityWebFilterProfile) Configure(ctx context.Context, req re
 	r.resourceName = "fortisase_security_web_filter_profile"
 }
 
+func (r *resourceSecurityWebFilterProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlanReferences(ctx, r.fortiClient, req.Plan, &resp.Diagnostics)
+}
+
+func (r *resourceSecurityWebFilterProfile) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		// 1.2.0 changed the integer attributes from Float64 to Int64.
+		integerStateNumbers(),
+		// 1.2.0 changed the unordered lists fortiguard_filters, fortiguard_local_category_filters and fqdn_threat_feed_filters to sets.
+		keepState(),
+	)
+}
+
 func (r *resourceSecurityWebFilterProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	lock := r.fortiClient.GetResourceLock("SecurityWebFilterProfile")
 	lock.Lock()
@@ -373,9 +400,12 @@ func (r *resourceSecurityWebFilterProfile) Create(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = data.PrimaryKey.ValueString()
 	input_model.BodyParams = *(data.getCreateObjectSecurityWebFilterProfile(ctx, diags))
 	input_model.URLParams = *(data.getURLObjectSecurityWebFilterProfile(ctx, "create", diags))
@@ -395,10 +425,11 @@ func (r *resourceSecurityWebFilterProfile) Create(ctx context.Context, req resou
 	mkey := fmt.Sprintf("%v", output["primaryKey"])
 	data.ID = types.StringValue(mkey)
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityWebFilterProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityWebFilterProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityWebFilterProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -433,14 +464,18 @@ func (r *resourceSecurityWebFilterProfile) Update(ctx context.Context, req resou
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectSecurityWebFilterProfile(ctx, state, diags))
+	input_model.BodyParams = withUnmanagedFields(input_model.BodyParams, state.RawJson, state)
 	input_model.URLParams = *(data.getURLObjectSecurityWebFilterProfile(ctx, "update", diags))
 
 	if diags.HasError() {
@@ -456,10 +491,11 @@ func (r *resourceSecurityWebFilterProfile) Update(ctx context.Context, req resou
 		return
 	}
 	var read_input_model forticlient.InputModel
+	read_input_model.Ctx = ctx
 	read_input_model.Mkey = mkey
 	read_input_model.URLParams = *(data.getURLObjectSecurityWebFilterProfile(ctx, "read", diags))
 
-	read_output, err := c.ReadSecurityWebFilterProfile(&read_input_model)
+	read_output, err := readAfterWrite(ctx, c.ReadSecurityWebFilterProfile, &read_input_model, input_model.BodyParams, output, diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("Error to read resource %s: %v", r.resourceName, err),
@@ -490,11 +526,14 @@ func (r *resourceSecurityWebFilterProfile) Read(ctx context.Context, req resourc
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, diags)
+	defer cancel()
 
 	mkey := data.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.URLParams = *(data.getURLObjectSecurityWebFilterProfile(ctx, "read", diags))
 
@@ -525,6 +564,8 @@ func (m *resourceSecurityWebFilterProfileModel) refreshSecurityWebFilterProfile(
 		return diags
 	}
 
+	m.RawJson = rawJSONValue(o)
+
 	if v, ok := o["fortiguardFilters"]; ok {
 		convert_v := m.flattenSecurityWebFilterProfileFortiguardFiltersList(ctx, v, &diags)
 		if m.FortiguardFilters == nil || !isSetSuperset(convert_v, m.FortiguardFilters) {
@@ -728,12 +769,12 @@ type resourceSecurityWebFilterProfileFqdnThreatFeedFiltersCategoryModel struct {
 }
 
 type resourceSecurityWebFilterProfileContentFiltersModel struct {
-	Status      types.String  `tfsdk:"status"`
-	Pattern     types.String  `tfsdk:"pattern"`
-	PatternType types.String  `tfsdk:"pattern_type"`
-	Lang        types.String  `tfsdk:"lang"`
-	Action      types.String  `tfsdk:"action"`
-	Score       types.Float64 `tfsdk:"score"`
+	Status      types.String `tfsdk:"status"`
+	Pattern     types.String `tfsdk:"pattern"`
+	PatternType types.String `tfsdk:"pattern_type"`
+	Lang        types.String `tfsdk:"lang"`
+	Action      types.String `tfsdk:"action"`
+	Score       types.Int64  `tfsdk:"score"`
 }
 
 type resourceSecurityWebFilterProfileUrlFiltersModel struct {
@@ -982,7 +1023,7 @@ func (m *resourceSecurityWebFilterProfileContentFiltersModel) flattenSecurityWeb
 	}
 
 	if v, ok := o["score"]; ok {
-		m.Score = parseFloat64Value(v)
+		m.Score = parseInt64Value(v)
 	}
 
 	return m
@@ -1294,7 +1335,7 @@ func (data *resourceSecurityWebFilterProfileContentFiltersModel) expandSecurityW
 	}
 
 	if !data.Score.IsNull() {
-		result["score"] = data.Score.ValueFloat64()
+		result["score"] = data.Score.ValueInt64()
 	}
 
 	return result
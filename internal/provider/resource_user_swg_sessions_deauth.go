@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,9 +28,10 @@ type resourceUserSwgSessionsDeauth2Edl struct {
 
 // resourceUserSwgSessionsDeauth2EdlModel describes the resource data model.
 type resourceUserSwgSessionsDeauth2EdlModel struct {
-	ID         types.String `tfsdk:"id"`
-	Usernames  types.Set    `tfsdk:"usernames"`
-	SessionIds types.Set    `tfsdk:"session_ids"`
+	ID         types.String   `tfsdk:"id"`
+	Usernames  types.Set      `tfsdk:"usernames"`
+	SessionIds types.Set      `tfsdk:"session_ids"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceUserSwgSessionsDeauth2Edl) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -38,6 +40,7 @@ func (r *resourceUserSwgSessionsDeauth2Edl) Metadata(ctx context.Context, req re
 
 func (r *resourceUserSwgSessionsDeauth2Edl) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		DeprecationMessage: "Use action fortisase_user_swg_sessions_deauth instead. The resource only runs the operation when it is created or its arguments change.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -57,6 +60,9 @@ func (r *resourceUserSwgSessionsDeauth2Edl) Schema(ctx context.Context, req reso
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeoutsWithoutRead, timeoutsWithoutDelete),
+		},
 	}
 }
 
@@ -91,9 +97,12 @@ func (r *resourceUserSwgSessionsDeauth2Edl) Create(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, diags)
+	defer cancel()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.BodyParams = *(data.getCreateObjectUserSwgSessionsDeauth(ctx, diags))
 
 	if diags.HasError() {
@@ -129,12 +138,15 @@ func (r *resourceUserSwgSessionsDeauth2Edl) Update(ctx context.Context, req reso
 	if diags.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, diags)
+	defer cancel()
 	data.ID = state.ID
 
 	mkey := state.ID.ValueString()
 
 	c := r.fortiClient.Client
 	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
 	input_model.Mkey = mkey
 	input_model.BodyParams = *(data.getUpdateObjectUserSwgSessionsDeauth(ctx, state, diags))
 
@@ -0,0 +1,127 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// baselinePrivateStateKey is the private state key of the object as it was before Terraform managed it.
+const baselinePrivateStateKey = "baseline"
+
+// privateStateSetter and privateStateGetter are satisfied by the private state of the framework responses and requests.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// restoreOnDestroyAttribute is the restore_on_destroy attribute of the resources managing a singleton object,
+// which cannot be deleted and are restored to the baseline captured on create instead.
+func restoreOnDestroyAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Whether to restore the object to the settings it had before Terraform managed it when the resource is destroyed. The secrets are not restored, FortiSASE does not return them. When `false`, the object is left as is. Defaults to `true`.",
+		Computed:            true,
+		Optional:            true,
+		Default:             booldefault.StaticBool(true),
+	}
+}
+
+// isRestoreOnDestroy returns whether to restore the baseline on destroy,
+// a null value comes from a state written by an earlier version of the provider and keeps the default.
+func isRestoreOnDestroy(v types.Bool) bool {
+	return v.IsNull() || v.ValueBool()
+}
+
+// saveBaseline stores the object read before the first update of Create in the private state, without its secrets.
+// FortiSASE returns the secrets masked, restoring them would set the mask as the secret.
+func saveBaseline(ctx context.Context, private privateStateSetter, baseline map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	value, err := json.Marshal(redactSecretFields(baseline))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error to save the baseline: %v", err), "")
+		return diags
+	}
+	return private.SetKey(ctx, baselinePrivateStateKey, value)
+}
+
+// loadBaseline returns the request body restoring the baseline saved by Create,
+// or nil when none was saved, e.g. for imported objects or objects created by earlier versions of the provider.
+func loadBaseline(ctx context.Context, private privateStateGetter, diags *diag.Diagnostics) map[string]interface{} {
+	value, d := private.GetKey(ctx, baselinePrivateStateKey)
+	diags.Append(d...)
+	if d.HasError() || len(value) == 0 {
+		return nil
+	}
+
+	var baseline map[string]interface{}
+	if err := json.Unmarshal(value, &baseline); err != nil {
+		diags.AddError(fmt.Sprintf("Error to load the baseline: %v", err), "")
+		return nil
+	}
+	return removeReadOnlyFields(baseline)
+}
+
+// readOnlyFields are the top-level fields that FortiSASE sets on the objects, they are never sent back.
+// The nested objects may have a writable id, e.g. the entries of the application control profiles.
+var readOnlyFields = []string{"id", "uuid", "_id"}
+
+// removeReadOnlyFields removes the meta fields and the readOnlyFields from an object read from FortiSASE.
+func removeReadOnlyFields(o map[string]interface{}) map[string]interface{} {
+	o = removeMetaFields(o)
+	for _, k := range readOnlyFields {
+		delete(o, k)
+	}
+	return o
+}
+
+// redactSecretFields returns a copy of o, an object read from FortiSASE, without the secretFields at any depth.
+func redactSecretFields(o map[string]interface{}) map[string]interface{} {
+	var redact func(v interface{}) interface{}
+	redact = func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			result := make(map[string]interface{}, len(v))
+			for k, e := range v {
+				if !secretFields[k] {
+					result[k] = redact(e)
+				}
+			}
+			return result
+		case []interface{}:
+			result := make([]interface{}, len(v))
+			for i, e := range v {
+				result[i] = redact(e)
+			}
+			return result
+		}
+		return v
+	}
+	if o == nil {
+		return nil
+	}
+	return redact(o).(map[string]interface{})
+}
+
+// removeMetaFields removes the read only fields starting with "$", such as "$meta", from an object read from FortiSASE.
+func removeMetaFields(o map[string]interface{}) map[string]interface{} {
+	for k, v := range o {
+		if strings.HasPrefix(k, "$") {
+			delete(o, k)
+			continue
+		}
+		if m, ok := v.(map[string]interface{}); ok {
+			o[k] = removeMetaFields(m)
+		}
+	}
+	return o
+}
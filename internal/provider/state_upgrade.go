@@ -0,0 +1,96 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// stateUpgradeStep migrates the raw JSON state of one schema version to the next one,
+// e.g. by renaming or removing attributes. Changes that keep the JSON representation,
+// such as list to set, only bump the version with keepState.
+type stateUpgradeStep func(ctx context.Context, state map[string]interface{}) error
+
+// stateUpgraders builds the state upgraders of a resource from its ordered upgrade steps,
+// steps[i] upgrades the state of version i to version i+1, so the schema Version must be len(steps).
+// Every prior version is upgraded to the current version in one go by running the remaining steps.
+func stateUpgraders(steps ...stateUpgradeStep) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(steps))
+	for i := range steps {
+		remaining := steps[i:]
+		upgraders[int64(i)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil || req.RawState.JSON == nil {
+					resp.Diagnostics.AddError("Error to upgrade state", "The prior state is not in JSON format. Please report this issue to the provider developers.")
+					return
+				}
+
+				var state map[string]interface{}
+				if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+					resp.Diagnostics.AddError(fmt.Sprintf("Error to upgrade state: %v", err), "")
+					return
+				}
+				for _, step := range remaining {
+					if err := step(ctx, state); err != nil {
+						resp.Diagnostics.AddError(fmt.Sprintf("Error to upgrade state: %v", err), "")
+						return
+					}
+				}
+				upgraded, err := json.Marshal(state)
+				if err != nil {
+					resp.Diagnostics.AddError(fmt.Sprintf("Error to upgrade state: %v", err), "")
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		}
+	}
+	return upgraders
+}
+
+// keepState is the step of the changes that keep the JSON representation of the state.
+func keepState() stateUpgradeStep {
+	return func(ctx context.Context, state map[string]interface{}) error {
+		return nil
+	}
+}
+
+// removeStateAttributes removes top-level attributes that are no longer in the schema.
+func removeStateAttributes(names ...string) stateUpgradeStep {
+	return func(ctx context.Context, state map[string]interface{}) error {
+		for _, name := range names {
+			delete(state, name)
+		}
+		return nil
+	}
+}
+
+// integerStateNumbers truncates the numbers of the state to integers at any depth,
+// for the resources whose numeric attributes all moved from Float64 to Int64.
+func integerStateNumbers() stateUpgradeStep {
+	var truncate func(v interface{}) interface{}
+	truncate = func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case float64:
+			return math.Trunc(v)
+		case map[string]interface{}:
+			for k, e := range v {
+				v[k] = truncate(e)
+			}
+		case []interface{}:
+			for i, e := range v {
+				v[i] = truncate(e)
+			}
+		}
+		return v
+	}
+	return func(ctx context.Context, state map[string]interface{}) error {
+		truncate(state)
+		return nil
+	}
+}
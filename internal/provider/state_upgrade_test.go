@@ -0,0 +1,91 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSamlServerUpgradeState(t *testing.T) {
+	ctx := context.Background()
+	for _, r := range []resource.Resource{newResourceAuthSwgSamlServer(), newResourceAuthVpnSamlServer()} {
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		// A state written before 1.1.0, which still has the attribute enabled.
+		req := resource.UpgradeStateRequest{
+			RawState: &tfprotov6.RawState{JSON: []byte(`{"id":"saml","primary_key":"saml","enabled":true,"scim_enabled":false}`)},
+		}
+		var resp resource.UpgradeStateResponse
+		r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)[0].StateUpgrader(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("UpgradeState() diagnostics: %v", resp.Diagnostics)
+		}
+
+		// The upgraded state must only have attributes of the current schema.
+		v, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+		if err != nil {
+			t.Fatalf("UpgradeState() = %s, not in the current schema: %v", resp.DynamicValue.JSON, err)
+		}
+		var state map[string]tftypes.Value
+		if err := v.As(&state); err != nil {
+			t.Fatalf("UpgradeState() = %v, want an object: %v", v, err)
+		}
+		var primaryKey string
+		if err := state["primary_key"].As(&primaryKey); err != nil || primaryKey != "saml" {
+			t.Errorf("UpgradeState() primary_key = %q, want the prior value", primaryKey)
+		}
+	}
+}
+
+func TestIntegerStateNumbers(t *testing.T) {
+	cases := []struct {
+		name  string
+		state map[string]interface{}
+		want  map[string]interface{}
+	}{
+		{
+			name:  "top-level numbers",
+			state: map[string]interface{}{"port": 443.0, "mtu_size": 1500.7, "name": "a"},
+			want:  map[string]interface{}{"port": 443.0, "mtu_size": 1500.0, "name": "a"},
+		},
+		{
+			name: "nested numbers",
+			state: map[string]interface{}{
+				"config": map[string]interface{}{"client_limit": 10.2, "enabled": true},
+				"ranges": []interface{}{
+					map[string]interface{}{"low": 80.9, "high": 90.1},
+					-3.5,
+				},
+			},
+			want: map[string]interface{}{
+				"config": map[string]interface{}{"client_limit": 10.0, "enabled": true},
+				"ranges": []interface{}{
+					map[string]interface{}{"low": 80.0, "high": 90.0},
+					-3.0,
+				},
+			},
+		},
+		{
+			name:  "null values",
+			state: map[string]interface{}{"port": nil, "ranges": []interface{}{nil}},
+			want:  map[string]interface{}{"port": nil, "ranges": []interface{}{nil}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := integerStateNumbers()(context.Background(), tc.state); err != nil {
+				t.Fatalf("integerStateNumbers() error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.state, tc.want) {
+				t.Errorf("integerStateNumbers() = %v, want %v", tc.state, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTimeout is the timeout of the operations that are not set in the timeouts block.
+// It leaves time for the provisioning of the private access service connections and of the large DLP fingerprint databases.
+const defaultTimeout = 20 * time.Minute
+
+var timeoutsOpts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+}
+
+// timeoutsOption removes an operation from the timeouts block of the resources that do not implement it,
+// e.g. the singletons that cannot be deleted, so that the block does not offer a timeout that is never applied.
+type timeoutsOption func(*timeouts.Opts)
+
+func timeoutsWithoutRead(o *timeouts.Opts)   { o.Read = false }
+func timeoutsWithoutUpdate(o *timeouts.Opts) { o.Update = false }
+func timeoutsWithoutDelete(o *timeouts.Opts) { o.Delete = false }
+
+// timeoutsBlock is the timeouts block of the resources, with all the operations unless opts remove some.
+func timeoutsBlock(ctx context.Context, opts ...timeoutsOption) schema.Block {
+	o := timeoutsOpts
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return timeouts.Block(ctx, o)
+}
+
+// timeoutsNull is the value of a timeouts block that is not set, for the models that are not read from the configuration.
+func timeoutsNull(ctx context.Context) timeouts.Value {
+	t := timeoutsBlock(ctx).Type().(attr.TypeWithAttributeTypes)
+	return timeouts.Value{Object: types.ObjectNull(t.AttributeTypes())}
+}
+
+// withTimeout returns ctx with the deadline of an operation, timeout is the getter of the operation in the timeouts block,
+// e.g. data.Timeouts.Create. The requests sent with the returned context and their retries stop when the deadline passes.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	t, d := timeout(ctx, defaultTimeout)
+	diags.Append(d...)
+	return context.WithTimeout(ctx, t)
+}
+
+// waitTimeout sleeps d before polling an operation again, it returns false when the deadline of ctx passes first.
+// The provisioning of the private access objects is polled until it completes or the operation times out.
+func waitTimeout(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// pollTimeoutDetail is the detail of the error reported when the deadline of an operation passes while field,
+// the state of the polled object, is still pending. state is nil when it was not read yet.
+func pollTimeoutDetail(field string, state interface{}) string {
+	if state == nil {
+		return fmt.Sprintf("The timeout expired before %s could be read. Increase the timeout in the timeouts block.", field)
+	}
+	return fmt.Sprintf("The timeout expired while %s was still %q. Increase the timeout in the timeouts block.", field, fmt.Sprint(state))
+}
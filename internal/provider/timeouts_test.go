@@ -0,0 +1,38 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestTimeoutsBlock(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name string
+		opts []timeoutsOption
+		want []string
+	}{
+		{name: "all operations", want: []string{"create", "delete", "read", "update"}},
+		{name: "singleton", opts: []timeoutsOption{timeoutsWithoutDelete}, want: []string{"create", "read", "update"}},
+		{name: "replaced on change", opts: []timeoutsOption{timeoutsWithoutUpdate}, want: []string{"create", "delete", "read"}},
+		{name: "action", opts: []timeoutsOption{timeoutsWithoutRead, timeoutsWithoutDelete}, want: []string{"create", "update"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for name := range timeoutsBlock(ctx, tc.opts...).Type().(attr.TypeWithAttributeTypes).AttributeTypes() {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("timeoutsBlock() attributes = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
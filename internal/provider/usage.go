@@ -0,0 +1,319 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/sdkcore"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// forceDetachAttribute is the force_detach attribute of the resources that have a usage endpoint, it is kept in the state only.
+func forceDetachAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Whether to remove the references to the object from the objects referencing it before deleting it. Only the references in lists of objects without secrets can be removed, as FortiSASE does not return the secrets and the update would erase them: when an object references this one outside of a list, e.g. as its profile group, or holds a secret, the deletion fails and lists it. Nothing is removed then, and the collections that cannot be read are reported in a warning. When `false`, deleting an object that is still in use fails and lists the objects referencing it. Defaults to `false`.",
+		Optional:            true,
+	}
+}
+
+// usageDatasources lists the datasources of the objects that have a usage endpoint,
+// "/resource-api/v2/usage/<datasource>/{primaryKey}".
+var usageDatasources = map[string]bool{
+	"auth/fsso-agents":                       true,
+	"auth/ldap-servers":                      true,
+	"auth/radius-servers":                    true,
+	"auth/user-groups":                       true,
+	"endpoint/ztna-tags":                     true,
+	"infra/ssids":                            true,
+	"network/host-groups":                    true,
+	"network/hosts":                          true,
+	"security/app-custom-signatures":         true,
+	"security/dlp-dictionaries":              true,
+	"security/dlp-exact-data-matches":        true,
+	"security/dlp-file-patterns":             true,
+	"security/dlp-fingerprint-databases":     true,
+	"security/dlp-sensors":                   true,
+	"security/domain-threat-feeds":           true,
+	"security/endpoint-to-endpoint-policies": true,
+	"security/fortiguard-local-categories":   true,
+	"security/internal-policies":             true,
+	"security/internal-reverse-policies":     true,
+	"security/ip-threat-feeds":               true,
+	"security/ips-custom-signatures":         true,
+	"security/onetime-schedules":             true,
+	"security/outbound-policies":             true,
+	"security/profile-groups":                true,
+	"security/recurring-schedules":           true,
+	"security/schedule-groups":               true,
+	"security/service-groups":                true,
+	"security/services":                      true,
+	"security/url-threat-feeds":              true,
+}
+
+// objectReferrer is an object referencing another one through a {primaryKey, datasource} reference.
+type objectReferrer struct {
+	collection *exportCollection
+	primaryKey string
+	object     map[string]interface{}
+}
+
+// checkUsage makes sure that the object mkey is not in use before deleting it,
+// datasource is the value of the references to the object, e.g. "network/hosts", which also locates its usage endpoint.
+// With forceDetach, the references are removed from the referencing objects, when none of them is found it fails like without.
+// It returns false and reports the referencing objects as an error when the object is still in use.
+// When the usage cannot be read, it warns and returns true, the delete request fails if the object is still in use.
+func checkUsage(ctx context.Context, c *forticlient.FortiSDKClient, datasource string, mkey string, forceDetach types.Bool, resourceName string, diags *diag.Diagnostics) bool {
+	usage, err := readUsage(ctx, c, datasource, mkey)
+	if err != nil {
+		diags.AddWarning(
+			fmt.Sprintf("Unable to read the usage of %q of resource %s", mkey, resourceName),
+			fmt.Sprintf("The objects referencing it are not checked nor detached before the deletion: %v", err),
+		)
+		return true
+	}
+	if len(usage) == 0 {
+		return true
+	}
+
+	referrers, skipped := findReferrers(ctx, c, datasource, mkey)
+	if len(skipped) > 0 {
+		diags.AddWarning(
+			fmt.Sprintf("Unable to list all the objects referencing %q of resource %s", mkey, resourceName),
+			fmt.Sprintf("These collections cannot be read, the objects they hold are neither listed nor detached: %s.", strings.Join(skipped, ", ")),
+		)
+	}
+	if forceDetach.ValueBool() && len(referrers) > 0 {
+		return detachReferrers(ctx, c, referrers, datasource, mkey, resourceName, diags)
+	}
+
+	var lines []string
+	for _, referrer := range referrers {
+		lines = append(lines, fmt.Sprintf("- %s %q", referrer.collection.typeName, referrer.primaryKey))
+	}
+	if len(lines) == 0 {
+		// The referencing objects are not managed by this provider, list their types only
+		for t, count := range usage {
+			lines = append(lines, fmt.Sprintf("- %s (%d)", t, count))
+		}
+		sort.Strings(lines)
+	}
+	hint := "Remove the references first, or set force_detach to true to remove them on destroy."
+	if forceDetach.ValueBool() {
+		hint = "None of the referencing objects was found in the collections of this provider, so force_detach cannot remove the references. Remove them first."
+	}
+	diags.AddError(
+		"Object In Use",
+		fmt.Sprintf("The object %q of resource %s cannot be deleted as it is referenced by:\n%s\n\n%s", mkey, resourceName, strings.Join(lines, "\n"), hint),
+	)
+	return false
+}
+
+// detachReferrers removes the references to the object mkey from the referrers. Each referrer is read again,
+// as the collection may omit fields, and updated without the references and its read-only fields.
+// An update replaces the object, so the referrers holding a secret, which FortiSASE does not return, would lose it:
+// like the references outside of a list, they are reported and nothing is updated then.
+// The updates are not atomic, when one fails the error lists the referrers that were already detached.
+func detachReferrers(ctx context.Context, c *forticlient.FortiSDKClient, referrers []objectReferrer, datasource string, mkey string, resourceName string, diags *diag.Diagnostics) bool {
+	bodies := make([]map[string]interface{}, len(referrers))
+	var lines []string
+	var secretLines []string
+	for i, referrer := range referrers {
+		var input_model forticlient.InputModel
+		input_model.Ctx = ctx
+		input_model.Mkey = referrer.primaryKey
+		input_model.URL = referrer.collection.path + "/{primaryKey}"
+
+		output, err := c.ReadObject(&input_model)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error to read %s %q referencing resource %s: %v", referrer.collection.typeName, referrer.primaryKey, resourceName, err),
+				getErrorDetail(&input_model, output),
+			)
+			return false
+		}
+		bodies[i] = removeReadOnlyFields(withoutReferences(output, datasource, mkey).(map[string]interface{}))
+		if hasReference(bodies[i], datasource, mkey) {
+			lines = append(lines, fmt.Sprintf("- %s %q", referrer.collection.typeName, referrer.primaryKey))
+		}
+		if hasSecretFields(bodies[i]) {
+			secretLines = append(secretLines, fmt.Sprintf("- %s %q", referrer.collection.typeName, referrer.primaryKey))
+		}
+	}
+	if len(lines) > 0 {
+		diags.AddError(
+			"Object In Use",
+			fmt.Sprintf("The object %q of resource %s is referenced outside of a list by:\n%s\n\nforce_detach only removes the references in lists, change or delete these objects first.", mkey, resourceName, strings.Join(lines, "\n")),
+		)
+		return false
+	}
+	if len(secretLines) > 0 {
+		diags.AddError(
+			"Object In Use",
+			fmt.Sprintf("The object %q of resource %s is referenced by objects holding secrets:\n%s\n\nFortiSASE does not return the secrets, updating these objects would erase them. Remove the references from these objects first.", mkey, resourceName, strings.Join(secretLines, "\n")),
+		)
+		return false
+	}
+
+	var detached []string
+	for i, referrer := range referrers {
+		var input_model forticlient.InputModel
+		input_model.Ctx = ctx
+		input_model.Mkey = referrer.primaryKey
+		input_model.URL = referrer.collection.path + "/{primaryKey}"
+		input_model.BodyParams = bodies[i]
+
+		output, err := c.UpdateObject(&input_model)
+		if err != nil {
+			detail := getErrorDetail(&input_model, output)
+			if len(detached) > 0 {
+				detail = fmt.Sprintf("The references were already removed from:\n%s\n\n%s", strings.Join(detached, "\n"), detail)
+			}
+			diags.AddError(
+				fmt.Sprintf("Error to detach %s %q from resource %s: %v", referrer.collection.typeName, referrer.primaryKey, resourceName, err),
+				detail,
+			)
+			return false
+		}
+		detached = append(detached, fmt.Sprintf("- %s %q", referrer.collection.typeName, referrer.primaryKey))
+	}
+	return true
+}
+
+// readUsage returns the number of objects referencing the object mkey by type, as reported by its usage endpoint.
+// The "data" of the response is a list of {type, count} entries or a single entry.
+func readUsage(ctx context.Context, c *forticlient.FortiSDKClient, datasource string, mkey string) (map[string]int, error) {
+	var input_model forticlient.InputModel
+	input_model.Ctx = ctx
+	input_model.Mkey = mkey
+	input_model.URL = "/resource-api/v2/usage/" + datasource + "/{primaryKey}"
+	input_model.HTTPMethod = "GET"
+
+	output, err := c.Request(&input_model)
+	if err != nil {
+		return nil, err
+	}
+
+	// Request returns the "data" object, or the whole response when "data" is a list
+	var entries []interface{}
+	if data, ok := output["data"].([]interface{}); ok {
+		entries = data
+	} else if _, ok := output["count"]; ok {
+		entries = []interface{}{output}
+	}
+
+	usage := make(map[string]int)
+	for _, v := range entries {
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if count, ok := o["count"].(float64); ok && count > 0 {
+			usage[fortiStringValue(o["type"])] += int(count)
+		}
+	}
+	return usage, nil
+}
+
+// findReferrers lists the objects of the collections of this provider that reference the object mkey.
+// Collections that cannot be listed, e.g. because of the license of the tenant, are skipped and returned.
+func findReferrers(ctx context.Context, c *forticlient.FortiSDKClient, datasource string, mkey string) ([]objectReferrer, []string) {
+	var referrers []objectReferrer
+	var skipped []string
+	for i := range exportCollections {
+		col := &exportCollections[i]
+		output, err := col.list(ctx, c)
+		if err != nil {
+			skipped = append(skipped, col.typeName)
+			continue
+		}
+		for _, o := range output {
+			if !hasReference(o, datasource, mkey) {
+				continue
+			}
+			referrers = append(referrers, objectReferrer{
+				collection: col,
+				primaryKey: col.objectKey(o),
+				object:     o,
+			})
+		}
+	}
+	return referrers, skipped
+}
+
+// hasSecretFields returns whether v holds a secret field with a value at any depth.
+func hasSecretFields(v interface{}) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if secretFields[k] && e != nil && e != "" {
+				return true
+			}
+			if hasSecretFields(e) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if hasSecretFields(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isReference returns whether v is a {primaryKey, datasource} reference to the object mkey.
+func isReference(v interface{}, datasource string, mkey string) bool {
+	o, ok := v.(map[string]interface{})
+	return ok && fortiStringValue(o["datasource"]) == datasource && fortiStringValue(o["primaryKey"]) == mkey
+}
+
+// hasReference returns whether v contains a reference to the object mkey at any depth.
+func hasReference(v interface{}, datasource string, mkey string) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if isReference(v, datasource, mkey) {
+			return true
+		}
+		for _, e := range v {
+			if hasReference(e, datasource, mkey) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if hasReference(e, datasource, mkey) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// withoutReferences returns v without the references to the object mkey listed in its lists at any depth.
+// Single references are kept, they cannot be removed without changing the meaning of the object.
+func withoutReferences(v interface{}, datasource string, mkey string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			result[k] = withoutReferences(e, datasource, mkey)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			if isReference(e, datasource, mkey) {
+				continue
+			}
+			result = append(result, withoutReferences(e, datasource, mkey))
+		}
+		return result
+	}
+	return v
+}
@@ -0,0 +1,350 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testExportCollection returns the export collection of the resource type typeName.
+func testExportCollection(t *testing.T, typeName string) *exportCollection {
+	t.Helper()
+	for i := range exportCollections {
+		if exportCollections[i].typeName == typeName {
+			return &exportCollections[i]
+		}
+	}
+	t.Fatalf("no export collection for %s", typeName)
+	return nil
+}
+
+func TestWithoutReferences(t *testing.T) {
+	web := map[string]interface{}{"primaryKey": "web", "datasource": "network/hosts"}
+	db := map[string]interface{}{"primaryKey": "db", "datasource": "network/hosts"}
+	webGroup := map[string]interface{}{"primaryKey": "web", "datasource": "network/host-groups"}
+
+	cases := []struct {
+		name string
+		in   interface{}
+		want interface{}
+	}{
+		{
+			name: "list",
+			in:   map[string]interface{}{"members": []interface{}{web, db}},
+			want: map[string]interface{}{"members": []interface{}{db}},
+		},
+		{
+			name: "nested list",
+			in:   map[string]interface{}{"rules": []interface{}{map[string]interface{}{"sources": []interface{}{web}}}},
+			want: map[string]interface{}{"rules": []interface{}{map[string]interface{}{"sources": []interface{}{}}}},
+		},
+		{
+			name: "single reference",
+			in:   map[string]interface{}{"host": web},
+			want: map[string]interface{}{"host": web},
+		},
+		{
+			name: "other datasource",
+			in:   map[string]interface{}{"members": []interface{}{webGroup}},
+			want: map[string]interface{}{"members": []interface{}{webGroup}},
+		},
+		{
+			name: "scalar",
+			in:   "web",
+			want: "web",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := withoutReferences(tc.in, "network/hosts", "web")
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("withoutReferences() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasSecretFields(t *testing.T) {
+	cases := []struct {
+		name string
+		in   interface{}
+		want bool
+	}{
+		{name: "secret", in: map[string]interface{}{"password": "x"}, want: true},
+		{name: "nested secret", in: map[string]interface{}{"servers": []interface{}{map[string]interface{}{"primarySecret": "x"}}}, want: true},
+		{name: "empty secret", in: map[string]interface{}{"password": ""}, want: false},
+		{name: "null secret", in: map[string]interface{}{"preSharedKey": nil}, want: false},
+		{name: "no secret", in: map[string]interface{}{"username": "x", "members": []interface{}{"a"}}, want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := hasSecretFields(tc.in); got != tc.want {
+				t.Errorf("hasSecretFields(%v) = %v, want %v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadUsage(t *testing.T) {
+	cases := []struct {
+		name    string
+		code    int
+		data    interface{}
+		want    map[string]int
+		wantErr bool
+	}{
+		{
+			name: "list",
+			code: 200,
+			data: []interface{}{
+				map[string]interface{}{"type": "network/host-groups", "count": 2},
+				map[string]interface{}{"type": "security/outbound-policies", "count": 1},
+				map[string]interface{}{"type": "network/host-groups", "count": 1},
+				map[string]interface{}{"type": "security/internal-policies", "count": 0},
+			},
+			want: map[string]int{"network/host-groups": 3, "security/outbound-policies": 1},
+		},
+		{
+			name: "single entry",
+			code: 200,
+			data: map[string]interface{}{"type": "network/host-groups", "count": 2},
+			want: map[string]int{"network/host-groups": 2},
+		},
+		{
+			name: "not in use",
+			code: 200,
+			data: []interface{}{},
+			want: map[string]int{},
+		},
+		{
+			name:    "error",
+			code:    403,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestFortiClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != "/resource-api/v2/usage/network/hosts/web" {
+					t.Errorf("request %s %s, want GET of the usage endpoint", r.Method, r.URL.Path)
+				}
+				writeTestResponse(w, tc.code, tc.data)
+			}))
+
+			got, err := readUsage(context.Background(), client.Client, "network/hosts", "web")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("readUsage() error = %v, want error %v", err, tc.wantErr)
+			}
+			if !tc.wantErr && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("readUsage() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDetachReferrers(t *testing.T) {
+	web := map[string]interface{}{"primaryKey": "web", "datasource": "network/hosts"}
+	db := map[string]interface{}{"primaryKey": "db", "datasource": "network/hosts"}
+
+	cases := []struct {
+		name string
+		// objects are the referrers read from the host groups collection by primary key
+		objects map[string]map[string]interface{}
+		// failedUpdate is the primary key of the referrer whose update fails
+		failedUpdate string
+		want         bool
+		wantUpdates  map[string]map[string]interface{}
+		wantError    string
+	}{
+		{
+			name: "list references",
+			objects: map[string]map[string]interface{}{
+				"servers":  {"primaryKey": "servers", "id": "1", "members": []interface{}{web, db}},
+				"web-only": {"primaryKey": "web-only", "id": "2", "members": []interface{}{web}},
+			},
+			want: true,
+			wantUpdates: map[string]map[string]interface{}{
+				"servers":  {"primaryKey": "servers", "members": []interface{}{db}},
+				"web-only": {"primaryKey": "web-only", "members": []interface{}{}},
+			},
+		},
+		{
+			name: "reference outside of a list",
+			objects: map[string]map[string]interface{}{
+				"servers": {"primaryKey": "servers", "members": []interface{}{web}},
+				"main":    {"primaryKey": "main", "host": web},
+			},
+			wantError: `fortisase_network_host_groups "main"`,
+		},
+		{
+			name: "secret holder",
+			objects: map[string]map[string]interface{}{
+				"servers": {"primaryKey": "servers", "members": []interface{}{web}, "preSharedKey": "x"},
+			},
+			wantError: "objects holding secrets",
+		},
+		{
+			name: "failed update",
+			objects: map[string]map[string]interface{}{
+				"a-servers": {"primaryKey": "a-servers", "members": []interface{}{web}},
+				"b-servers": {"primaryKey": "b-servers", "members": []interface{}{web}},
+			},
+			failedUpdate: "b-servers",
+			wantUpdates: map[string]map[string]interface{}{
+				"a-servers": {"primaryKey": "a-servers", "members": []interface{}{}},
+			},
+			wantError: `already removed from:` + "\n" + `- fortisase_network_host_groups "a-servers"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			updates := make(map[string]map[string]interface{})
+			client := newTestFortiClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mkey := strings.TrimPrefix(r.URL.Path, "/resource-api/v2/network/host-groups/")
+				switch r.Method {
+				case "GET":
+					writeTestResponse(w, 200, tc.objects[mkey])
+				case "PUT":
+					if mkey == tc.failedUpdate {
+						writeTestResponse(w, 403, nil)
+						return
+					}
+					var body map[string]interface{}
+					json.NewDecoder(r.Body).Decode(&body)
+					mu.Lock()
+					updates[mkey] = body
+					mu.Unlock()
+					writeTestResponse(w, 200, body)
+				}
+			}))
+
+			col := testExportCollection(t, "fortisase_network_host_groups")
+			var referrers []objectReferrer
+			for mkey, o := range tc.objects {
+				referrers = append(referrers, objectReferrer{collection: col, primaryKey: mkey, object: o})
+			}
+			sort.Slice(referrers, func(i, j int) bool { return referrers[i].primaryKey < referrers[j].primaryKey })
+
+			var diags diag.Diagnostics
+			got := detachReferrers(context.Background(), client.Client, referrers, "network/hosts", "web", "fortisase_network_hosts", &diags)
+			if got != tc.want {
+				t.Errorf("detachReferrers() = %v, want %v", got, tc.want)
+			}
+			if tc.wantError == "" && diags.HasError() {
+				t.Errorf("detachReferrers() diagnostics: %v", diags)
+			}
+			if tc.wantError != "" && !diagsContain(diags, tc.wantError) {
+				t.Errorf("detachReferrers() diagnostics = %v, want an error containing %q", diags, tc.wantError)
+			}
+			want := tc.wantUpdates
+			if want == nil {
+				want = map[string]map[string]interface{}{}
+			}
+			if !reflect.DeepEqual(updates, want) {
+				t.Errorf("detachReferrers() updates = %v, want %v", updates, want)
+			}
+		})
+	}
+}
+
+func TestCheckUsage(t *testing.T) {
+	cases := []struct {
+		name        string
+		usage       interface{}
+		referrers   []interface{}
+		forceDetach bool
+		want        bool
+		wantError   string
+		wantUpdate  bool
+	}{
+		{
+			name:  "not in use",
+			usage: []interface{}{},
+			want:  true,
+		},
+		{
+			name:      "in use",
+			usage:     []interface{}{map[string]interface{}{"type": "network/host-groups", "count": 1}},
+			referrers: []interface{}{map[string]interface{}{"primaryKey": "servers", "members": []interface{}{map[string]interface{}{"primaryKey": "web", "datasource": "network/hosts"}}}},
+			wantError: `fortisase_network_host_groups "servers"`,
+		},
+		{
+			name:        "force detach",
+			usage:       []interface{}{map[string]interface{}{"type": "network/host-groups", "count": 1}},
+			referrers:   []interface{}{map[string]interface{}{"primaryKey": "servers", "members": []interface{}{map[string]interface{}{"primaryKey": "web", "datasource": "network/hosts"}}}},
+			forceDetach: true,
+			want:        true,
+			wantUpdate:  true,
+		},
+		{
+			// The usage counts objects that are in none of the collections of the provider, e.g. security profiles.
+			name:        "force detach without referrers",
+			usage:       []interface{}{map[string]interface{}{"type": "security/web-filter-profiles", "count": 2}},
+			forceDetach: true,
+			wantError:   "- security/web-filter-profiles (2)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			updated := false
+			client := newTestFortiClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/resource-api/v2/usage/network/hosts/web":
+					writeTestResponse(w, 200, tc.usage)
+				case r.URL.Path == "/resource-api/v2/network/host-groups":
+					writeTestResponse(w, 200, tc.referrers)
+				case r.URL.Path == "/resource-api/v2/network/host-groups/servers" && r.Method == "GET":
+					writeTestResponse(w, 200, tc.referrers[0])
+				case r.URL.Path == "/resource-api/v2/network/host-groups/servers" && r.Method == "PUT":
+					mu.Lock()
+					updated = true
+					mu.Unlock()
+					writeTestResponse(w, 200, nil)
+				default:
+					writeTestResponse(w, 200, []interface{}{})
+				}
+			}))
+
+			var diags diag.Diagnostics
+			got := checkUsage(context.Background(), client.Client, "network/hosts", "web", types.BoolValue(tc.forceDetach), "fortisase_network_hosts", &diags)
+			if got != tc.want {
+				t.Errorf("checkUsage() = %v, want %v", got, tc.want)
+			}
+			if tc.wantError == "" && diags.HasError() {
+				t.Errorf("checkUsage() diagnostics: %v", diags)
+			}
+			if tc.wantError != "" && !diagsContain(diags, tc.wantError) {
+				t.Errorf("checkUsage() diagnostics = %v, want an error containing %q", diags, tc.wantError)
+			}
+			if updated != tc.wantUpdate {
+				t.Errorf("checkUsage() updated the referrer: %v, want %v", updated, tc.wantUpdate)
+			}
+		})
+	}
+}
+
+// diagsContain returns whether one of the errors of diags contains s in its summary or detail.
+func diagsContain(diags diag.Diagnostics, s string) bool {
+	for _, d := range diags.Errors() {
+		if strings.Contains(d.Summary(), s) || strings.Contains(d.Detail(), s) {
+			return true
+		}
+	}
+	return false
+}
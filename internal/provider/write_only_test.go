@@ -0,0 +1,72 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestUpdateObjectWriteOnlySecrets checks that an update of an unrelated attribute still sends the write-only secrets,
+// as the PUT replaces the object and would erase the secrets it does not carry.
+func TestUpdateObjectWriteOnlySecrets(t *testing.T) {
+	ctx := context.Background()
+	raw := types.StringValue(`{"primaryKey":"ldap","server":"10.0.0.1","username":"old"}`)
+
+	t.Run("ldap server", func(t *testing.T) {
+		state := resourceAuthLdapServersModel{
+			PrimaryKey:        types.StringValue("ldap"),
+			Server:            types.StringValue("10.0.0.1"),
+			Username:          types.StringValue("old"),
+			PasswordWoVersion: types.Int64Value(1),
+		}
+		data := state
+		data.Username = types.StringValue("new")
+		data.PasswordWo = types.StringValue("secret")
+
+		var diags diag.Diagnostics
+		body := withUnmanagedFields(*data.getUpdateObjectAuthLdapServers(ctx, state, &diags), raw, state)
+		if diags.HasError() {
+			t.Fatalf("getUpdateObjectAuthLdapServers() diagnostics: %v", diags)
+		}
+		if body["password"] != "secret" || body["username"] != "new" {
+			t.Errorf("update body = %v, want the new username and the write-only password", body)
+		}
+	})
+
+	t.Run("radius server", func(t *testing.T) {
+		state := resourceAuthRadiusServersModel{
+			PrimaryKey:               types.StringValue("radius"),
+			PrimaryServer:            types.StringValue("10.0.0.1"),
+			PrimarySecretWoVersion:   types.Int64Value(1),
+			SecondarySecretWoVersion: types.Int64Value(3),
+		}
+		data := state
+		data.PrimaryServer = types.StringValue("10.0.0.2")
+		data.PrimarySecretWo = types.StringValue("primary")
+		data.SecondarySecretWo = types.StringValue("secondary")
+
+		var diags diag.Diagnostics
+		body := *data.getUpdateObjectAuthRadiusServers(ctx, state, &diags)
+		if diags.HasError() {
+			t.Fatalf("getUpdateObjectAuthRadiusServers() diagnostics: %v", diags)
+		}
+		if body["primarySecret"] != "primary" || body["secondarySecret"] != "secondary" || body["primaryServer"] != "10.0.0.2" {
+			t.Errorf("update body = %v, want the new server and both write-only secrets", body)
+		}
+	})
+
+	t.Run("unset secret", func(t *testing.T) {
+		state := resourceAuthLdapServersModel{PrimaryKey: types.StringValue("ldap")}
+		data := state
+		data.Username = types.StringValue("new")
+
+		var diags diag.Diagnostics
+		body := *data.getUpdateObjectAuthLdapServers(ctx, state, &diags)
+		if _, ok := body["password"]; ok {
+			t.Errorf("update body = %v, want no password when neither password nor password_wo is set", body)
+		}
+	})
+}
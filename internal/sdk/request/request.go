@@ -2,6 +2,7 @@ package request
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -83,6 +84,10 @@ func (r *Request) Send() error {
 				err = fmt.Errorf("lost connection to firewall with error: %v", errdo.Error())
 				break
 			}
+			if ctxErr := r.HTTPRequest.Context().Err(); ctxErr != nil {
+				err = fmt.Errorf("Error found: %v, not resent: %v", errdo.Error(), ctxErr)
+				break
+			}
 			time.Sleep(time.Second)
 			log.Printf("Error found: %v, will resend again %s, %d", errdo.Error(), u, retry)
 
@@ -168,6 +173,76 @@ func (r *Request) GenToken() (string, string, error) {
 	return access_token, refresh_token, err
 }
 
+// RequestToken requests a new token for the given credentials and client ID.
+// The client ID limits the products the token is valid for, e.g. "FortiSASE".
+// It returns the decoded response that holds access_token, refresh_token, expires_in, etc.
+// The request stops when ctx is done. If errors are encountered, it returns the error.
+func (r *Request) RequestToken(ctx context.Context, username, password, client_id string) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	data["username"] = username
+	data["password"] = password
+	data["client_id"] = client_id
+	data["grant_type"] = "password"
+
+	result, err := r.sendAuthRequest(ctx, "https://customerapiauth.fortinet.com/api/v1/oauth/token/", data)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := result["access_token"]; !ok {
+		return nil, fmt.Errorf("Login failed: %v.", result["status_message"])
+	}
+	return result, nil
+}
+
+// RevokeToken revokes a token issued for the client ID.
+// The request stops when ctx is done. If errors are encountered, it returns the error.
+func (r *Request) RevokeToken(ctx context.Context, token, client_id string) error {
+	data := make(map[string]interface{})
+	data["token"] = token
+	data["client_id"] = client_id
+
+	result, err := r.sendAuthRequest(ctx, "https://customerapiauth.fortinet.com/api/v1/oauth/revoke_token/", data)
+	if err != nil {
+		return err
+	}
+	if status, ok := result["status"].(string); ok && status != "success" {
+		return fmt.Errorf("Revoke token failed: %v.", result["status_message"])
+	}
+	return nil
+}
+
+func (r *Request) sendAuthRequest(ctx context.Context, u string, data map[string]interface{}) (map[string]interface{}, error) {
+	locJSON, err := json.Marshal(data)
+	if err != nil {
+		log.Printf("[ERROR] Encoding body data failed.")
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u, bytes.NewBuffer(locJSON))
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse URL: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := r.Config.HTTPCon.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request error: %v", err)
+	}
+
+	body, err := io.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	if err != nil || body == nil {
+		return nil, fmt.Errorf("cannot get response body, %s", err)
+	}
+
+	var result map[string]interface{}
+	json.Unmarshal(body, &result)
+	if result == nil {
+		return nil, fmt.Errorf("\n%v", string(body))
+	}
+	return result, nil
+}
+
 // Logout current token based authentication.
 // If errors are encountered, it returns the error.
 func (r *Request) LogoutToken(token string) error {
@@ -0,0 +1,23 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fortinetdev/terraform-provider-fortisase/internal/sdk/config"
+)
+
+func TestAuthRequestContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := &Request{Config: config.Config{HTTPCon: &http.Client{}}}
+	if _, err := r.RequestToken(ctx, "user", "password", "FortiSASE"); err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Errorf("RequestToken() with a done context error = %v, want the request to stop", err)
+	}
+	if err := r.RevokeToken(ctx, "token", "FortiSASE"); err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Errorf("RevokeToken() with a done context error = %v, want the request to stop", err)
+	}
+}
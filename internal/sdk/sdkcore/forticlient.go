@@ -2,6 +2,7 @@ package forticlient
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -84,6 +85,80 @@ func (c *FortiSDKClient) GenToken() error {
 	return nil
 }
 
+// ReadCollection lists every object of the collection endpoint set in input_model.URL,
+// e.g. "/resource-api/v2/network/hosts".
+// It returns the "data" array of the response.
+func (c *FortiSDKClient) ReadCollection(input_model *InputModel) (output []interface{}, err error) {
+	input_model.HTTPMethod = "GET"
+	input_model.update()
+
+	output, err = readList(c, input_model)
+	return
+}
+
+// Request sends input_model to the endpoint set in input_model.URL with input_model.HTTPMethod,
+// for the endpoints that have no dedicated function.
+// It returns the "data" object of the response, or the whole response when "data" is not an object.
+func (c *FortiSDKClient) Request(input_model *InputModel) (output map[string]interface{}, err error) {
+	input_model.update()
+
+	output, err = sendRequests(c, input_model)
+	return
+}
+
+// ReadObject reads the object input_model.Mkey of the endpoint set in input_model.URL,
+// e.g. "/resource-api/v2/network/host-groups/{primaryKey}".
+// If errors are encountered, it returns the error.
+func (c *FortiSDKClient) ReadObject(input_model *InputModel) (output map[string]interface{}, err error) {
+	input_model.HTTPMethod = "GET"
+	input_model.update()
+
+	output, err = read(c, input_model)
+	return
+}
+
+// UpdateObject updates the object input_model.Mkey of the endpoint set in input_model.URL with input_model.BodyParams,
+// e.g. "/resource-api/v2/network/host-groups/{primaryKey}".
+// If errors are encountered, it returns the error.
+func (c *FortiSDKClient) UpdateObject(input_model *InputModel) (output map[string]interface{}, err error) {
+	input_model.HTTPMethod = "PUT"
+	input_model.update()
+
+	output, err = sendRequests(c, input_model)
+	return
+}
+
+// ReadReference checks whether the object referenced by a {primary_key, datasource} pair exists,
+// e.g. datasource "network/hosts" is read from "/resource-api/v2/network/hosts/{primaryKey}".
+// It returns false without an error when the object is not found. The read and its retries stop when ctx is done.
+func (c *FortiSDKClient) ReadReference(ctx context.Context, datasource string, mkey string) (exists bool, err error) {
+	input_model := &InputModel{
+		Mkey:       mkey,
+		HTTPMethod: "GET",
+		URL:        "/resource-api/v2/" + datasource + "/{primaryKey}",
+		Ctx:        ctx,
+	}
+	input_model.update()
+
+	exists, err = exist(c, input_model)
+	return
+}
+
+// CreateAccessToken generates a new access token for the given credentials and client ID,
+// independently of the token used by the client itself. The request stops when ctx is done.
+// If errors are encountered, it returns the error.
+func (c *FortiSDKClient) CreateAccessToken(ctx context.Context, username, password, client_id string) (map[string]interface{}, error) {
+	req := c.NewRequest("POST", "", nil, nil)
+	return req.RequestToken(ctx, username, password, client_id)
+}
+
+// RevokeAccessToken revokes a token generated by CreateAccessToken. The request stops when ctx is done.
+// If errors are encountered, it returns the error.
+func (c *FortiSDKClient) RevokeAccessToken(ctx context.Context, token, client_id string) error {
+	req := c.NewRequest("POST", "", nil, nil)
+	return req.RevokeToken(ctx, token, client_id)
+}
+
 // CheckUP checks whether username and password is valid
 // If errors are encountered, it returns the error.
 func (c *FortiSDKClient) CheckUP() error {
@@ -1,11 +1,13 @@
 package forticlient
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // Helper function to check if a value has a specific method
@@ -22,6 +24,22 @@ type InputModel struct {
 	HeadParams map[string]interface{} `json:"head_params"`
 	BodyParams map[string]interface{} `json:"body_params"`
 	URLParams  map[string]interface{} `json:"url_params"`
+	Ctx        context.Context        `json:"-"` // the deadline of the operation, the request and its retries stop when it passes
+}
+
+// wait sleeps d before the request is retried after err.
+// It returns an error when the deadline of input_model.Ctx passes first, the request is not retried then.
+func (input_model *InputModel) wait(d time.Duration, err error) error {
+	if input_model.Ctx == nil {
+		time.Sleep(d)
+		return nil
+	}
+	select {
+	case <-input_model.Ctx.Done():
+		return fmt.Errorf("%v, not retried: %v", err, input_model.Ctx.Err())
+	case <-time.After(d):
+		return nil
+	}
 }
 
 func (input_model *InputModel) update() {
@@ -29,6 +29,9 @@ func sendSingleRequest(c *FortiSDKClient, input_model *InputModel) (output map[s
 	}
 
 	req := c.NewRequest(method, path, head_params, body_bytes)
+	if input_model.Ctx != nil {
+		req.HTTPRequest = req.HTTPRequest.WithContext(input_model.Ctx)
+	}
 	err = req.Send()
 	if err != nil || req.HTTPResponse == nil {
 		err = fmt.Errorf("Cannot send request: %v", err)
@@ -66,17 +69,23 @@ func sendRequests(c *FortiSDKClient, input_model *InputModel) (map[string]interf
 			}
 		} else if code == 400.0 {
 			log.Printf("[%v] [RETRY] [%v] retry again due to 400", input_model.HTTPMethod, input_model.URL)
-			time.Sleep(time.Second * 2)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return result, werr
+			}
 			i = i + 50
 			continue
 		} else if code == 429.0 {
 			log.Printf("[%v] [RETRY] [%v] retry again due to 429", input_model.HTTPMethod, input_model.URL)
-			time.Sleep(time.Second * 2)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return result, werr
+			}
 			i = i + 10
 			continue
 		} else if code == 500.0 {
 			log.Printf("[%v] [RETRY] [%v] retry again due to 500", input_model.HTTPMethod, input_model.URL)
-			time.Sleep(time.Second * 2)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return result, werr
+			}
 			i = i + 20
 			continue
 		} else {
@@ -108,19 +117,25 @@ func read(c *FortiSDKClient, input_model *InputModel) (map[string]interface{}, e
 			}
 		} else if code == 400.0 {
 			log.Printf("[%v] [RETRY] [%v] retry again due to 400", input_model.HTTPMethod, input_model.URL)
-			time.Sleep(time.Second * 2)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return result, werr
+			}
 			i = i + 50
 			continue
 		} else if code == 404.0 {
 			return result, err
 		} else if code == 429.0 {
 			log.Printf("[%v] [RETRY] [%v] retry again due to 429", input_model.HTTPMethod, input_model.URL)
-			time.Sleep(time.Second * 2)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return result, werr
+			}
 			i = i + 10
 			continue
 		} else if code == 500.0 {
 			log.Printf("[%v] [RETRY] [%v] retry again due to 500", input_model.HTTPMethod, input_model.URL)
-			time.Sleep(time.Second * 2)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return result, werr
+			}
 			i = i + 20
 			continue
 		} else {
@@ -129,3 +144,76 @@ func read(c *FortiSDKClient, input_model *InputModel) (map[string]interface{}, e
 	}
 	return result, err
 }
+
+func exist(c *FortiSDKClient, input_model *InputModel) (bool, error) {
+	var code float64
+	var err error
+	for i := 0; i <= 100; {
+		_, code, err = sendSingleRequest(c, input_model)
+		if err == nil {
+			return true, nil
+		} else if code == 404.0 {
+			return false, nil
+		} else if code == 429.0 {
+			log.Printf("[%v] [RETRY] [%v] retry again due to 429", input_model.HTTPMethod, input_model.URL)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return false, werr
+			}
+			i = i + 10
+			continue
+		} else if code == 500.0 {
+			log.Printf("[%v] [RETRY] [%v] retry again due to 500", input_model.HTTPMethod, input_model.URL)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return false, werr
+			}
+			i = i + 20
+			continue
+		} else {
+			return false, err
+		}
+	}
+	return false, err
+}
+
+func readList(c *FortiSDKClient, input_model *InputModel) ([]interface{}, error) {
+	var result map[string]interface{}
+	var code float64
+	var err error
+	for i := 0; i <= 100; {
+		result, code, err = sendSingleRequest(c, input_model)
+		if err == nil {
+			if result["data"] == nil {
+				return []interface{}{}, nil
+			} else if convered_rst, ok := result["data"].([]interface{}); ok {
+				return convered_rst, nil
+			} else {
+				err = fmt.Errorf("Cannot convert respound type: %T", result["data"])
+				return nil, err
+			}
+		} else if code == 400.0 {
+			log.Printf("[%v] [RETRY] [%v] retry again due to 400", input_model.HTTPMethod, input_model.URL)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return nil, werr
+			}
+			i = i + 50
+			continue
+		} else if code == 429.0 {
+			log.Printf("[%v] [RETRY] [%v] retry again due to 429", input_model.HTTPMethod, input_model.URL)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return nil, werr
+			}
+			i = i + 10
+			continue
+		} else if code == 500.0 {
+			log.Printf("[%v] [RETRY] [%v] retry again due to 500", input_model.HTTPMethod, input_model.URL)
+			if werr := input_model.wait(time.Second*2, err); werr != nil {
+				return nil, werr
+			}
+			i = i + 20
+			continue
+		} else {
+			return nil, err
+		}
+	}
+	return nil, err
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/fortinetdev/terraform-provider-fortisase/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -22,6 +23,14 @@ var (
 )
 
 func main() {
+	// "export" generates Terraform configuration from the objects that already exist in the tenant.
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := provider.RunExport(context.Background(), os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")